@@ -25,12 +25,132 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Pool_Health int32
+
+const (
+	Pool_HEALTH_UNSPECIFIED Pool_Health = 0
+	Pool_HEALTH_ONLINE      Pool_Health = 1
+	Pool_HEALTH_DEGRADED    Pool_Health = 2
+	Pool_HEALTH_FAULTED     Pool_Health = 3
+	Pool_HEALTH_OFFLINE     Pool_Health = 4
+	Pool_HEALTH_REMOVED     Pool_Health = 5
+	Pool_HEALTH_UNAVAIL     Pool_Health = 6
+	Pool_HEALTH_SUSPENDED   Pool_Health = 7
+)
+
+// Enum value maps for Pool_Health.
+var (
+	Pool_Health_name = map[int32]string{
+		0: "HEALTH_UNSPECIFIED",
+		1: "HEALTH_ONLINE",
+		2: "HEALTH_DEGRADED",
+		3: "HEALTH_FAULTED",
+		4: "HEALTH_OFFLINE",
+		5: "HEALTH_REMOVED",
+		6: "HEALTH_UNAVAIL",
+		7: "HEALTH_SUSPENDED",
+	}
+	Pool_Health_value = map[string]int32{
+		"HEALTH_UNSPECIFIED": 0,
+		"HEALTH_ONLINE":      1,
+		"HEALTH_DEGRADED":    2,
+		"HEALTH_FAULTED":     3,
+		"HEALTH_OFFLINE":     4,
+		"HEALTH_REMOVED":     5,
+		"HEALTH_UNAVAIL":     6,
+		"HEALTH_SUSPENDED":   7,
+	}
+)
+
+func (x Pool_Health) Enum() *Pool_Health {
+	p := new(Pool_Health)
+	*p = x
+	return p
+}
+
+func (x Pool_Health) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Pool_Health) Descriptor() protoreflect.EnumDescriptor {
+	return file_zfsilo_v1_zfsilo_proto_enumTypes[0].Descriptor()
+}
+
+func (Pool_Health) Type() protoreflect.EnumType {
+	return &file_zfsilo_v1_zfsilo_proto_enumTypes[0]
+}
+
+func (x Pool_Health) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Pool_Health.Descriptor instead.
+func (Pool_Health) EnumDescriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type PoolStatus_Scrub_State int32
+
+const (
+	PoolStatus_Scrub_STATE_UNSPECIFIED PoolStatus_Scrub_State = 0
+	PoolStatus_Scrub_STATE_SCANNING    PoolStatus_Scrub_State = 1
+	PoolStatus_Scrub_STATE_FINISHED    PoolStatus_Scrub_State = 2
+	PoolStatus_Scrub_STATE_CANCELED    PoolStatus_Scrub_State = 3
+	PoolStatus_Scrub_STATE_PAUSED      PoolStatus_Scrub_State = 4
+)
+
+// Enum value maps for PoolStatus_Scrub_State.
+var (
+	PoolStatus_Scrub_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_SCANNING",
+		2: "STATE_FINISHED",
+		3: "STATE_CANCELED",
+		4: "STATE_PAUSED",
+	}
+	PoolStatus_Scrub_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"STATE_SCANNING":    1,
+		"STATE_FINISHED":    2,
+		"STATE_CANCELED":    3,
+		"STATE_PAUSED":      4,
+	}
+)
+
+func (x PoolStatus_Scrub_State) Enum() *PoolStatus_Scrub_State {
+	p := new(PoolStatus_Scrub_State)
+	*p = x
+	return p
+}
+
+func (x PoolStatus_Scrub_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PoolStatus_Scrub_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_zfsilo_v1_zfsilo_proto_enumTypes[1].Descriptor()
+}
+
+func (PoolStatus_Scrub_State) Type() protoreflect.EnumType {
+	return &file_zfsilo_v1_zfsilo_proto_enumTypes[1]
+}
+
+func (x PoolStatus_Scrub_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PoolStatus_Scrub_State.Descriptor instead.
+func (PoolStatus_Scrub_State) EnumDescriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{16, 0, 0}
+}
+
 type Volume_Mode int32
 
 const (
 	Volume_MODE_UNSPECIFIED Volume_Mode = 0
 	Volume_MODE_BLOCK       Volume_Mode = 1
 	Volume_MODE_FILESYSTEM  Volume_Mode = 2
+	Volume_MODE_DATASET     Volume_Mode = 3
 )
 
 // Enum value maps for Volume_Mode.
@@ -39,11 +159,13 @@ var (
 		0: "MODE_UNSPECIFIED",
 		1: "MODE_BLOCK",
 		2: "MODE_FILESYSTEM",
+		3: "MODE_DATASET",
 	}
 	Volume_Mode_value = map[string]int32{
 		"MODE_UNSPECIFIED": 0,
 		"MODE_BLOCK":       1,
 		"MODE_FILESYSTEM":  2,
+		"MODE_DATASET":     3,
 	}
 )
 
@@ -58,11 +180,11 @@ func (x Volume_Mode) String() string {
 }
 
 func (Volume_Mode) Descriptor() protoreflect.EnumDescriptor {
-	return file_zfsilo_v1_zfsilo_proto_enumTypes[0].Descriptor()
+	return file_zfsilo_v1_zfsilo_proto_enumTypes[2].Descriptor()
 }
 
 func (Volume_Mode) Type() protoreflect.EnumType {
-	return &file_zfsilo_v1_zfsilo_proto_enumTypes[0]
+	return &file_zfsilo_v1_zfsilo_proto_enumTypes[2]
 }
 
 func (x Volume_Mode) Number() protoreflect.EnumNumber {
@@ -71,7 +193,7 @@ func (x Volume_Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Volume_Mode.Descriptor instead.
 func (Volume_Mode) EnumDescriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{13, 0}
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type Volume_Status int32
@@ -116,11 +238,11 @@ func (x Volume_Status) String() string {
 }
 
 func (Volume_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_zfsilo_v1_zfsilo_proto_enumTypes[1].Descriptor()
+	return file_zfsilo_v1_zfsilo_proto_enumTypes[3].Descriptor()
 }
 
 func (Volume_Status) Type() protoreflect.EnumType {
-	return &file_zfsilo_v1_zfsilo_proto_enumTypes[1]
+	return &file_zfsilo_v1_zfsilo_proto_enumTypes[3]
 }
 
 func (x Volume_Status) Number() protoreflect.EnumNumber {
@@ -129,7 +251,7 @@ func (x Volume_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Volume_Status.Descriptor instead.
 func (Volume_Status) EnumDescriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{13, 1}
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{23, 1}
 }
 
 type Volume_Transport int32
@@ -138,6 +260,7 @@ const (
 	Volume_TRANSPORT_UNSPECIFIED Volume_Transport = 0
 	Volume_TRANSPORT_ISCSI       Volume_Transport = 1
 	Volume_TRANSPORT_NVMEOF_TCP  Volume_Transport = 2
+	Volume_TRANSPORT_NFS         Volume_Transport = 3
 )
 
 // Enum value maps for Volume_Transport.
@@ -146,11 +269,13 @@ var (
 		0: "TRANSPORT_UNSPECIFIED",
 		1: "TRANSPORT_ISCSI",
 		2: "TRANSPORT_NVMEOF_TCP",
+		3: "TRANSPORT_NFS",
 	}
 	Volume_Transport_value = map[string]int32{
 		"TRANSPORT_UNSPECIFIED": 0,
 		"TRANSPORT_ISCSI":       1,
 		"TRANSPORT_NVMEOF_TCP":  2,
+		"TRANSPORT_NFS":         3,
 	}
 )
 
@@ -165,11 +290,11 @@ func (x Volume_Transport) String() string {
 }
 
 func (Volume_Transport) Descriptor() protoreflect.EnumDescriptor {
-	return file_zfsilo_v1_zfsilo_proto_enumTypes[2].Descriptor()
+	return file_zfsilo_v1_zfsilo_proto_enumTypes[4].Descriptor()
 }
 
 func (Volume_Transport) Type() protoreflect.EnumType {
-	return &file_zfsilo_v1_zfsilo_proto_enumTypes[2]
+	return &file_zfsilo_v1_zfsilo_proto_enumTypes[4]
 }
 
 func (x Volume_Transport) Number() protoreflect.EnumNumber {
@@ -178,7 +303,59 @@ func (x Volume_Transport) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Volume_Transport.Descriptor instead.
 func (Volume_Transport) EnumDescriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{13, 2}
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{23, 2}
+}
+
+type Volume_FSType int32
+
+const (
+	Volume_FS_TYPE_UNSPECIFIED Volume_FSType = 0
+	Volume_FS_TYPE_EXT4        Volume_FSType = 1
+	Volume_FS_TYPE_XFS         Volume_FSType = 2
+	Volume_FS_TYPE_BTRFS       Volume_FSType = 3
+)
+
+// Enum value maps for Volume_FSType.
+var (
+	Volume_FSType_name = map[int32]string{
+		0: "FS_TYPE_UNSPECIFIED",
+		1: "FS_TYPE_EXT4",
+		2: "FS_TYPE_XFS",
+		3: "FS_TYPE_BTRFS",
+	}
+	Volume_FSType_value = map[string]int32{
+		"FS_TYPE_UNSPECIFIED": 0,
+		"FS_TYPE_EXT4":        1,
+		"FS_TYPE_XFS":         2,
+		"FS_TYPE_BTRFS":       3,
+	}
+)
+
+func (x Volume_FSType) Enum() *Volume_FSType {
+	p := new(Volume_FSType)
+	*p = x
+	return p
+}
+
+func (x Volume_FSType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Volume_FSType) Descriptor() protoreflect.EnumDescriptor {
+	return file_zfsilo_v1_zfsilo_proto_enumTypes[5].Descriptor()
+}
+
+func (Volume_FSType) Type() protoreflect.EnumType {
+	return &file_zfsilo_v1_zfsilo_proto_enumTypes[5]
+}
+
+func (x Volume_FSType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Volume_FSType.Descriptor instead.
+func (Volume_FSType) EnumDescriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{23, 3}
 }
 
 type StatsVolumeResponse_Stats_Usage_Unit int32
@@ -214,11 +391,11 @@ func (x StatsVolumeResponse_Stats_Usage_Unit) String() string {
 }
 
 func (StatsVolumeResponse_Stats_Usage_Unit) Descriptor() protoreflect.EnumDescriptor {
-	return file_zfsilo_v1_zfsilo_proto_enumTypes[3].Descriptor()
+	return file_zfsilo_v1_zfsilo_proto_enumTypes[6].Descriptor()
 }
 
 func (StatsVolumeResponse_Stats_Usage_Unit) Type() protoreflect.EnumType {
-	return &file_zfsilo_v1_zfsilo_proto_enumTypes[3]
+	return &file_zfsilo_v1_zfsilo_proto_enumTypes[6]
 }
 
 func (x StatsVolumeResponse_Stats_Usage_Unit) Number() protoreflect.EnumNumber {
@@ -227,13 +404,124 @@ func (x StatsVolumeResponse_Stats_Usage_Unit) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StatsVolumeResponse_Stats_Usage_Unit.Descriptor instead.
 func (StatsVolumeResponse_Stats_Usage_Unit) EnumDescriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{41, 0, 0, 0}
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{51, 0, 0, 0}
+}
+
+type VolumeProperty_Source int32
+
+const (
+	VolumeProperty_SOURCE_UNSPECIFIED VolumeProperty_Source = 0
+	VolumeProperty_SOURCE_LOCAL       VolumeProperty_Source = 1
+	VolumeProperty_SOURCE_DEFAULT     VolumeProperty_Source = 2
+	VolumeProperty_SOURCE_INHERITED   VolumeProperty_Source = 3
+	VolumeProperty_SOURCE_TEMPORARY   VolumeProperty_Source = 4
+	VolumeProperty_SOURCE_RECEIVED    VolumeProperty_Source = 5
+	VolumeProperty_SOURCE_NONE        VolumeProperty_Source = 6
+)
+
+// Enum value maps for VolumeProperty_Source.
+var (
+	VolumeProperty_Source_name = map[int32]string{
+		0: "SOURCE_UNSPECIFIED",
+		1: "SOURCE_LOCAL",
+		2: "SOURCE_DEFAULT",
+		3: "SOURCE_INHERITED",
+		4: "SOURCE_TEMPORARY",
+		5: "SOURCE_RECEIVED",
+		6: "SOURCE_NONE",
+	}
+	VolumeProperty_Source_value = map[string]int32{
+		"SOURCE_UNSPECIFIED": 0,
+		"SOURCE_LOCAL":       1,
+		"SOURCE_DEFAULT":     2,
+		"SOURCE_INHERITED":   3,
+		"SOURCE_TEMPORARY":   4,
+		"SOURCE_RECEIVED":    5,
+		"SOURCE_NONE":        6,
+	}
+)
+
+func (x VolumeProperty_Source) Enum() *VolumeProperty_Source {
+	p := new(VolumeProperty_Source)
+	*p = x
+	return p
+}
+
+func (x VolumeProperty_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VolumeProperty_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_zfsilo_v1_zfsilo_proto_enumTypes[7].Descriptor()
+}
+
+func (VolumeProperty_Source) Type() protoreflect.EnumType {
+	return &file_zfsilo_v1_zfsilo_proto_enumTypes[7]
+}
+
+func (x VolumeProperty_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VolumeProperty_Source.Descriptor instead.
+func (VolumeProperty_Source) EnumDescriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{91, 0}
+}
+
+type SnapshotPolicyRun_Outcome int32
+
+const (
+	SnapshotPolicyRun_OUTCOME_UNSPECIFIED SnapshotPolicyRun_Outcome = 0
+	SnapshotPolicyRun_OUTCOME_SUCCEEDED   SnapshotPolicyRun_Outcome = 1
+	SnapshotPolicyRun_OUTCOME_FAILED      SnapshotPolicyRun_Outcome = 2
+)
+
+// Enum value maps for SnapshotPolicyRun_Outcome.
+var (
+	SnapshotPolicyRun_Outcome_name = map[int32]string{
+		0: "OUTCOME_UNSPECIFIED",
+		1: "OUTCOME_SUCCEEDED",
+		2: "OUTCOME_FAILED",
+	}
+	SnapshotPolicyRun_Outcome_value = map[string]int32{
+		"OUTCOME_UNSPECIFIED": 0,
+		"OUTCOME_SUCCEEDED":   1,
+		"OUTCOME_FAILED":      2,
+	}
+)
+
+func (x SnapshotPolicyRun_Outcome) Enum() *SnapshotPolicyRun_Outcome {
+	p := new(SnapshotPolicyRun_Outcome)
+	*p = x
+	return p
+}
+
+func (x SnapshotPolicyRun_Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SnapshotPolicyRun_Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_zfsilo_v1_zfsilo_proto_enumTypes[8].Descriptor()
+}
+
+func (SnapshotPolicyRun_Outcome) Type() protoreflect.EnumType {
+	return &file_zfsilo_v1_zfsilo_proto_enumTypes[8]
+}
+
+func (x SnapshotPolicyRun_Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SnapshotPolicyRun_Outcome.Descriptor instead.
+func (SnapshotPolicyRun_Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{95, 0}
 }
 
 type GetCapacityRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ParentDatasetId string                 `protobuf:"bytes,1,opt,name=parent_dataset_id,json=parentDatasetId,proto3" json:"parent_dataset_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetCapacityRequest) Reset() {
@@ -266,9 +554,17 @@ func (*GetCapacityRequest) Descriptor() ([]byte, []int) {
 	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GetCapacityRequest) GetParentDatasetId() string {
+	if x != nil {
+		return x.ParentDatasetId
+	}
+	return ""
+}
+
 type GetCapacityResponse struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	AvailableCapacityBytes int64                  `protobuf:"varint,1,opt,name=available_capacity_bytes,json=availableCapacityBytes,proto3" json:"available_capacity_bytes,omitempty"`
+	MaximumVolumeSizeBytes int64                  `protobuf:"varint,2,opt,name=maximum_volume_size_bytes,json=maximumVolumeSizeBytes,proto3" json:"maximum_volume_size_bytes,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -310,6 +606,13 @@ func (x *GetCapacityResponse) GetAvailableCapacityBytes() int64 {
 	return 0
 }
 
+func (x *GetCapacityResponse) GetMaximumVolumeSizeBytes() int64 {
+	if x != nil {
+		return x.MaximumVolumeSizeBytes
+	}
+	return 0
+}
+
 type Host struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
@@ -321,6 +624,7 @@ type Host struct {
 	Key           string                 `protobuf:"bytes,7,opt,name=key,proto3" json:"key,omitempty"`
 	Role          *Host_Role             `protobuf:"bytes,8,opt,name=role,proto3" json:"role,omitempty"`
 	ByConfig      bool                   `protobuf:"varint,10,opt,name=by_config,json=byConfig,proto3" json:"by_config,omitempty"`
+	Status        *Host_Status           `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -418,6 +722,13 @@ func (x *Host) GetByConfig() bool {
 	return false
 }
 
+func (x *Host) GetStatus() *Host_Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
 type GetHostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -882,42 +1193,28 @@ func (*DeleteHostResponse) Descriptor() ([]byte, []int) {
 	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{12}
 }
 
-type Volume struct {
+type RotateHostKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Struct        *structpb.Struct       `protobuf:"bytes,1,opt,name=struct,proto3" json:"struct,omitempty"`
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	DatasetId     string                 `protobuf:"bytes,6,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
-	Options       []*Volume_Option       `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
-	Sparse        *bool                  `protobuf:"varint,8,opt,name=sparse,proto3,oneof" json:"sparse,omitempty"`
-	Mode          Volume_Mode            `protobuf:"varint,9,opt,name=mode,proto3,enum=zfsilo.v1.Volume_Mode" json:"mode,omitempty"`
-	CapacityBytes int64                  `protobuf:"varint,10,opt,name=capacity_bytes,json=capacityBytes,proto3" json:"capacity_bytes,omitempty"`
-	Status        Volume_Status          `protobuf:"varint,11,opt,name=status,proto3,enum=zfsilo.v1.Volume_Status" json:"status,omitempty"`
-	Transport     *Volume_Transport      `protobuf:"varint,12,opt,name=transport,proto3,enum=zfsilo.v1.Volume_Transport,oneof" json:"transport,omitempty"`
-	ServerHost    *string                `protobuf:"bytes,13,opt,name=server_host,json=serverHost,proto3,oneof" json:"server_host,omitempty"`
-	ClientHost    *string                `protobuf:"bytes,14,opt,name=client_host,json=clientHost,proto3,oneof" json:"client_host,omitempty"`
-	StagingPath   *string                `protobuf:"bytes,16,opt,name=staging_path,json=stagingPath,proto3,oneof" json:"staging_path,omitempty"`
-	TargetPaths   []string               `protobuf:"bytes,17,rep,name=target_paths,json=targetPaths,proto3" json:"target_paths,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Volume) Reset() {
-	*x = Volume{}
+func (x *RotateHostKeyRequest) Reset() {
+	*x = RotateHostKeyRequest{}
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Volume) String() string {
+func (x *RotateHostKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Volume) ProtoMessage() {}
+func (*RotateHostKeyRequest) ProtoMessage() {}
 
-func (x *Volume) ProtoReflect() protoreflect.Message {
+func (x *RotateHostKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -929,145 +1226,239 @@ func (x *Volume) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
-func (*Volume) Descriptor() ([]byte, []int) {
+// Deprecated: Use RotateHostKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateHostKeyRequest) Descriptor() ([]byte, []int) {
 	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Volume) GetStruct() *structpb.Struct {
+func (x *RotateHostKeyRequest) GetId() string {
 	if x != nil {
-		return x.Struct
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *Volume) GetCreateTime() *timestamppb.Timestamp {
+func (x *RotateHostKeyRequest) GetKey() string {
 	if x != nil {
-		return x.CreateTime
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *Volume) GetUpdateTime() *timestamppb.Timestamp {
+type RotateHostKeyResponse struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Host          *Host                                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Results       []*RotateHostKeyResponse_VolumeResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateHostKeyResponse) Reset() {
+	*x = RotateHostKeyResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateHostKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateHostKeyResponse) ProtoMessage() {}
+
+func (x *RotateHostKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[14]
 	if x != nil {
-		return x.UpdateTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateHostKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateHostKeyResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RotateHostKeyResponse) GetHost() *Host {
+	if x != nil {
+		return x.Host
 	}
 	return nil
 }
 
-func (x *Volume) GetId() string {
+func (x *RotateHostKeyResponse) GetResults() []*RotateHostKeyResponse_VolumeResult {
 	if x != nil {
-		return x.Id
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *Volume) GetName() string {
+type Pool struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ServerHost           string                 `protobuf:"bytes,1,opt,name=server_host,json=serverHost,proto3" json:"server_host,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SizeBytes            int64                  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	AllocatedBytes       int64                  `protobuf:"varint,4,opt,name=allocated_bytes,json=allocatedBytes,proto3" json:"allocated_bytes,omitempty"`
+	FreeBytes            int64                  `protobuf:"varint,5,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	FragmentationPercent int32                  `protobuf:"varint,6,opt,name=fragmentation_percent,json=fragmentationPercent,proto3" json:"fragmentation_percent,omitempty"`
+	Health               Pool_Health            `protobuf:"varint,7,opt,name=health,proto3,enum=zfsilo.v1.Pool_Health" json:"health,omitempty"`
+	Status               string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Action               string                 `protobuf:"bytes,9,opt,name=action,proto3" json:"action,omitempty"`
+	Errors               string                 `protobuf:"bytes,10,opt,name=errors,proto3" json:"errors,omitempty"`
+	Datasets             []string               `protobuf:"bytes,11,rep,name=datasets,proto3" json:"datasets,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Pool) Reset() {
+	*x = Pool{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pool) ProtoMessage() {}
+
+func (x *Pool) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[15]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Volume) GetDatasetId() string {
+// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
+func (*Pool) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Pool) GetServerHost() string {
 	if x != nil {
-		return x.DatasetId
+		return x.ServerHost
 	}
 	return ""
 }
 
-func (x *Volume) GetOptions() []*Volume_Option {
+func (x *Pool) GetName() string {
 	if x != nil {
-		return x.Options
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Volume) GetSparse() bool {
-	if x != nil && x.Sparse != nil {
-		return *x.Sparse
+func (x *Pool) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
 	}
-	return false
+	return 0
 }
 
-func (x *Volume) GetMode() Volume_Mode {
+func (x *Pool) GetAllocatedBytes() int64 {
 	if x != nil {
-		return x.Mode
+		return x.AllocatedBytes
 	}
-	return Volume_MODE_UNSPECIFIED
+	return 0
 }
 
-func (x *Volume) GetCapacityBytes() int64 {
+func (x *Pool) GetFreeBytes() int64 {
 	if x != nil {
-		return x.CapacityBytes
+		return x.FreeBytes
 	}
 	return 0
 }
 
-func (x *Volume) GetStatus() Volume_Status {
+func (x *Pool) GetFragmentationPercent() int32 {
 	if x != nil {
-		return x.Status
+		return x.FragmentationPercent
 	}
-	return Volume_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *Volume) GetTransport() Volume_Transport {
-	if x != nil && x.Transport != nil {
-		return *x.Transport
+func (x *Pool) GetHealth() Pool_Health {
+	if x != nil {
+		return x.Health
 	}
-	return Volume_TRANSPORT_UNSPECIFIED
+	return Pool_HEALTH_UNSPECIFIED
 }
 
-func (x *Volume) GetServerHost() string {
-	if x != nil && x.ServerHost != nil {
-		return *x.ServerHost
+func (x *Pool) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *Volume) GetClientHost() string {
-	if x != nil && x.ClientHost != nil {
-		return *x.ClientHost
+func (x *Pool) GetAction() string {
+	if x != nil {
+		return x.Action
 	}
 	return ""
 }
 
-func (x *Volume) GetStagingPath() string {
-	if x != nil && x.StagingPath != nil {
-		return *x.StagingPath
+func (x *Pool) GetErrors() string {
+	if x != nil {
+		return x.Errors
 	}
 	return ""
 }
 
-func (x *Volume) GetTargetPaths() []string {
+func (x *Pool) GetDatasets() []string {
 	if x != nil {
-		return x.TargetPaths
+		return x.Datasets
 	}
 	return nil
 }
 
-type GetVolumeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetVolumeRequest) Reset() {
-	*x = GetVolumeRequest{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[14]
+type PoolStatus struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ServerHost          string                 `protobuf:"bytes,1,opt,name=server_host,json=serverHost,proto3" json:"server_host,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PollTime            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=poll_time,json=pollTime,proto3" json:"poll_time,omitempty"`
+	Health              Pool_Health            `protobuf:"varint,4,opt,name=health,proto3,enum=zfsilo.v1.Pool_Health" json:"health,omitempty"`
+	PreviousHealth      Pool_Health            `protobuf:"varint,5,opt,name=previous_health,json=previousHealth,proto3,enum=zfsilo.v1.Pool_Health" json:"previous_health,omitempty"`
+	HealthChangeTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=health_change_time,json=healthChangeTime,proto3" json:"health_change_time,omitempty"`
+	Status              string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Action              string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`
+	Errors              string                 `protobuf:"bytes,9,opt,name=errors,proto3" json:"errors,omitempty"`
+	ReadErrors          int64                  `protobuf:"varint,10,opt,name=read_errors,json=readErrors,proto3" json:"read_errors,omitempty"`
+	WriteErrors         int64                  `protobuf:"varint,11,opt,name=write_errors,json=writeErrors,proto3" json:"write_errors,omitempty"`
+	ChecksumErrors      int64                  `protobuf:"varint,12,opt,name=checksum_errors,json=checksumErrors,proto3" json:"checksum_errors,omitempty"`
+	ResilverPercentDone float64                `protobuf:"fixed64,13,opt,name=resilver_percent_done,json=resilverPercentDone,proto3" json:"resilver_percent_done,omitempty"`
+	LastScrub           *PoolStatus_Scrub      `protobuf:"bytes,14,opt,name=last_scrub,json=lastScrub,proto3" json:"last_scrub,omitempty"`
+	ScrubSchedule       string                 `protobuf:"bytes,15,opt,name=scrub_schedule,json=scrubSchedule,proto3" json:"scrub_schedule,omitempty"`
+	NextScrubTime       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=next_scrub_time,json=nextScrubTime,proto3" json:"next_scrub_time,omitempty"`
+	ScrubError          string                 `protobuf:"bytes,17,opt,name=scrub_error,json=scrubError,proto3" json:"scrub_error,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PoolStatus) Reset() {
+	*x = PoolStatus{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVolumeRequest) String() string {
+func (x *PoolStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVolumeRequest) ProtoMessage() {}
+func (*PoolStatus) ProtoMessage() {}
 
-func (x *GetVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[14]
+func (x *PoolStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,152 +1469,152 @@ func (x *GetVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVolumeRequest.ProtoReflect.Descriptor instead.
-func (*GetVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use PoolStatus.ProtoReflect.Descriptor instead.
+func (*PoolStatus) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetVolumeRequest) GetId() string {
+func (x *PoolStatus) GetServerHost() string {
 	if x != nil {
-		return x.Id
+		return x.ServerHost
 	}
 	return ""
 }
 
-type GetVolumeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetVolumeResponse) Reset() {
-	*x = GetVolumeResponse{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PoolStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetVolumeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PoolStatus) GetPollTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PollTime
+	}
+	return nil
 }
 
-func (*GetVolumeResponse) ProtoMessage() {}
-
-func (x *GetVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[15]
+func (x *PoolStatus) GetHealth() Pool_Health {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Health
 	}
-	return mi.MessageOf(x)
+	return Pool_HEALTH_UNSPECIFIED
 }
 
-// Deprecated: Use GetVolumeResponse.ProtoReflect.Descriptor instead.
-func (*GetVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{15}
+func (x *PoolStatus) GetPreviousHealth() Pool_Health {
+	if x != nil {
+		return x.PreviousHealth
+	}
+	return Pool_HEALTH_UNSPECIFIED
 }
 
-func (x *GetVolumeResponse) GetVolume() *Volume {
+func (x *PoolStatus) GetHealthChangeTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Volume
+		return x.HealthChangeTime
 	}
 	return nil
 }
 
-type ListVolumesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Filter        string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PoolStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *ListVolumesRequest) Reset() {
-	*x = ListVolumesRequest{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PoolStatus) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (x *ListVolumesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PoolStatus) GetErrors() string {
+	if x != nil {
+		return x.Errors
+	}
+	return ""
 }
 
-func (*ListVolumesRequest) ProtoMessage() {}
+func (x *PoolStatus) GetReadErrors() int64 {
+	if x != nil {
+		return x.ReadErrors
+	}
+	return 0
+}
 
-func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[16]
+func (x *PoolStatus) GetWriteErrors() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.WriteErrors
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
-func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{16}
+func (x *PoolStatus) GetChecksumErrors() int64 {
+	if x != nil {
+		return x.ChecksumErrors
+	}
+	return 0
 }
 
-func (x *ListVolumesRequest) GetPageSize() int32 {
+func (x *PoolStatus) GetResilverPercentDone() float64 {
 	if x != nil {
-		return x.PageSize
+		return x.ResilverPercentDone
 	}
 	return 0
 }
 
-func (x *ListVolumesRequest) GetFilter() string {
+func (x *PoolStatus) GetLastScrub() *PoolStatus_Scrub {
 	if x != nil {
-		return x.Filter
+		return x.LastScrub
 	}
-	return ""
+	return nil
 }
 
-func (x *ListVolumesRequest) GetOrderBy() string {
+func (x *PoolStatus) GetScrubSchedule() string {
 	if x != nil {
-		return x.OrderBy
+		return x.ScrubSchedule
 	}
 	return ""
 }
 
-func (x *ListVolumesRequest) GetPageToken() string {
+func (x *PoolStatus) GetNextScrubTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PageToken
+		return x.NextScrubTime
+	}
+	return nil
+}
+
+func (x *PoolStatus) GetScrubError() string {
+	if x != nil {
+		return x.ScrubError
 	}
 	return ""
 }
 
-type ListVolumesResponse struct {
+type GetPoolRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volumes       []*Volume              `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	ServerHost    string                 `protobuf:"bytes,1,opt,name=server_host,json=serverHost,proto3" json:"server_host,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVolumesResponse) Reset() {
-	*x = ListVolumesResponse{}
+func (x *GetPoolRequest) Reset() {
+	*x = GetPoolRequest{}
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVolumesResponse) String() string {
+func (x *GetPoolRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVolumesResponse) ProtoMessage() {}
+func (*GetPoolRequest) ProtoMessage() {}
 
-func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPoolRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1235,46 +1626,46 @@ func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
-func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPoolRequest.ProtoReflect.Descriptor instead.
+func (*GetPoolRequest) Descriptor() ([]byte, []int) {
 	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListVolumesResponse) GetVolumes() []*Volume {
+func (x *GetPoolRequest) GetServerHost() string {
 	if x != nil {
-		return x.Volumes
+		return x.ServerHost
 	}
-	return nil
+	return ""
 }
 
-func (x *ListVolumesResponse) GetNextPageToken() string {
+func (x *GetPoolRequest) GetName() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Name
 	}
 	return ""
 }
 
-type CreateVolumeRequest struct {
+type GetPoolResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Pool          *Pool                  `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVolumeRequest) Reset() {
-	*x = CreateVolumeRequest{}
+func (x *GetPoolResponse) Reset() {
+	*x = GetPoolResponse{}
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVolumeRequest) String() string {
+func (x *GetPoolResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVolumeRequest) ProtoMessage() {}
+func (*GetPoolResponse) ProtoMessage() {}
 
-func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPoolResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1286,39 +1677,40 @@ func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
-func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPoolResponse.ProtoReflect.Descriptor instead.
+func (*GetPoolResponse) Descriptor() ([]byte, []int) {
 	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateVolumeRequest) GetVolume() *Volume {
+func (x *GetPoolResponse) GetPool() *Pool {
 	if x != nil {
-		return x.Volume
+		return x.Pool
 	}
 	return nil
 }
 
-type CreateVolumeResponse struct {
+type GetPoolStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	ServerHost    string                 `protobuf:"bytes,1,opt,name=server_host,json=serverHost,proto3" json:"server_host,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVolumeResponse) Reset() {
-	*x = CreateVolumeResponse{}
+func (x *GetPoolStatusRequest) Reset() {
+	*x = GetPoolStatusRequest{}
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVolumeResponse) String() string {
+func (x *GetPoolStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVolumeResponse) ProtoMessage() {}
+func (*GetPoolStatusRequest) ProtoMessage() {}
 
-func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPoolStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1330,39 +1722,46 @@ func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
-func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPoolStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPoolStatusRequest) Descriptor() ([]byte, []int) {
 	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CreateVolumeResponse) GetVolume() *Volume {
+func (x *GetPoolStatusRequest) GetServerHost() string {
 	if x != nil {
-		return x.Volume
+		return x.ServerHost
 	}
-	return nil
+	return ""
 }
 
-type UpdateVolumeRequest struct {
+func (x *GetPoolStatusRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetPoolStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *structpb.Struct       `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	PoolStatus    *PoolStatus            `protobuf:"bytes,1,opt,name=pool_status,json=poolStatus,proto3" json:"pool_status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateVolumeRequest) Reset() {
-	*x = UpdateVolumeRequest{}
+func (x *GetPoolStatusResponse) Reset() {
+	*x = GetPoolStatusResponse{}
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVolumeRequest) String() string {
+func (x *GetPoolStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVolumeRequest) ProtoMessage() {}
+func (*GetPoolStatusResponse) ProtoMessage() {}
 
-func (x *UpdateVolumeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPoolStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1374,39 +1773,39 @@ func (x *UpdateVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVolumeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateVolumeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPoolStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetPoolStatusResponse) Descriptor() ([]byte, []int) {
 	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UpdateVolumeRequest) GetVolume() *structpb.Struct {
+func (x *GetPoolStatusResponse) GetPoolStatus() *PoolStatus {
 	if x != nil {
-		return x.Volume
+		return x.PoolStatus
 	}
 	return nil
 }
 
-type UpdateVolumeResponse struct {
+type ListPoolsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	ServerHost    string                 `protobuf:"bytes,1,opt,name=server_host,json=serverHost,proto3" json:"server_host,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateVolumeResponse) Reset() {
-	*x = UpdateVolumeResponse{}
+func (x *ListPoolsRequest) Reset() {
+	*x = ListPoolsRequest{}
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVolumeResponse) String() string {
+func (x *ListPoolsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVolumeResponse) ProtoMessage() {}
+func (*ListPoolsRequest) ProtoMessage() {}
 
-func (x *UpdateVolumeResponse) ProtoReflect() protoreflect.Message {
+func (x *ListPoolsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1418,39 +1817,39 @@ func (x *UpdateVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVolumeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateVolumeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPoolsRequest.ProtoReflect.Descriptor instead.
+func (*ListPoolsRequest) Descriptor() ([]byte, []int) {
 	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpdateVolumeResponse) GetVolume() *Volume {
+func (x *ListPoolsRequest) GetServerHost() string {
 	if x != nil {
-		return x.Volume
+		return x.ServerHost
 	}
-	return nil
+	return ""
 }
 
-type DeleteVolumeRequest struct {
+type ListPoolsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pools         []*Pool                `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteVolumeRequest) Reset() {
-	*x = DeleteVolumeRequest{}
+func (x *ListPoolsResponse) Reset() {
+	*x = ListPoolsResponse{}
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVolumeRequest) String() string {
+func (x *ListPoolsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVolumeRequest) ProtoMessage() {}
+func (*ListPoolsResponse) ProtoMessage() {}
 
-func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
+func (x *ListPoolsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1462,38 +1861,63 @@ func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPoolsResponse.ProtoReflect.Descriptor instead.
+func (*ListPoolsResponse) Descriptor() ([]byte, []int) {
 	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeleteVolumeRequest) GetId() string {
+func (x *ListPoolsResponse) GetPools() []*Pool {
 	if x != nil {
-		return x.Id
+		return x.Pools
 	}
-	return ""
+	return nil
 }
 
-type DeleteVolumeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Volume struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Struct         *structpb.Struct       `protobuf:"bytes,1,opt,name=struct,proto3" json:"struct,omitempty"`
+	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Id             string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	DatasetId      string                 `protobuf:"bytes,6,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	Options        []*Volume_Option       `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+	Sparse         *bool                  `protobuf:"varint,8,opt,name=sparse,proto3,oneof" json:"sparse,omitempty"`
+	Mode           Volume_Mode            `protobuf:"varint,9,opt,name=mode,proto3,enum=zfsilo.v1.Volume_Mode" json:"mode,omitempty"`
+	CapacityBytes  int64                  `protobuf:"varint,10,opt,name=capacity_bytes,json=capacityBytes,proto3" json:"capacity_bytes,omitempty"`
+	Status         Volume_Status          `protobuf:"varint,11,opt,name=status,proto3,enum=zfsilo.v1.Volume_Status" json:"status,omitempty"`
+	Transport      *Volume_Transport      `protobuf:"varint,12,opt,name=transport,proto3,enum=zfsilo.v1.Volume_Transport,oneof" json:"transport,omitempty"`
+	ServerHost     *string                `protobuf:"bytes,13,opt,name=server_host,json=serverHost,proto3,oneof" json:"server_host,omitempty"`
+	SourceSnapshot *string                `protobuf:"bytes,18,opt,name=source_snapshot,json=sourceSnapshot,proto3,oneof" json:"source_snapshot,omitempty"`
+	Promote        *bool                  `protobuf:"varint,19,opt,name=promote,proto3,oneof" json:"promote,omitempty"`
+	SourceVolume   *string                `protobuf:"bytes,20,opt,name=source_volume,json=sourceVolume,proto3,oneof" json:"source_volume,omitempty"`
+	SnapshotPolicy *string                `protobuf:"bytes,21,opt,name=snapshot_policy,json=snapshotPolicy,proto3,oneof" json:"snapshot_policy,omitempty"`
+	StandbyHost    *string                `protobuf:"bytes,22,opt,name=standby_host,json=standbyHost,proto3,oneof" json:"standby_host,omitempty"`
+	FencedHosts    []string               `protobuf:"bytes,23,rep,name=fenced_hosts,json=fencedHosts,proto3" json:"fenced_hosts,omitempty"`
+	Encrypted      *bool                  `protobuf:"varint,24,opt,name=encrypted,proto3,oneof" json:"encrypted,omitempty"`
+	FsType         Volume_FSType          `protobuf:"varint,25,opt,name=fs_type,json=fsType,proto3,enum=zfsilo.v1.Volume_FSType" json:"fs_type,omitempty"`
+	MkfsOptions    []string               `protobuf:"bytes,26,rep,name=mkfs_options,json=mkfsOptions,proto3" json:"mkfs_options,omitempty"`
+	MountOptions   []string               `protobuf:"bytes,27,rep,name=mount_options,json=mountOptions,proto3" json:"mount_options,omitempty"`
+	Attachments    []*Volume_Attachment   `protobuf:"bytes,30,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Tls            *bool                  `protobuf:"varint,31,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteVolumeResponse) Reset() {
-	*x = DeleteVolumeResponse{}
+func (x *Volume) Reset() {
+	*x = Volume{}
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVolumeResponse) String() string {
+func (x *Volume) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVolumeResponse) ProtoMessage() {}
+func (*Volume) ProtoMessage() {}
 
-func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
+func (x *Volume) ProtoReflect() protoreflect.Message {
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1505,35 +1929,4027 @@ func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
+func (*Volume) Descriptor() ([]byte, []int) {
 	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{23}
 }
 
-type PublishVolumeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Transport     Volume_Transport       `protobuf:"varint,2,opt,name=transport,proto3,enum=zfsilo.v1.Volume_Transport" json:"transport,omitempty"`
-	ServerHost    string                 `protobuf:"bytes,3,opt,name=server_host,json=serverHost,proto3" json:"server_host,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Volume) GetStruct() *structpb.Struct {
+	if x != nil {
+		return x.Struct
+	}
+	return nil
 }
 
-func (x *PublishVolumeRequest) Reset() {
-	*x = PublishVolumeRequest{}
+func (x *Volume) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Volume) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Volume) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Volume) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Volume) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *Volume) GetOptions() []*Volume_Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Volume) GetSparse() bool {
+	if x != nil && x.Sparse != nil {
+		return *x.Sparse
+	}
+	return false
+}
+
+func (x *Volume) GetMode() Volume_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Volume_MODE_UNSPECIFIED
+}
+
+func (x *Volume) GetCapacityBytes() int64 {
+	if x != nil {
+		return x.CapacityBytes
+	}
+	return 0
+}
+
+func (x *Volume) GetStatus() Volume_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Volume_STATUS_UNSPECIFIED
+}
+
+func (x *Volume) GetTransport() Volume_Transport {
+	if x != nil && x.Transport != nil {
+		return *x.Transport
+	}
+	return Volume_TRANSPORT_UNSPECIFIED
+}
+
+func (x *Volume) GetServerHost() string {
+	if x != nil && x.ServerHost != nil {
+		return *x.ServerHost
+	}
+	return ""
+}
+
+func (x *Volume) GetSourceSnapshot() string {
+	if x != nil && x.SourceSnapshot != nil {
+		return *x.SourceSnapshot
+	}
+	return ""
+}
+
+func (x *Volume) GetPromote() bool {
+	if x != nil && x.Promote != nil {
+		return *x.Promote
+	}
+	return false
+}
+
+func (x *Volume) GetSourceVolume() string {
+	if x != nil && x.SourceVolume != nil {
+		return *x.SourceVolume
+	}
+	return ""
+}
+
+func (x *Volume) GetSnapshotPolicy() string {
+	if x != nil && x.SnapshotPolicy != nil {
+		return *x.SnapshotPolicy
+	}
+	return ""
+}
+
+func (x *Volume) GetStandbyHost() string {
+	if x != nil && x.StandbyHost != nil {
+		return *x.StandbyHost
+	}
+	return ""
+}
+
+func (x *Volume) GetFencedHosts() []string {
+	if x != nil {
+		return x.FencedHosts
+	}
+	return nil
+}
+
+func (x *Volume) GetEncrypted() bool {
+	if x != nil && x.Encrypted != nil {
+		return *x.Encrypted
+	}
+	return false
+}
+
+func (x *Volume) GetFsType() Volume_FSType {
+	if x != nil {
+		return x.FsType
+	}
+	return Volume_FS_TYPE_UNSPECIFIED
+}
+
+func (x *Volume) GetMkfsOptions() []string {
+	if x != nil {
+		return x.MkfsOptions
+	}
+	return nil
+}
+
+func (x *Volume) GetMountOptions() []string {
+	if x != nil {
+		return x.MountOptions
+	}
+	return nil
+}
+
+func (x *Volume) GetAttachments() []*Volume_Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Volume) GetTls() bool {
+	if x != nil && x.Tls != nil {
+		return *x.Tls
+	}
+	return false
+}
+
+type GetVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolumeRequest) Reset() {
+	*x = GetVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeRequest) ProtoMessage() {}
+
+func (x *GetVolumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolumeResponse) Reset() {
+	*x = GetVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeResponse) ProtoMessage() {}
+
+func (x *GetVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type ListVolumesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter        string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesRequest) Reset() {
+	*x = ListVolumesRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesRequest) ProtoMessage() {}
+
+func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
+func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListVolumesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListVolumesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListVolumesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListVolumesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListVolumesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volumes       []*Volume              `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesResponse) Reset() {
+	*x = ListVolumesResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesResponse) ProtoMessage() {}
+
+func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
+func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListVolumesResponse) GetVolumes() []*Volume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *ListVolumesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolumeRequest) Reset() {
+	*x = CreateVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeRequest) ProtoMessage() {}
+
+func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateVolumeRequest) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type CreateVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolumeResponse) Reset() {
+	*x = CreateVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeResponse) ProtoMessage() {}
+
+func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
+func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type UpdateVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *structpb.Struct       `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVolumeRequest) Reset() {
+	*x = UpdateVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVolumeRequest) ProtoMessage() {}
+
+func (x *UpdateVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVolumeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateVolumeRequest) GetVolume() *structpb.Struct {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type UpdateVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVolumeResponse) Reset() {
+	*x = UpdateVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVolumeResponse) ProtoMessage() {}
+
+func (x *UpdateVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVolumeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type DeleteVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVolumeRequest) Reset() {
+	*x = DeleteVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVolumeRequest) ProtoMessage() {}
+
+func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVolumeResponse) Reset() {
+	*x = DeleteVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVolumeResponse) ProtoMessage() {}
+
+func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{33}
+}
+
+type PublishVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Transport     Volume_Transport       `protobuf:"varint,2,opt,name=transport,proto3,enum=zfsilo.v1.Volume_Transport" json:"transport,omitempty"`
+	ServerHost    string                 `protobuf:"bytes,3,opt,name=server_host,json=serverHost,proto3" json:"server_host,omitempty"`
+	Tls           bool                   `protobuf:"varint,4,opt,name=tls,proto3" json:"tls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishVolumeRequest) Reset() {
+	*x = PublishVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishVolumeRequest) ProtoMessage() {}
+
+func (x *PublishVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishVolumeRequest.ProtoReflect.Descriptor instead.
+func (*PublishVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PublishVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublishVolumeRequest) GetTransport() Volume_Transport {
+	if x != nil {
+		return x.Transport
+	}
+	return Volume_TRANSPORT_UNSPECIFIED
+}
+
+func (x *PublishVolumeRequest) GetServerHost() string {
+	if x != nil {
+		return x.ServerHost
+	}
+	return ""
+}
+
+func (x *PublishVolumeRequest) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+type PublishVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishVolumeResponse) Reset() {
+	*x = PublishVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishVolumeResponse) ProtoMessage() {}
+
+func (x *PublishVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishVolumeResponse.ProtoReflect.Descriptor instead.
+func (*PublishVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PublishVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type UnpublishVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishVolumeRequest) Reset() {
+	*x = UnpublishVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishVolumeRequest) ProtoMessage() {}
+
+func (x *UnpublishVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishVolumeRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UnpublishVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UnpublishVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishVolumeResponse) Reset() {
+	*x = UnpublishVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishVolumeResponse) ProtoMessage() {}
+
+func (x *UnpublishVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishVolumeResponse.ProtoReflect.Descriptor instead.
+func (*UnpublishVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UnpublishVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type ConnectVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientHost    string                 `protobuf:"bytes,2,opt,name=client_host,json=clientHost,proto3" json:"client_host,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectVolumeRequest) Reset() {
+	*x = ConnectVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectVolumeRequest) ProtoMessage() {}
+
+func (x *ConnectVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ConnectVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ConnectVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConnectVolumeRequest) GetClientHost() string {
+	if x != nil {
+		return x.ClientHost
+	}
+	return ""
+}
+
+func (x *ConnectVolumeRequest) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type ConnectVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectVolumeResponse) Reset() {
+	*x = ConnectVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectVolumeResponse) ProtoMessage() {}
+
+func (x *ConnectVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectVolumeResponse.ProtoReflect.Descriptor instead.
+func (*ConnectVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ConnectVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type DisconnectVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientHost    string                 `protobuf:"bytes,2,opt,name=client_host,json=clientHost,proto3" json:"client_host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectVolumeRequest) Reset() {
+	*x = DisconnectVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectVolumeRequest) ProtoMessage() {}
+
+func (x *DisconnectVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectVolumeRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DisconnectVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DisconnectVolumeRequest) GetClientHost() string {
+	if x != nil {
+		return x.ClientHost
+	}
+	return ""
+}
+
+type DisconnectVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectVolumeResponse) Reset() {
+	*x = DisconnectVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectVolumeResponse) ProtoMessage() {}
+
+func (x *DisconnectVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectVolumeResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DisconnectVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type StageVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StagingPath   string                 `protobuf:"bytes,2,opt,name=staging_path,json=stagingPath,proto3" json:"staging_path,omitempty"`
+	ClientHost    string                 `protobuf:"bytes,3,opt,name=client_host,json=clientHost,proto3" json:"client_host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageVolumeRequest) Reset() {
+	*x = StageVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageVolumeRequest) ProtoMessage() {}
+
+func (x *StageVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageVolumeRequest.ProtoReflect.Descriptor instead.
+func (*StageVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StageVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StageVolumeRequest) GetStagingPath() string {
+	if x != nil {
+		return x.StagingPath
+	}
+	return ""
+}
+
+func (x *StageVolumeRequest) GetClientHost() string {
+	if x != nil {
+		return x.ClientHost
+	}
+	return ""
+}
+
+type StageVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageVolumeResponse) Reset() {
+	*x = StageVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageVolumeResponse) ProtoMessage() {}
+
+func (x *StageVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageVolumeResponse.ProtoReflect.Descriptor instead.
+func (*StageVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StageVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type UnstageVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientHost    string                 `protobuf:"bytes,2,opt,name=client_host,json=clientHost,proto3" json:"client_host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnstageVolumeRequest) Reset() {
+	*x = UnstageVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnstageVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnstageVolumeRequest) ProtoMessage() {}
+
+func (x *UnstageVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnstageVolumeRequest.ProtoReflect.Descriptor instead.
+func (*UnstageVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UnstageVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnstageVolumeRequest) GetClientHost() string {
+	if x != nil {
+		return x.ClientHost
+	}
+	return ""
+}
+
+type UnstageVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnstageVolumeResponse) Reset() {
+	*x = UnstageVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnstageVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnstageVolumeResponse) ProtoMessage() {}
+
+func (x *UnstageVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnstageVolumeResponse.ProtoReflect.Descriptor instead.
+func (*UnstageVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UnstageVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type MountVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MountPath     string                 `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	ClientHost    string                 `protobuf:"bytes,4,opt,name=client_host,json=clientHost,proto3" json:"client_host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MountVolumeRequest) Reset() {
+	*x = MountVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MountVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MountVolumeRequest) ProtoMessage() {}
+
+func (x *MountVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MountVolumeRequest.ProtoReflect.Descriptor instead.
+func (*MountVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MountVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MountVolumeRequest) GetMountPath() string {
+	if x != nil {
+		return x.MountPath
+	}
+	return ""
+}
+
+func (x *MountVolumeRequest) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *MountVolumeRequest) GetClientHost() string {
+	if x != nil {
+		return x.ClientHost
+	}
+	return ""
+}
+
+type MountVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MountVolumeResponse) Reset() {
+	*x = MountVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MountVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MountVolumeResponse) ProtoMessage() {}
+
+func (x *MountVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MountVolumeResponse.ProtoReflect.Descriptor instead.
+func (*MountVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MountVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type UnmountVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MountPath     string                 `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	ClientHost    string                 `protobuf:"bytes,3,opt,name=client_host,json=clientHost,proto3" json:"client_host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmountVolumeRequest) Reset() {
+	*x = UnmountVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmountVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmountVolumeRequest) ProtoMessage() {}
+
+func (x *UnmountVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmountVolumeRequest.ProtoReflect.Descriptor instead.
+func (*UnmountVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UnmountVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnmountVolumeRequest) GetMountPath() string {
+	if x != nil {
+		return x.MountPath
+	}
+	return ""
+}
+
+func (x *UnmountVolumeRequest) GetClientHost() string {
+	if x != nil {
+		return x.ClientHost
+	}
+	return ""
+}
+
+type UnmountVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmountVolumeResponse) Reset() {
+	*x = UnmountVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmountVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmountVolumeResponse) ProtoMessage() {}
+
+func (x *UnmountVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmountVolumeResponse.ProtoReflect.Descriptor instead.
+func (*UnmountVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UnmountVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type StatsVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsVolumeRequest) Reset() {
+	*x = StatsVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsVolumeRequest) ProtoMessage() {}
+
+func (x *StatsVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsVolumeRequest.ProtoReflect.Descriptor instead.
+func (*StatsVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *StatsVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StatsVolumeResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Stats         *StatsVolumeResponse_Stats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsVolumeResponse) Reset() {
+	*x = StatsVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsVolumeResponse) ProtoMessage() {}
+
+func (x *StatsVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsVolumeResponse.ProtoReflect.Descriptor instead.
+func (*StatsVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *StatsVolumeResponse) GetStats() *StatsVolumeResponse_Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type SyncVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncVolumeRequest) Reset() {
+	*x = SyncVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncVolumeRequest) ProtoMessage() {}
+
+func (x *SyncVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncVolumeRequest.ProtoReflect.Descriptor instead.
+func (*SyncVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SyncVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SyncVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncVolumeResponse) Reset() {
+	*x = SyncVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncVolumeResponse) ProtoMessage() {}
+
+func (x *SyncVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncVolumeResponse.ProtoReflect.Descriptor instead.
+func (*SyncVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{53}
+}
+
+type SyncVolumesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncVolumesRequest) Reset() {
+	*x = SyncVolumesRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncVolumesRequest) ProtoMessage() {}
+
+func (x *SyncVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncVolumesRequest.ProtoReflect.Descriptor instead.
+func (*SyncVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{54}
+}
+
+type SyncVolumesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncVolumesResponse) Reset() {
+	*x = SyncVolumesResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncVolumesResponse) ProtoMessage() {}
+
+func (x *SyncVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncVolumesResponse.ProtoReflect.Descriptor instead.
+func (*SyncVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{55}
+}
+
+type Snapshot struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Struct         *structpb.Struct       `protobuf:"bytes,1,opt,name=struct,proto3" json:"struct,omitempty"`
+	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Id             string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	VolumeId       string                 `protobuf:"bytes,6,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	DatasetId      string                 `protobuf:"bytes,7,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	CapacityBytes  int64                  `protobuf:"varint,8,opt,name=capacity_bytes,json=capacityBytes,proto3" json:"capacity_bytes,omitempty"`
+	ServerHost     *string                `protobuf:"bytes,9,opt,name=server_host,json=serverHost,proto3,oneof" json:"server_host,omitempty"`
+	SnapshotPolicy *string                `protobuf:"bytes,10,opt,name=snapshot_policy,json=snapshotPolicy,proto3,oneof" json:"snapshot_policy,omitempty"`
+	SnapshotGroup  *string                `protobuf:"bytes,11,opt,name=snapshot_group,json=snapshotGroup,proto3,oneof" json:"snapshot_group,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Snapshot) GetStruct() *structpb.Struct {
+	if x != nil {
+		return x.Struct
+	}
+	return nil
+}
+
+func (x *Snapshot) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Snapshot) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Snapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Snapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Snapshot) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *Snapshot) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *Snapshot) GetCapacityBytes() int64 {
+	if x != nil {
+		return x.CapacityBytes
+	}
+	return 0
+}
+
+func (x *Snapshot) GetServerHost() string {
+	if x != nil && x.ServerHost != nil {
+		return *x.ServerHost
+	}
+	return ""
+}
+
+func (x *Snapshot) GetSnapshotPolicy() string {
+	if x != nil && x.SnapshotPolicy != nil {
+		return *x.SnapshotPolicy
+	}
+	return ""
+}
+
+func (x *Snapshot) GetSnapshotGroup() string {
+	if x != nil && x.SnapshotGroup != nil {
+		return *x.SnapshotGroup
+	}
+	return ""
+}
+
+type GetSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSnapshotRequest) Reset() {
+	*x = GetSnapshotRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnapshotRequest) ProtoMessage() {}
+
+func (x *GetSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetSnapshotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *Snapshot              `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSnapshotResponse) Reset() {
+	*x = GetSnapshotResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnapshotResponse) ProtoMessage() {}
+
+func (x *GetSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*GetSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetSnapshotResponse) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type ListSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter        string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	VolumeId      string                 `protobuf:"bytes,5,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotsRequest) Reset() {
+	*x = ListSnapshotsRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListSnapshotsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSnapshotsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListSnapshotsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListSnapshotsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSnapshotsRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type ListSnapshotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*Snapshot            `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotsResponse) Reset() {
+	*x = ListSnapshotsResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListSnapshotsResponse) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *ListSnapshotsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *Snapshot              `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSnapshotRequest) Reset() {
+	*x = CreateSnapshotRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateSnapshotRequest) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type CreateSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *Snapshot              `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSnapshotResponse) Reset() {
+	*x = CreateSnapshotResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateSnapshotResponse) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type DeleteSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSnapshotRequest) Reset() {
+	*x = DeleteSnapshotRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotRequest) ProtoMessage() {}
+
+func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteSnapshotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSnapshotResponse) Reset() {
+	*x = DeleteSnapshotResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotResponse) ProtoMessage() {}
+
+func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{64}
+}
+
+type SnapshotGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Struct        *structpb.Struct       `protobuf:"bytes,1,opt,name=struct,proto3" json:"struct,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	VolumeIds     []string               `protobuf:"bytes,6,rep,name=volume_ids,json=volumeIds,proto3" json:"volume_ids,omitempty"`
+	SnapshotIds   []string               `protobuf:"bytes,7,rep,name=snapshot_ids,json=snapshotIds,proto3" json:"snapshot_ids,omitempty"`
+	ServerHost    *string                `protobuf:"bytes,8,opt,name=server_host,json=serverHost,proto3,oneof" json:"server_host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotGroup) Reset() {
+	*x = SnapshotGroup{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotGroup) ProtoMessage() {}
+
+func (x *SnapshotGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotGroup.ProtoReflect.Descriptor instead.
+func (*SnapshotGroup) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SnapshotGroup) GetStruct() *structpb.Struct {
+	if x != nil {
+		return x.Struct
+	}
+	return nil
+}
+
+func (x *SnapshotGroup) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *SnapshotGroup) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *SnapshotGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SnapshotGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotGroup) GetVolumeIds() []string {
+	if x != nil {
+		return x.VolumeIds
+	}
+	return nil
+}
+
+func (x *SnapshotGroup) GetSnapshotIds() []string {
+	if x != nil {
+		return x.SnapshotIds
+	}
+	return nil
+}
+
+func (x *SnapshotGroup) GetServerHost() string {
+	if x != nil && x.ServerHost != nil {
+		return *x.ServerHost
+	}
+	return ""
+}
+
+type GetSnapshotGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSnapshotGroupRequest) Reset() {
+	*x = GetSnapshotGroupRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSnapshotGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnapshotGroupRequest) ProtoMessage() {}
+
+func (x *GetSnapshotGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnapshotGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetSnapshotGroupRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetSnapshotGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSnapshotGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotGroup *SnapshotGroup         `protobuf:"bytes,1,opt,name=snapshot_group,json=snapshotGroup,proto3" json:"snapshot_group,omitempty"`
+	Snapshots     []*Snapshot            `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSnapshotGroupResponse) Reset() {
+	*x = GetSnapshotGroupResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSnapshotGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnapshotGroupResponse) ProtoMessage() {}
+
+func (x *GetSnapshotGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnapshotGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetSnapshotGroupResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetSnapshotGroupResponse) GetSnapshotGroup() *SnapshotGroup {
+	if x != nil {
+		return x.SnapshotGroup
+	}
+	return nil
+}
+
+func (x *GetSnapshotGroupResponse) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type CreateSnapshotGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotGroup *SnapshotGroup         `protobuf:"bytes,1,opt,name=snapshot_group,json=snapshotGroup,proto3" json:"snapshot_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSnapshotGroupRequest) Reset() {
+	*x = CreateSnapshotGroupRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSnapshotGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnapshotGroupRequest) ProtoMessage() {}
+
+func (x *CreateSnapshotGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnapshotGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotGroupRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateSnapshotGroupRequest) GetSnapshotGroup() *SnapshotGroup {
+	if x != nil {
+		return x.SnapshotGroup
+	}
+	return nil
+}
+
+type CreateSnapshotGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotGroup *SnapshotGroup         `protobuf:"bytes,1,opt,name=snapshot_group,json=snapshotGroup,proto3" json:"snapshot_group,omitempty"`
+	Snapshots     []*Snapshot            `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSnapshotGroupResponse) Reset() {
+	*x = CreateSnapshotGroupResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSnapshotGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnapshotGroupResponse) ProtoMessage() {}
+
+func (x *CreateSnapshotGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnapshotGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotGroupResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateSnapshotGroupResponse) GetSnapshotGroup() *SnapshotGroup {
+	if x != nil {
+		return x.SnapshotGroup
+	}
+	return nil
+}
+
+func (x *CreateSnapshotGroupResponse) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type DeleteSnapshotGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSnapshotGroupRequest) Reset() {
+	*x = DeleteSnapshotGroupRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSnapshotGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotGroupRequest) ProtoMessage() {}
+
+func (x *DeleteSnapshotGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotGroupRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeleteSnapshotGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSnapshotGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSnapshotGroupResponse) Reset() {
+	*x = DeleteSnapshotGroupResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSnapshotGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotGroupResponse) ProtoMessage() {}
+
+func (x *DeleteSnapshotGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotGroupResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{71}
+}
+
+type RollbackVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SnapshotId    string                 `protobuf:"bytes,2,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	DestroyNewer  bool                   `protobuf:"varint,3,opt,name=destroy_newer,json=destroyNewer,proto3" json:"destroy_newer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackVolumeRequest) Reset() {
+	*x = RollbackVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackVolumeRequest) ProtoMessage() {}
+
+func (x *RollbackVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackVolumeRequest.ProtoReflect.Descriptor instead.
+func (*RollbackVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RollbackVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RollbackVolumeRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *RollbackVolumeRequest) GetDestroyNewer() bool {
+	if x != nil {
+		return x.DestroyNewer
+	}
+	return false
+}
+
+type RollbackVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackVolumeResponse) Reset() {
+	*x = RollbackVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackVolumeResponse) ProtoMessage() {}
+
+func (x *RollbackVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackVolumeResponse.ProtoReflect.Descriptor instead.
+func (*RollbackVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RollbackVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type MigrateVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerHost    string                 `protobuf:"bytes,2,opt,name=server_host,json=serverHost,proto3" json:"server_host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateVolumeRequest) Reset() {
+	*x = MigrateVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateVolumeRequest) ProtoMessage() {}
+
+func (x *MigrateVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateVolumeRequest.ProtoReflect.Descriptor instead.
+func (*MigrateVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *MigrateVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MigrateVolumeRequest) GetServerHost() string {
+	if x != nil {
+		return x.ServerHost
+	}
+	return ""
+}
+
+type MigrateVolumeResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Volume           *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	BytesTransferred int64                  `protobuf:"varint,2,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MigrateVolumeResponse) Reset() {
+	*x = MigrateVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateVolumeResponse) ProtoMessage() {}
+
+func (x *MigrateVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateVolumeResponse.ProtoReflect.Descriptor instead.
+func (*MigrateVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *MigrateVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+func (x *MigrateVolumeResponse) GetBytesTransferred() int64 {
+	if x != nil {
+		return x.BytesTransferred
+	}
+	return 0
+}
+
+type FailoverVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailoverVolumeRequest) Reset() {
+	*x = FailoverVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailoverVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailoverVolumeRequest) ProtoMessage() {}
+
+func (x *FailoverVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailoverVolumeRequest.ProtoReflect.Descriptor instead.
+func (*FailoverVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *FailoverVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FailoverVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailoverVolumeResponse) Reset() {
+	*x = FailoverVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailoverVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailoverVolumeResponse) ProtoMessage() {}
+
+func (x *FailoverVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailoverVolumeResponse.ProtoReflect.Descriptor instead.
+func (*FailoverVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *FailoverVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type VolumeExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Base          string                 `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	ObjectKey     string                 `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	Bytes         int64                  `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeExport) Reset() {
+	*x = VolumeExport{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeExport) ProtoMessage() {}
+
+func (x *VolumeExport) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeExport.ProtoReflect.Descriptor instead.
+func (*VolumeExport) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *VolumeExport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeExport) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *VolumeExport) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *VolumeExport) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *VolumeExport) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ExportVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Incremental   bool                   `protobuf:"varint,2,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportVolumeRequest) Reset() {
+	*x = ExportVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportVolumeRequest) ProtoMessage() {}
+
+func (x *ExportVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ExportVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ExportVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportVolumeRequest) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
+type ExportVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *VolumeExport          `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportVolumeResponse) Reset() {
+	*x = ExportVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportVolumeResponse) ProtoMessage() {}
+
+func (x *ExportVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportVolumeResponse.ProtoReflect.Descriptor instead.
+func (*ExportVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ExportVolumeResponse) GetExport() *VolumeExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type ImportVolumeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerHost     string                 `protobuf:"bytes,2,opt,name=server_host,json=serverHost,proto3" json:"server_host,omitempty"`
+	SourceVolumeId string                 `protobuf:"bytes,3,opt,name=source_volume_id,json=sourceVolumeId,proto3" json:"source_volume_id,omitempty"`
+	Export         string                 `protobuf:"bytes,4,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportVolumeRequest) Reset() {
+	*x = ImportVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVolumeRequest) ProtoMessage() {}
+
+func (x *ImportVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ImportVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ImportVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportVolumeRequest) GetServerHost() string {
+	if x != nil {
+		return x.ServerHost
+	}
+	return ""
+}
+
+func (x *ImportVolumeRequest) GetSourceVolumeId() string {
+	if x != nil {
+		return x.SourceVolumeId
+	}
+	return ""
+}
+
+func (x *ImportVolumeRequest) GetExport() string {
+	if x != nil {
+		return x.Export
+	}
+	return ""
+}
+
+type ImportVolumeResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Volume           *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Exports          []*VolumeExport        `protobuf:"bytes,2,rep,name=exports,proto3" json:"exports,omitempty"`
+	BytesTransferred int64                  `protobuf:"varint,3,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ImportVolumeResponse) Reset() {
+	*x = ImportVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVolumeResponse) ProtoMessage() {}
+
+func (x *ImportVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVolumeResponse.ProtoReflect.Descriptor instead.
+func (*ImportVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ImportVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+func (x *ImportVolumeResponse) GetExports() []*VolumeExport {
+	if x != nil {
+		return x.Exports
+	}
+	return nil
+}
+
+func (x *ImportVolumeResponse) GetBytesTransferred() int64 {
+	if x != nil {
+		return x.BytesTransferred
+	}
+	return 0
+}
+
+type ListVolumeExportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId      string                 `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumeExportsRequest) Reset() {
+	*x = ListVolumeExportsRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumeExportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumeExportsRequest) ProtoMessage() {}
+
+func (x *ListVolumeExportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumeExportsRequest.ProtoReflect.Descriptor instead.
+func (*ListVolumeExportsRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListVolumeExportsRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type ListVolumeExportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exports       []*VolumeExport        `protobuf:"bytes,1,rep,name=exports,proto3" json:"exports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumeExportsResponse) Reset() {
+	*x = ListVolumeExportsResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumeExportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumeExportsResponse) ProtoMessage() {}
+
+func (x *ListVolumeExportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumeExportsResponse.ProtoReflect.Descriptor instead.
+func (*ListVolumeExportsResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListVolumeExportsResponse) GetExports() []*VolumeExport {
+	if x != nil {
+		return x.Exports
+	}
+	return nil
+}
+
+type RotateVolumeKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateVolumeKeyRequest) Reset() {
+	*x = RotateVolumeKeyRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateVolumeKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateVolumeKeyRequest) ProtoMessage() {}
+
+func (x *RotateVolumeKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateVolumeKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateVolumeKeyRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RotateVolumeKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RotateVolumeKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateVolumeKeyResponse) Reset() {
+	*x = RotateVolumeKeyResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateVolumeKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateVolumeKeyResponse) ProtoMessage() {}
+
+func (x *RotateVolumeKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateVolumeKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateVolumeKeyResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RotateVolumeKeyResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type ExpandVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CapacityBytes int64                  `protobuf:"varint,2,opt,name=capacity_bytes,json=capacityBytes,proto3" json:"capacity_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpandVolumeRequest) Reset() {
+	*x = ExpandVolumeRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandVolumeRequest) ProtoMessage() {}
+
+func (x *ExpandVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ExpandVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ExpandVolumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExpandVolumeRequest) GetCapacityBytes() int64 {
+	if x != nil {
+		return x.CapacityBytes
+	}
+	return 0
+}
+
+type ExpandVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpandVolumeResponse) Reset() {
+	*x = ExpandVolumeResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandVolumeResponse) ProtoMessage() {}
+
+func (x *ExpandVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandVolumeResponse.ProtoReflect.Descriptor instead.
+func (*ExpandVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ExpandVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+func (x *ExpandVolumeResponse) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type RotateVolumeCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateVolumeCredentialsRequest) Reset() {
+	*x = RotateVolumeCredentialsRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateVolumeCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateVolumeCredentialsRequest) ProtoMessage() {}
+
+func (x *RotateVolumeCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateVolumeCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*RotateVolumeCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *RotateVolumeCredentialsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RotateVolumeCredentialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateVolumeCredentialsResponse) Reset() {
+	*x = RotateVolumeCredentialsResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateVolumeCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateVolumeCredentialsResponse) ProtoMessage() {}
+
+func (x *RotateVolumeCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateVolumeCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*RotateVolumeCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RotateVolumeCredentialsResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type VolumeProperty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Source        VolumeProperty_Source  `protobuf:"varint,3,opt,name=source,proto3,enum=zfsilo.v1.VolumeProperty_Source" json:"source,omitempty"`
+	InheritedFrom string                 `protobuf:"bytes,4,opt,name=inherited_from,json=inheritedFrom,proto3" json:"inherited_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeProperty) Reset() {
+	*x = VolumeProperty{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeProperty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeProperty) ProtoMessage() {}
+
+func (x *VolumeProperty) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeProperty.ProtoReflect.Descriptor instead.
+func (*VolumeProperty) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *VolumeProperty) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeProperty) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *VolumeProperty) GetSource() VolumeProperty_Source {
+	if x != nil {
+		return x.Source
+	}
+	return VolumeProperty_SOURCE_UNSPECIFIED
+}
+
+func (x *VolumeProperty) GetInheritedFrom() string {
+	if x != nil {
+		return x.InheritedFrom
+	}
+	return ""
+}
+
+type GetVolumePropertiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Names         []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolumePropertiesRequest) Reset() {
+	*x = GetVolumePropertiesRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolumePropertiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumePropertiesRequest) ProtoMessage() {}
+
+func (x *GetVolumePropertiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumePropertiesRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumePropertiesRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetVolumePropertiesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetVolumePropertiesRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type GetVolumePropertiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Properties    []*VolumeProperty      `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolumePropertiesResponse) Reset() {
+	*x = GetVolumePropertiesResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolumePropertiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumePropertiesResponse) ProtoMessage() {}
+
+func (x *GetVolumePropertiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumePropertiesResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumePropertiesResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetVolumePropertiesResponse) GetProperties() []*VolumeProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+type SnapshotPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Struct        *structpb.Struct       `protobuf:"bytes,1,opt,name=struct,proto3" json:"struct,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Schedule      string                 `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	KeepLast      int32                  `protobuf:"varint,7,opt,name=keep_last,json=keepLast,proto3" json:"keep_last,omitempty"`
+	KeepDaily     int32                  `protobuf:"varint,8,opt,name=keep_daily,json=keepDaily,proto3" json:"keep_daily,omitempty"`
+	KeepWeekly    int32                  `protobuf:"varint,9,opt,name=keep_weekly,json=keepWeekly,proto3" json:"keep_weekly,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotPolicy) Reset() {
+	*x = SnapshotPolicy{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotPolicy) ProtoMessage() {}
+
+func (x *SnapshotPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotPolicy.ProtoReflect.Descriptor instead.
+func (*SnapshotPolicy) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SnapshotPolicy) GetStruct() *structpb.Struct {
+	if x != nil {
+		return x.Struct
+	}
+	return nil
+}
+
+func (x *SnapshotPolicy) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *SnapshotPolicy) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *SnapshotPolicy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SnapshotPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotPolicy) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *SnapshotPolicy) GetKeepLast() int32 {
+	if x != nil {
+		return x.KeepLast
+	}
+	return 0
+}
+
+func (x *SnapshotPolicy) GetKeepDaily() int32 {
+	if x != nil {
+		return x.KeepDaily
+	}
+	return 0
+}
+
+func (x *SnapshotPolicy) GetKeepWeekly() int32 {
+	if x != nil {
+		return x.KeepWeekly
+	}
+	return 0
+}
+
+type SnapshotPolicyRun struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	VolumeId         string                    `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	SnapshotPolicyId string                    `protobuf:"bytes,2,opt,name=snapshot_policy_id,json=snapshotPolicyId,proto3" json:"snapshot_policy_id,omitempty"`
+	RunTime          *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=run_time,json=runTime,proto3" json:"run_time,omitempty"`
+	Outcome          SnapshotPolicyRun_Outcome `protobuf:"varint,4,opt,name=outcome,proto3,enum=zfsilo.v1.SnapshotPolicyRun_Outcome" json:"outcome,omitempty"`
+	Message          string                    `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	SnapshotId       string                    `protobuf:"bytes,6,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	PrunedCount      int32                     `protobuf:"varint,7,opt,name=pruned_count,json=prunedCount,proto3" json:"pruned_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SnapshotPolicyRun) Reset() {
+	*x = SnapshotPolicyRun{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotPolicyRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotPolicyRun) ProtoMessage() {}
+
+func (x *SnapshotPolicyRun) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotPolicyRun.ProtoReflect.Descriptor instead.
+func (*SnapshotPolicyRun) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SnapshotPolicyRun) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *SnapshotPolicyRun) GetSnapshotPolicyId() string {
+	if x != nil {
+		return x.SnapshotPolicyId
+	}
+	return ""
+}
+
+func (x *SnapshotPolicyRun) GetRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunTime
+	}
+	return nil
+}
+
+func (x *SnapshotPolicyRun) GetOutcome() SnapshotPolicyRun_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return SnapshotPolicyRun_OUTCOME_UNSPECIFIED
+}
+
+func (x *SnapshotPolicyRun) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SnapshotPolicyRun) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *SnapshotPolicyRun) GetPrunedCount() int32 {
+	if x != nil {
+		return x.PrunedCount
+	}
+	return 0
+}
+
+type GetSnapshotPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSnapshotPolicyRequest) Reset() {
+	*x = GetSnapshotPolicyRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSnapshotPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnapshotPolicyRequest) ProtoMessage() {}
+
+func (x *GetSnapshotPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnapshotPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetSnapshotPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetSnapshotPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSnapshotPolicyResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotPolicy *SnapshotPolicy        `protobuf:"bytes,1,opt,name=snapshot_policy,json=snapshotPolicy,proto3" json:"snapshot_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSnapshotPolicyResponse) Reset() {
+	*x = GetSnapshotPolicyResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSnapshotPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnapshotPolicyResponse) ProtoMessage() {}
+
+func (x *GetSnapshotPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnapshotPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetSnapshotPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetSnapshotPolicyResponse) GetSnapshotPolicy() *SnapshotPolicy {
+	if x != nil {
+		return x.SnapshotPolicy
+	}
+	return nil
+}
+
+type ListSnapshotPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter        string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotPoliciesRequest) Reset() {
+	*x = ListSnapshotPoliciesRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PublishVolumeRequest) String() string {
+func (x *ListSnapshotPoliciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishVolumeRequest) ProtoMessage() {}
+func (*ListSnapshotPoliciesRequest) ProtoMessage() {}
 
-func (x *PublishVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[24]
+func (x *ListSnapshotPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1544,54 +5960,62 @@ func (x *PublishVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishVolumeRequest.ProtoReflect.Descriptor instead.
-func (*PublishVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListSnapshotPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *PublishVolumeRequest) GetId() string {
+func (x *ListSnapshotPoliciesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Id
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSnapshotPoliciesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
 	}
 	return ""
 }
 
-func (x *PublishVolumeRequest) GetTransport() Volume_Transport {
+func (x *ListSnapshotPoliciesRequest) GetOrderBy() string {
 	if x != nil {
-		return x.Transport
+		return x.OrderBy
 	}
-	return Volume_TRANSPORT_UNSPECIFIED
+	return ""
 }
 
-func (x *PublishVolumeRequest) GetServerHost() string {
+func (x *ListSnapshotPoliciesRequest) GetPageToken() string {
 	if x != nil {
-		return x.ServerHost
+		return x.PageToken
 	}
 	return ""
 }
 
-type PublishVolumeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListSnapshotPoliciesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotPolicies []*SnapshotPolicy      `protobuf:"bytes,1,rep,name=snapshot_policies,json=snapshotPolicies,proto3" json:"snapshot_policies,omitempty"`
+	NextPageToken    string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *PublishVolumeResponse) Reset() {
-	*x = PublishVolumeResponse{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[25]
+func (x *ListSnapshotPoliciesResponse) Reset() {
+	*x = ListSnapshotPoliciesResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PublishVolumeResponse) String() string {
+func (x *ListSnapshotPoliciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishVolumeResponse) ProtoMessage() {}
+func (*ListSnapshotPoliciesResponse) ProtoMessage() {}
 
-func (x *PublishVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[25]
+func (x *ListSnapshotPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,40 +6026,47 @@ func (x *PublishVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishVolumeResponse.ProtoReflect.Descriptor instead.
-func (*PublishVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ListSnapshotPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *PublishVolumeResponse) GetVolume() *Volume {
+func (x *ListSnapshotPoliciesResponse) GetSnapshotPolicies() []*SnapshotPolicy {
 	if x != nil {
-		return x.Volume
+		return x.SnapshotPolicies
 	}
 	return nil
 }
 
-type UnpublishVolumeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListSnapshotPoliciesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
 }
 
-func (x *UnpublishVolumeRequest) Reset() {
-	*x = UnpublishVolumeRequest{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[26]
+type CreateSnapshotPolicyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotPolicy *SnapshotPolicy        `protobuf:"bytes,1,opt,name=snapshot_policy,json=snapshotPolicy,proto3" json:"snapshot_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateSnapshotPolicyRequest) Reset() {
+	*x = CreateSnapshotPolicyRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnpublishVolumeRequest) String() string {
+func (x *CreateSnapshotPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnpublishVolumeRequest) ProtoMessage() {}
+func (*CreateSnapshotPolicyRequest) ProtoMessage() {}
 
-func (x *UnpublishVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[26]
+func (x *CreateSnapshotPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1646,40 +6077,40 @@ func (x *UnpublishVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnpublishVolumeRequest.ProtoReflect.Descriptor instead.
-func (*UnpublishVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CreateSnapshotPolicyRequest.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *UnpublishVolumeRequest) GetId() string {
+func (x *CreateSnapshotPolicyRequest) GetSnapshotPolicy() *SnapshotPolicy {
 	if x != nil {
-		return x.Id
+		return x.SnapshotPolicy
 	}
-	return ""
+	return nil
 }
 
-type UnpublishVolumeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateSnapshotPolicyResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotPolicy *SnapshotPolicy        `protobuf:"bytes,1,opt,name=snapshot_policy,json=snapshotPolicy,proto3" json:"snapshot_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UnpublishVolumeResponse) Reset() {
-	*x = UnpublishVolumeResponse{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[27]
+func (x *CreateSnapshotPolicyResponse) Reset() {
+	*x = CreateSnapshotPolicyResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnpublishVolumeResponse) String() string {
+func (x *CreateSnapshotPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnpublishVolumeResponse) ProtoMessage() {}
+func (*CreateSnapshotPolicyResponse) ProtoMessage() {}
 
-func (x *UnpublishVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[27]
+func (x *CreateSnapshotPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1690,41 +6121,40 @@ func (x *UnpublishVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnpublishVolumeResponse.ProtoReflect.Descriptor instead.
-func (*UnpublishVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use CreateSnapshotPolicyResponse.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *UnpublishVolumeResponse) GetVolume() *Volume {
+func (x *CreateSnapshotPolicyResponse) GetSnapshotPolicy() *SnapshotPolicy {
 	if x != nil {
-		return x.Volume
+		return x.SnapshotPolicy
 	}
 	return nil
 }
 
-type ConnectVolumeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ClientHost    string                 `protobuf:"bytes,2,opt,name=client_host,json=clientHost,proto3" json:"client_host,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateSnapshotPolicyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotPolicy *structpb.Struct       `protobuf:"bytes,1,opt,name=snapshot_policy,json=snapshotPolicy,proto3" json:"snapshot_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ConnectVolumeRequest) Reset() {
-	*x = ConnectVolumeRequest{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[28]
+func (x *UpdateSnapshotPolicyRequest) Reset() {
+	*x = UpdateSnapshotPolicyRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectVolumeRequest) String() string {
+func (x *UpdateSnapshotPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectVolumeRequest) ProtoMessage() {}
+func (*UpdateSnapshotPolicyRequest) ProtoMessage() {}
 
-func (x *ConnectVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[28]
+func (x *UpdateSnapshotPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,47 +6165,84 @@ func (x *ConnectVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectVolumeRequest.ProtoReflect.Descriptor instead.
-func (*ConnectVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UpdateSnapshotPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSnapshotPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *ConnectVolumeRequest) GetId() string {
+func (x *UpdateSnapshotPolicyRequest) GetSnapshotPolicy() *structpb.Struct {
 	if x != nil {
-		return x.Id
+		return x.SnapshotPolicy
 	}
-	return ""
+	return nil
 }
 
-func (x *ConnectVolumeRequest) GetClientHost() string {
+type UpdateSnapshotPolicyResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotPolicy *SnapshotPolicy        `protobuf:"bytes,1,opt,name=snapshot_policy,json=snapshotPolicy,proto3" json:"snapshot_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateSnapshotPolicyResponse) Reset() {
+	*x = UpdateSnapshotPolicyResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSnapshotPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSnapshotPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateSnapshotPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[103]
 	if x != nil {
-		return x.ClientHost
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type ConnectVolumeResponse struct {
+// Deprecated: Use UpdateSnapshotPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSnapshotPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdateSnapshotPolicyResponse) GetSnapshotPolicy() *SnapshotPolicy {
+	if x != nil {
+		return x.SnapshotPolicy
+	}
+	return nil
+}
+
+type DeleteSnapshotPolicyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectVolumeResponse) Reset() {
-	*x = ConnectVolumeResponse{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[29]
+func (x *DeleteSnapshotPolicyRequest) Reset() {
+	*x = DeleteSnapshotPolicyRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectVolumeResponse) String() string {
+func (x *DeleteSnapshotPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectVolumeResponse) ProtoMessage() {}
+func (*DeleteSnapshotPolicyRequest) ProtoMessage() {}
 
-func (x *ConnectVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[29]
+func (x *DeleteSnapshotPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1786,40 +6253,39 @@ func (x *ConnectVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectVolumeResponse.ProtoReflect.Descriptor instead.
-func (*ConnectVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use DeleteSnapshotPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *ConnectVolumeResponse) GetVolume() *Volume {
+func (x *DeleteSnapshotPolicyRequest) GetId() string {
 	if x != nil {
-		return x.Volume
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type DisconnectVolumeRequest struct {
+type DeleteSnapshotPolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisconnectVolumeRequest) Reset() {
-	*x = DisconnectVolumeRequest{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[30]
+func (x *DeleteSnapshotPolicyResponse) Reset() {
+	*x = DeleteSnapshotPolicyResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisconnectVolumeRequest) String() string {
+func (x *DeleteSnapshotPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectVolumeRequest) ProtoMessage() {}
+func (*DeleteSnapshotPolicyResponse) ProtoMessage() {}
 
-func (x *DisconnectVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[30]
+func (x *DeleteSnapshotPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1830,40 +6296,118 @@ func (x *DisconnectVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectVolumeRequest.ProtoReflect.Descriptor instead.
-func (*DisconnectVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use DeleteSnapshotPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *DisconnectVolumeRequest) GetId() string {
+type ListSnapshotPolicyRunsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PageSize         int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter           string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy          string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	PageToken        string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SnapshotPolicyId string                 `protobuf:"bytes,5,opt,name=snapshot_policy_id,json=snapshotPolicyId,proto3" json:"snapshot_policy_id,omitempty"`
+	VolumeId         string                 `protobuf:"bytes,6,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListSnapshotPolicyRunsRequest) Reset() {
+	*x = ListSnapshotPolicyRunsRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotPolicyRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotPolicyRunsRequest) ProtoMessage() {}
+
+func (x *ListSnapshotPolicyRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[106]
 	if x != nil {
-		return x.Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotPolicyRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotPolicyRunsRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListSnapshotPolicyRunsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSnapshotPolicyRunsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
 	}
 	return ""
 }
 
-type DisconnectVolumeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListSnapshotPolicyRunsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
 }
 
-func (x *DisconnectVolumeResponse) Reset() {
-	*x = DisconnectVolumeResponse{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[31]
+func (x *ListSnapshotPolicyRunsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSnapshotPolicyRunsRequest) GetSnapshotPolicyId() string {
+	if x != nil {
+		return x.SnapshotPolicyId
+	}
+	return ""
+}
+
+func (x *ListSnapshotPolicyRunsRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type ListSnapshotPolicyRunsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotPolicyRuns []*SnapshotPolicyRun   `protobuf:"bytes,1,rep,name=snapshot_policy_runs,json=snapshotPolicyRuns,proto3" json:"snapshot_policy_runs,omitempty"`
+	NextPageToken      string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListSnapshotPolicyRunsResponse) Reset() {
+	*x = ListSnapshotPolicyRunsResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisconnectVolumeResponse) String() string {
+func (x *ListSnapshotPolicyRunsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectVolumeResponse) ProtoMessage() {}
+func (*ListSnapshotPolicyRunsResponse) ProtoMessage() {}
 
-func (x *DisconnectVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[31]
+func (x *ListSnapshotPolicyRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1874,41 +6418,57 @@ func (x *DisconnectVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectVolumeResponse.ProtoReflect.Descriptor instead.
-func (*DisconnectVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ListSnapshotPolicyRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotPolicyRunsResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *DisconnectVolumeResponse) GetVolume() *Volume {
+func (x *ListSnapshotPolicyRunsResponse) GetSnapshotPolicyRuns() []*SnapshotPolicyRun {
 	if x != nil {
-		return x.Volume
+		return x.SnapshotPolicyRuns
 	}
 	return nil
 }
 
-type StageVolumeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	StagingPath   string                 `protobuf:"bytes,2,opt,name=staging_path,json=stagingPath,proto3" json:"staging_path,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListSnapshotPolicyRunsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
 }
 
-func (x *StageVolumeRequest) Reset() {
-	*x = StageVolumeRequest{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[32]
+type Replication struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Struct          *structpb.Struct       `protobuf:"bytes,1,opt,name=struct,proto3" json:"struct,omitempty"`
+	CreateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Id              string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	VolumeId        string                 `protobuf:"bytes,6,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	TargetHost      string                 `protobuf:"bytes,7,opt,name=target_host,json=targetHost,proto3" json:"target_host,omitempty"`
+	TargetDatasetId string                 `protobuf:"bytes,8,opt,name=target_dataset_id,json=targetDatasetId,proto3" json:"target_dataset_id,omitempty"`
+	IntervalSeconds int32                  `protobuf:"varint,9,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	Status          *Replication_Status    `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	Standby         bool                   `protobuf:"varint,11,opt,name=standby,proto3" json:"standby,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Replication) Reset() {
+	*x = Replication{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StageVolumeRequest) String() string {
+func (x *Replication) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StageVolumeRequest) ProtoMessage() {}
+func (*Replication) ProtoMessage() {}
 
-func (x *StageVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[32]
+func (x *Replication) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1919,91 +6479,110 @@ func (x *StageVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StageVolumeRequest.ProtoReflect.Descriptor instead.
-func (*StageVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use Replication.ProtoReflect.Descriptor instead.
+func (*Replication) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *StageVolumeRequest) GetId() string {
+func (x *Replication) GetStruct() *structpb.Struct {
+	if x != nil {
+		return x.Struct
+	}
+	return nil
+}
+
+func (x *Replication) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Replication) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Replication) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *StageVolumeRequest) GetStagingPath() string {
+func (x *Replication) GetName() string {
 	if x != nil {
-		return x.StagingPath
+		return x.Name
 	}
 	return ""
 }
 
-type StageVolumeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Replication) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
 }
 
-func (x *StageVolumeResponse) Reset() {
-	*x = StageVolumeResponse{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[33]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Replication) GetTargetHost() string {
+	if x != nil {
+		return x.TargetHost
+	}
+	return ""
 }
 
-func (x *StageVolumeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Replication) GetTargetDatasetId() string {
+	if x != nil {
+		return x.TargetDatasetId
+	}
+	return ""
 }
 
-func (*StageVolumeResponse) ProtoMessage() {}
-
-func (x *StageVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[33]
+func (x *Replication) GetIntervalSeconds() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IntervalSeconds
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use StageVolumeResponse.ProtoReflect.Descriptor instead.
-func (*StageVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{33}
+func (x *Replication) GetStatus() *Replication_Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
 }
 
-func (x *StageVolumeResponse) GetVolume() *Volume {
+func (x *Replication) GetStandby() bool {
 	if x != nil {
-		return x.Volume
+		return x.Standby
 	}
-	return nil
+	return false
 }
 
-type UnstageVolumeRequest struct {
+type GetReplicationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnstageVolumeRequest) Reset() {
-	*x = UnstageVolumeRequest{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[34]
+func (x *GetReplicationRequest) Reset() {
+	*x = GetReplicationRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnstageVolumeRequest) String() string {
+func (x *GetReplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnstageVolumeRequest) ProtoMessage() {}
+func (*GetReplicationRequest) ProtoMessage() {}
 
-func (x *UnstageVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[34]
+func (x *GetReplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2014,40 +6593,40 @@ func (x *UnstageVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnstageVolumeRequest.ProtoReflect.Descriptor instead.
-func (*UnstageVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GetReplicationRequest.ProtoReflect.Descriptor instead.
+func (*GetReplicationRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *UnstageVolumeRequest) GetId() string {
+func (x *GetReplicationRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type UnstageVolumeResponse struct {
+type GetReplicationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Replication   *Replication           `protobuf:"bytes,1,opt,name=replication,proto3" json:"replication,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnstageVolumeResponse) Reset() {
-	*x = UnstageVolumeResponse{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[35]
+func (x *GetReplicationResponse) Reset() {
+	*x = GetReplicationResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnstageVolumeResponse) String() string {
+func (x *GetReplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnstageVolumeResponse) ProtoMessage() {}
+func (*GetReplicationResponse) ProtoMessage() {}
 
-func (x *UnstageVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[35]
+func (x *GetReplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2058,41 +6637,44 @@ func (x *UnstageVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnstageVolumeResponse.ProtoReflect.Descriptor instead.
-func (*UnstageVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use GetReplicationResponse.ProtoReflect.Descriptor instead.
+func (*GetReplicationResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *UnstageVolumeResponse) GetVolume() *Volume {
+func (x *GetReplicationResponse) GetReplication() *Replication {
 	if x != nil {
-		return x.Volume
+		return x.Replication
 	}
 	return nil
 }
 
-type MountVolumeRequest struct {
+type ListReplicationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	MountPath     string                 `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter        string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	VolumeId      string                 `protobuf:"bytes,5,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MountVolumeRequest) Reset() {
-	*x = MountVolumeRequest{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[36]
+func (x *ListReplicationsRequest) Reset() {
+	*x = ListReplicationsRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MountVolumeRequest) String() string {
+func (x *ListReplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MountVolumeRequest) ProtoMessage() {}
+func (*ListReplicationsRequest) ProtoMessage() {}
 
-func (x *MountVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[36]
+func (x *ListReplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2103,47 +6685,69 @@ func (x *MountVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MountVolumeRequest.ProtoReflect.Descriptor instead.
-func (*MountVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ListReplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListReplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *MountVolumeRequest) GetId() string {
+func (x *ListReplicationsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Id
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReplicationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
 	}
 	return ""
 }
 
-func (x *MountVolumeRequest) GetMountPath() string {
+func (x *ListReplicationsRequest) GetOrderBy() string {
 	if x != nil {
-		return x.MountPath
+		return x.OrderBy
 	}
 	return ""
 }
 
-type MountVolumeResponse struct {
+func (x *ListReplicationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListReplicationsRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type ListReplicationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Replications  []*Replication         `protobuf:"bytes,1,rep,name=replications,proto3" json:"replications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MountVolumeResponse) Reset() {
-	*x = MountVolumeResponse{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[37]
+func (x *ListReplicationsResponse) Reset() {
+	*x = ListReplicationsResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MountVolumeResponse) String() string {
+func (x *ListReplicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MountVolumeResponse) ProtoMessage() {}
+func (*ListReplicationsResponse) ProtoMessage() {}
 
-func (x *MountVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[37]
+func (x *ListReplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2154,41 +6758,47 @@ func (x *MountVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MountVolumeResponse.ProtoReflect.Descriptor instead.
-func (*MountVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListReplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *MountVolumeResponse) GetVolume() *Volume {
+func (x *ListReplicationsResponse) GetReplications() []*Replication {
 	if x != nil {
-		return x.Volume
+		return x.Replications
 	}
 	return nil
 }
 
-type UnmountVolumeRequest struct {
+func (x *ListReplicationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateReplicationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	MountPath     string                 `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	Replication   *Replication           `protobuf:"bytes,1,opt,name=replication,proto3" json:"replication,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnmountVolumeRequest) Reset() {
-	*x = UnmountVolumeRequest{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[38]
+func (x *CreateReplicationRequest) Reset() {
+	*x = CreateReplicationRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnmountVolumeRequest) String() string {
+func (x *CreateReplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnmountVolumeRequest) ProtoMessage() {}
+func (*CreateReplicationRequest) ProtoMessage() {}
 
-func (x *UnmountVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[38]
+func (x *CreateReplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2199,47 +6809,40 @@ func (x *UnmountVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnmountVolumeRequest.ProtoReflect.Descriptor instead.
-func (*UnmountVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *UnmountVolumeRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use CreateReplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateReplicationRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *UnmountVolumeRequest) GetMountPath() string {
+func (x *CreateReplicationRequest) GetReplication() *Replication {
 	if x != nil {
-		return x.MountPath
+		return x.Replication
 	}
-	return ""
+	return nil
 }
 
-type UnmountVolumeResponse struct {
+type CreateReplicationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Replication   *Replication           `protobuf:"bytes,1,opt,name=replication,proto3" json:"replication,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnmountVolumeResponse) Reset() {
-	*x = UnmountVolumeResponse{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[39]
+func (x *CreateReplicationResponse) Reset() {
+	*x = CreateReplicationResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnmountVolumeResponse) String() string {
+func (x *CreateReplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnmountVolumeResponse) ProtoMessage() {}
+func (*CreateReplicationResponse) ProtoMessage() {}
 
-func (x *UnmountVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[39]
+func (x *CreateReplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2250,40 +6853,40 @@ func (x *UnmountVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnmountVolumeResponse.ProtoReflect.Descriptor instead.
-func (*UnmountVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use CreateReplicationResponse.ProtoReflect.Descriptor instead.
+func (*CreateReplicationResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *UnmountVolumeResponse) GetVolume() *Volume {
+func (x *CreateReplicationResponse) GetReplication() *Replication {
 	if x != nil {
-		return x.Volume
+		return x.Replication
 	}
 	return nil
 }
 
-type StatsVolumeRequest struct {
+type UpdateReplicationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Replication   *structpb.Struct       `protobuf:"bytes,1,opt,name=replication,proto3" json:"replication,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatsVolumeRequest) Reset() {
-	*x = StatsVolumeRequest{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[40]
+func (x *UpdateReplicationRequest) Reset() {
+	*x = UpdateReplicationRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatsVolumeRequest) String() string {
+func (x *UpdateReplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsVolumeRequest) ProtoMessage() {}
+func (*UpdateReplicationRequest) ProtoMessage() {}
 
-func (x *StatsVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[40]
+func (x *UpdateReplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2294,40 +6897,40 @@ func (x *StatsVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsVolumeRequest.ProtoReflect.Descriptor instead.
-func (*StatsVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use UpdateReplicationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReplicationRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *StatsVolumeRequest) GetId() string {
+func (x *UpdateReplicationRequest) GetReplication() *structpb.Struct {
 	if x != nil {
-		return x.Id
+		return x.Replication
 	}
-	return ""
+	return nil
 }
 
-type StatsVolumeResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Stats         *StatsVolumeResponse_Stats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+type UpdateReplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replication   *Replication           `protobuf:"bytes,1,opt,name=replication,proto3" json:"replication,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatsVolumeResponse) Reset() {
-	*x = StatsVolumeResponse{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[41]
+func (x *UpdateReplicationResponse) Reset() {
+	*x = UpdateReplicationResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatsVolumeResponse) String() string {
+func (x *UpdateReplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsVolumeResponse) ProtoMessage() {}
+func (*UpdateReplicationResponse) ProtoMessage() {}
 
-func (x *StatsVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[41]
+func (x *UpdateReplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2338,40 +6941,40 @@ func (x *StatsVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsVolumeResponse.ProtoReflect.Descriptor instead.
-func (*StatsVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use UpdateReplicationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReplicationResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *StatsVolumeResponse) GetStats() *StatsVolumeResponse_Stats {
+func (x *UpdateReplicationResponse) GetReplication() *Replication {
 	if x != nil {
-		return x.Stats
+		return x.Replication
 	}
 	return nil
 }
 
-type SyncVolumeRequest struct {
+type DeleteReplicationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncVolumeRequest) Reset() {
-	*x = SyncVolumeRequest{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[42]
+func (x *DeleteReplicationRequest) Reset() {
+	*x = DeleteReplicationRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncVolumeRequest) String() string {
+func (x *DeleteReplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncVolumeRequest) ProtoMessage() {}
+func (*DeleteReplicationRequest) ProtoMessage() {}
 
-func (x *SyncVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[42]
+func (x *DeleteReplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2382,39 +6985,39 @@ func (x *SyncVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncVolumeRequest.ProtoReflect.Descriptor instead.
-func (*SyncVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use DeleteReplicationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReplicationRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *SyncVolumeRequest) GetId() string {
+func (x *DeleteReplicationRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type SyncVolumeResponse struct {
+type DeleteReplicationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncVolumeResponse) Reset() {
-	*x = SyncVolumeResponse{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[43]
+func (x *DeleteReplicationResponse) Reset() {
+	*x = DeleteReplicationResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncVolumeResponse) String() string {
+func (x *DeleteReplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncVolumeResponse) ProtoMessage() {}
+func (*DeleteReplicationResponse) ProtoMessage() {}
 
-func (x *SyncVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[43]
+func (x *DeleteReplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2425,32 +7028,33 @@ func (x *SyncVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncVolumeResponse.ProtoReflect.Descriptor instead.
-func (*SyncVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use DeleteReplicationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReplicationResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{118}
 }
 
-type SyncVolumesRequest struct {
+type SyncReplicationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncVolumesRequest) Reset() {
-	*x = SyncVolumesRequest{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[44]
+func (x *SyncReplicationRequest) Reset() {
+	*x = SyncReplicationRequest{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncVolumesRequest) String() string {
+func (x *SyncReplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncVolumesRequest) ProtoMessage() {}
+func (*SyncReplicationRequest) ProtoMessage() {}
 
-func (x *SyncVolumesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[44]
+func (x *SyncReplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2461,32 +7065,40 @@ func (x *SyncVolumesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncVolumesRequest.ProtoReflect.Descriptor instead.
-func (*SyncVolumesRequest) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use SyncReplicationRequest.ProtoReflect.Descriptor instead.
+func (*SyncReplicationRequest) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{119}
 }
 
-type SyncVolumesResponse struct {
+func (x *SyncReplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SyncReplicationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replication   *Replication           `protobuf:"bytes,1,opt,name=replication,proto3" json:"replication,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncVolumesResponse) Reset() {
-	*x = SyncVolumesResponse{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[45]
+func (x *SyncReplicationResponse) Reset() {
+	*x = SyncReplicationResponse{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncVolumesResponse) String() string {
+func (x *SyncReplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncVolumesResponse) ProtoMessage() {}
+func (*SyncReplicationResponse) ProtoMessage() {}
 
-func (x *SyncVolumesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[45]
+func (x *SyncReplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2497,9 +7109,16 @@ func (x *SyncVolumesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncVolumesResponse.ProtoReflect.Descriptor instead.
-func (*SyncVolumesResponse) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use SyncReplicationResponse.ProtoReflect.Descriptor instead.
+func (*SyncReplicationResponse) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *SyncReplicationResponse) GetReplication() *Replication {
+	if x != nil {
+		return x.Replication
+	}
+	return nil
 }
 
 type Host_Connection struct {
@@ -2515,7 +7134,7 @@ type Host_Connection struct {
 
 func (x *Host_Connection) Reset() {
 	*x = Host_Connection{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[46]
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2527,7 +7146,7 @@ func (x *Host_Connection) String() string {
 func (*Host_Connection) ProtoMessage() {}
 
 func (x *Host_Connection) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[46]
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2597,7 +7216,7 @@ type Host_Role struct {
 
 func (x *Host_Role) Reset() {
 	*x = Host_Role{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[47]
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2609,7 +7228,7 @@ func (x *Host_Role) String() string {
 func (*Host_Role) ProtoMessage() {}
 
 func (x *Host_Role) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[47]
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2666,6 +7285,66 @@ func (*Host_Role_Server_) isHost_Role_Type() {}
 
 func (*Host_Role_Client_) isHost_Role_Type() {}
 
+type Host_Status struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastPollTime  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_poll_time,json=lastPollTime,proto3" json:"last_poll_time,omitempty"`
+	LastError     string                 `protobuf:"bytes,2,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Pools         []*PoolStatus          `protobuf:"bytes,3,rep,name=pools,proto3" json:"pools,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Host_Status) Reset() {
+	*x = Host_Status{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Host_Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Host_Status) ProtoMessage() {}
+
+func (x *Host_Status) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Host_Status.ProtoReflect.Descriptor instead.
+func (*Host_Status) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Host_Status) GetLastPollTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPollTime
+	}
+	return nil
+}
+
+func (x *Host_Status) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Host_Status) GetPools() []*PoolStatus {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
 type Host_Connection_Local struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RunAsRoot     bool                   `protobuf:"varint,1,opt,name=run_as_root,json=runAsRoot,proto3" json:"run_as_root,omitempty"`
@@ -2675,7 +7354,7 @@ type Host_Connection_Local struct {
 
 func (x *Host_Connection_Local) Reset() {
 	*x = Host_Connection_Local{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[48]
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2687,7 +7366,203 @@ func (x *Host_Connection_Local) String() string {
 func (*Host_Connection_Local) ProtoMessage() {}
 
 func (x *Host_Connection_Local) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[48]
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Host_Connection_Local.ProtoReflect.Descriptor instead.
+func (*Host_Connection_Local) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{2, 0, 0}
+}
+
+func (x *Host_Connection_Local) GetRunAsRoot() bool {
+	if x != nil {
+		return x.RunAsRoot
+	}
+	return false
+}
+
+type Host_Connection_Remote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	RunAsRoot     bool                   `protobuf:"varint,5,opt,name=run_as_root,json=runAsRoot,proto3" json:"run_as_root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Host_Connection_Remote) Reset() {
+	*x = Host_Connection_Remote{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Host_Connection_Remote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Host_Connection_Remote) ProtoMessage() {}
+
+func (x *Host_Connection_Remote) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Host_Connection_Remote.ProtoReflect.Descriptor instead.
+func (*Host_Connection_Remote) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{2, 0, 1}
+}
+
+func (x *Host_Connection_Remote) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Host_Connection_Remote) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Host_Connection_Remote) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Host_Connection_Remote) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Host_Connection_Remote) GetRunAsRoot() bool {
+	if x != nil {
+		return x.RunAsRoot
+	}
+	return false
+}
+
+type Host_Role_Server struct {
+	state          protoimpl.MessageState         `protogen:"open.v1"`
+	Endpoint       string                         `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Datasets       []string                       `protobuf:"bytes,2,rep,name=datasets,proto3" json:"datasets,omitempty"`
+	ScrubSchedules map[string]string              `protobuf:"bytes,3,rep,name=scrub_schedules,json=scrubSchedules,proto3" json:"scrub_schedules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DataAddresses  []string                       `protobuf:"bytes,4,rep,name=data_addresses,json=dataAddresses,proto3" json:"data_addresses,omitempty"`
+	NvmeofPorts    []*Host_Role_Server_NvmeofPort `protobuf:"bytes,5,rep,name=nvmeof_ports,json=nvmeofPorts,proto3" json:"nvmeof_ports,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Host_Role_Server) Reset() {
+	*x = Host_Role_Server{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Host_Role_Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Host_Role_Server) ProtoMessage() {}
+
+func (x *Host_Role_Server) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Host_Role_Server.ProtoReflect.Descriptor instead.
+func (*Host_Role_Server) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{2, 1, 0}
+}
+
+func (x *Host_Role_Server) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Host_Role_Server) GetDatasets() []string {
+	if x != nil {
+		return x.Datasets
+	}
+	return nil
+}
+
+func (x *Host_Role_Server) GetScrubSchedules() map[string]string {
+	if x != nil {
+		return x.ScrubSchedules
+	}
+	return nil
+}
+
+func (x *Host_Role_Server) GetDataAddresses() []string {
+	if x != nil {
+		return x.DataAddresses
+	}
+	return nil
+}
+
+func (x *Host_Role_Server) GetNvmeofPorts() []*Host_Role_Server_NvmeofPort {
+	if x != nil {
+		return x.NvmeofPorts
+	}
+	return nil
+}
+
+type Host_Role_Client struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Host_Role_Client) Reset() {
+	*x = Host_Role_Client{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Host_Role_Client) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Host_Role_Client) ProtoMessage() {}
+
+func (x *Host_Role_Client) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2698,44 +7573,43 @@ func (x *Host_Connection_Local) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Host_Connection_Local.ProtoReflect.Descriptor instead.
-func (*Host_Connection_Local) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{2, 0, 0}
+// Deprecated: Use Host_Role_Client.ProtoReflect.Descriptor instead.
+func (*Host_Role_Client) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{2, 1, 1}
 }
 
-func (x *Host_Connection_Local) GetRunAsRoot() bool {
+func (x *Host_Role_Client) GetEndpoint() string {
 	if x != nil {
-		return x.RunAsRoot
+		return x.Endpoint
 	}
-	return false
+	return ""
 }
 
-type Host_Connection_Remote struct {
+type Host_Role_Server_NvmeofPort struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	RunAsRoot     bool                   `protobuf:"varint,5,opt,name=run_as_root,json=runAsRoot,proto3" json:"run_as_root,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ServiceId     int32                  `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Tls           bool                   `protobuf:"varint,4,opt,name=tls,proto3" json:"tls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Host_Connection_Remote) Reset() {
-	*x = Host_Connection_Remote{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[49]
+func (x *Host_Role_Server_NvmeofPort) Reset() {
+	*x = Host_Role_Server_NvmeofPort{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Host_Connection_Remote) String() string {
+func (x *Host_Role_Server_NvmeofPort) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Host_Connection_Remote) ProtoMessage() {}
+func (*Host_Role_Server_NvmeofPort) ProtoMessage() {}
 
-func (x *Host_Connection_Remote) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[49]
+func (x *Host_Role_Server_NvmeofPort) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2746,68 +7620,62 @@ func (x *Host_Connection_Remote) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Host_Connection_Remote.ProtoReflect.Descriptor instead.
-func (*Host_Connection_Remote) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{2, 0, 1}
-}
-
-func (x *Host_Connection_Remote) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use Host_Role_Server_NvmeofPort.ProtoReflect.Descriptor instead.
+func (*Host_Role_Server_NvmeofPort) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{2, 1, 0, 0}
 }
 
-func (x *Host_Connection_Remote) GetPort() int32 {
+func (x *Host_Role_Server_NvmeofPort) GetId() int32 {
 	if x != nil {
-		return x.Port
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Host_Connection_Remote) GetUsername() string {
+func (x *Host_Role_Server_NvmeofPort) GetAddress() string {
 	if x != nil {
-		return x.Username
+		return x.Address
 	}
 	return ""
 }
 
-func (x *Host_Connection_Remote) GetPassword() string {
+func (x *Host_Role_Server_NvmeofPort) GetServiceId() int32 {
 	if x != nil {
-		return x.Password
+		return x.ServiceId
 	}
-	return ""
+	return 0
 }
 
-func (x *Host_Connection_Remote) GetRunAsRoot() bool {
+func (x *Host_Role_Server_NvmeofPort) GetTls() bool {
 	if x != nil {
-		return x.RunAsRoot
+		return x.Tls
 	}
 	return false
 }
 
-type Host_Role_Server struct {
+type RotateHostKeyResponse_VolumeResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Volume        string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Host_Role_Server) Reset() {
-	*x = Host_Role_Server{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[50]
+func (x *RotateHostKeyResponse_VolumeResult) Reset() {
+	*x = RotateHostKeyResponse_VolumeResult{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Host_Role_Server) String() string {
+func (x *RotateHostKeyResponse_VolumeResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Host_Role_Server) ProtoMessage() {}
+func (*RotateHostKeyResponse_VolumeResult) ProtoMessage() {}
 
-func (x *Host_Role_Server) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[50]
+func (x *RotateHostKeyResponse_VolumeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2818,39 +7686,52 @@ func (x *Host_Role_Server) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Host_Role_Server.ProtoReflect.Descriptor instead.
-func (*Host_Role_Server) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{2, 1, 0}
+// Deprecated: Use RotateHostKeyResponse_VolumeResult.ProtoReflect.Descriptor instead.
+func (*RotateHostKeyResponse_VolumeResult) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{14, 0}
 }
 
-func (x *Host_Role_Server) GetEndpoint() string {
+func (x *RotateHostKeyResponse_VolumeResult) GetVolume() string {
 	if x != nil {
-		return x.Endpoint
+		return x.Volume
 	}
 	return ""
 }
 
-type Host_Role_Client struct {
+func (x *RotateHostKeyResponse_VolumeResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PoolStatus_Scrub struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         PoolStatus_Scrub_State `protobuf:"varint,1,opt,name=state,proto3,enum=zfsilo.v1.PoolStatus_Scrub_State" json:"state,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	RepairedBytes int64                  `protobuf:"varint,4,opt,name=repaired_bytes,json=repairedBytes,proto3" json:"repaired_bytes,omitempty"`
+	Errors        int64                  `protobuf:"varint,5,opt,name=errors,proto3" json:"errors,omitempty"`
+	PercentDone   float64                `protobuf:"fixed64,6,opt,name=percent_done,json=percentDone,proto3" json:"percent_done,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Host_Role_Client) Reset() {
-	*x = Host_Role_Client{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[51]
+func (x *PoolStatus_Scrub) Reset() {
+	*x = PoolStatus_Scrub{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Host_Role_Client) String() string {
+func (x *PoolStatus_Scrub) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Host_Role_Client) ProtoMessage() {}
+func (*PoolStatus_Scrub) ProtoMessage() {}
 
-func (x *Host_Role_Client) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[51]
+func (x *PoolStatus_Scrub) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2861,9 +7742,51 @@ func (x *Host_Role_Client) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Host_Role_Client.ProtoReflect.Descriptor instead.
-func (*Host_Role_Client) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{2, 1, 1}
+// Deprecated: Use PoolStatus_Scrub.ProtoReflect.Descriptor instead.
+func (*PoolStatus_Scrub) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *PoolStatus_Scrub) GetState() PoolStatus_Scrub_State {
+	if x != nil {
+		return x.State
+	}
+	return PoolStatus_Scrub_STATE_UNSPECIFIED
+}
+
+func (x *PoolStatus_Scrub) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *PoolStatus_Scrub) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *PoolStatus_Scrub) GetRepairedBytes() int64 {
+	if x != nil {
+		return x.RepairedBytes
+	}
+	return 0
+}
+
+func (x *PoolStatus_Scrub) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *PoolStatus_Scrub) GetPercentDone() float64 {
+	if x != nil {
+		return x.PercentDone
+	}
+	return 0
 }
 
 type Volume_Option struct {
@@ -2876,7 +7799,7 @@ type Volume_Option struct {
 
 func (x *Volume_Option) Reset() {
 	*x = Volume_Option{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[52]
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2888,7 +7811,7 @@ func (x *Volume_Option) String() string {
 func (*Volume_Option) ProtoMessage() {}
 
 func (x *Volume_Option) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[52]
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2901,7 +7824,7 @@ func (x *Volume_Option) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Volume_Option.ProtoReflect.Descriptor instead.
 func (*Volume_Option) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{13, 0}
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{23, 0}
 }
 
 func (x *Volume_Option) GetKey() string {
@@ -2918,6 +7841,82 @@ func (x *Volume_Option) GetValue() string {
 	return ""
 }
 
+type Volume_Attachment struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ClientHost          string                 `protobuf:"bytes,1,opt,name=client_host,json=clientHost,proto3" json:"client_host,omitempty"`
+	ReadOnly            bool                   `protobuf:"varint,2,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	StagingPath         string                 `protobuf:"bytes,3,opt,name=staging_path,json=stagingPath,proto3" json:"staging_path,omitempty"`
+	TargetPaths         []string               `protobuf:"bytes,4,rep,name=target_paths,json=targetPaths,proto3" json:"target_paths,omitempty"`
+	ReadOnlyTargetPaths []string               `protobuf:"bytes,5,rep,name=read_only_target_paths,json=readOnlyTargetPaths,proto3" json:"read_only_target_paths,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Volume_Attachment) Reset() {
+	*x = Volume_Attachment{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Volume_Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Volume_Attachment) ProtoMessage() {}
+
+func (x *Volume_Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Volume_Attachment.ProtoReflect.Descriptor instead.
+func (*Volume_Attachment) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{23, 1}
+}
+
+func (x *Volume_Attachment) GetClientHost() string {
+	if x != nil {
+		return x.ClientHost
+	}
+	return ""
+}
+
+func (x *Volume_Attachment) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *Volume_Attachment) GetStagingPath() string {
+	if x != nil {
+		return x.StagingPath
+	}
+	return ""
+}
+
+func (x *Volume_Attachment) GetTargetPaths() []string {
+	if x != nil {
+		return x.TargetPaths
+	}
+	return nil
+}
+
+func (x *Volume_Attachment) GetReadOnlyTargetPaths() []string {
+	if x != nil {
+		return x.ReadOnlyTargetPaths
+	}
+	return nil
+}
+
 type StatsVolumeResponse_Stats struct {
 	state         protoimpl.MessageState             `protogen:"open.v1"`
 	Usage         []*StatsVolumeResponse_Stats_Usage `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
@@ -2927,7 +7926,7 @@ type StatsVolumeResponse_Stats struct {
 
 func (x *StatsVolumeResponse_Stats) Reset() {
 	*x = StatsVolumeResponse_Stats{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[53]
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2939,7 +7938,7 @@ func (x *StatsVolumeResponse_Stats) String() string {
 func (*StatsVolumeResponse_Stats) ProtoMessage() {}
 
 func (x *StatsVolumeResponse_Stats) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[53]
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2952,7 +7951,7 @@ func (x *StatsVolumeResponse_Stats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsVolumeResponse_Stats.ProtoReflect.Descriptor instead.
 func (*StatsVolumeResponse_Stats) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{41, 0}
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{51, 0}
 }
 
 func (x *StatsVolumeResponse_Stats) GetUsage() []*StatsVolumeResponse_Stats_Usage {
@@ -2974,7 +7973,7 @@ type StatsVolumeResponse_Stats_Usage struct {
 
 func (x *StatsVolumeResponse_Stats_Usage) Reset() {
 	*x = StatsVolumeResponse_Stats_Usage{}
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[54]
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2986,7 +7985,7 @@ func (x *StatsVolumeResponse_Stats_Usage) String() string {
 func (*StatsVolumeResponse_Stats_Usage) ProtoMessage() {}
 
 func (x *StatsVolumeResponse_Stats_Usage) ProtoReflect() protoreflect.Message {
-	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[54]
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2999,7 +7998,7 @@ func (x *StatsVolumeResponse_Stats_Usage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsVolumeResponse_Stats_Usage.ProtoReflect.Descriptor instead.
 func (*StatsVolumeResponse_Stats_Usage) Descriptor() ([]byte, []int) {
-	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{41, 0, 0}
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{51, 0, 0}
 }
 
 func (x *StatsVolumeResponse_Stats_Usage) GetUnit() StatsVolumeResponse_Stats_Usage_Unit {
@@ -3030,14 +8029,116 @@ func (x *StatsVolumeResponse_Stats_Usage) GetAvailable() int64 {
 	return 0
 }
 
+type Replication_Status struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	LastSyncTime          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
+	LastAttemptTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_attempt_time,json=lastAttemptTime,proto3" json:"last_attempt_time,omitempty"`
+	LastBytesTransferred  int64                  `protobuf:"varint,3,opt,name=last_bytes_transferred,json=lastBytesTransferred,proto3" json:"last_bytes_transferred,omitempty"`
+	TotalBytesTransferred int64                  `protobuf:"varint,4,opt,name=total_bytes_transferred,json=totalBytesTransferred,proto3" json:"total_bytes_transferred,omitempty"`
+	LagSeconds            int64                  `protobuf:"varint,5,opt,name=lag_seconds,json=lagSeconds,proto3" json:"lag_seconds,omitempty"`
+	LastError             string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastSnapshot          string                 `protobuf:"bytes,7,opt,name=last_snapshot,json=lastSnapshot,proto3" json:"last_snapshot,omitempty"`
+	LastSnapshotTime      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_snapshot_time,json=lastSnapshotTime,proto3" json:"last_snapshot_time,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Replication_Status) Reset() {
+	*x = Replication_Status{}
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Replication_Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Replication_Status) ProtoMessage() {}
+
+func (x *Replication_Status) ProtoReflect() protoreflect.Message {
+	mi := &file_zfsilo_v1_zfsilo_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Replication_Status.ProtoReflect.Descriptor instead.
+func (*Replication_Status) Descriptor() ([]byte, []int) {
+	return file_zfsilo_v1_zfsilo_proto_rawDescGZIP(), []int{108, 0}
+}
+
+func (x *Replication_Status) GetLastSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncTime
+	}
+	return nil
+}
+
+func (x *Replication_Status) GetLastAttemptTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptTime
+	}
+	return nil
+}
+
+func (x *Replication_Status) GetLastBytesTransferred() int64 {
+	if x != nil {
+		return x.LastBytesTransferred
+	}
+	return 0
+}
+
+func (x *Replication_Status) GetTotalBytesTransferred() int64 {
+	if x != nil {
+		return x.TotalBytesTransferred
+	}
+	return 0
+}
+
+func (x *Replication_Status) GetLagSeconds() int64 {
+	if x != nil {
+		return x.LagSeconds
+	}
+	return 0
+}
+
+func (x *Replication_Status) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Replication_Status) GetLastSnapshot() string {
+	if x != nil {
+		return x.LastSnapshot
+	}
+	return ""
+}
+
+func (x *Replication_Status) GetLastSnapshotTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSnapshotTime
+	}
+	return nil
+}
+
 var File_zfsilo_v1_zfsilo_proto protoreflect.FileDescriptor
 
 const file_zfsilo_v1_zfsilo_proto_rawDesc = "" +
 	"\n" +
-	"\x16zfsilo/v1/zfsilo.proto\x12\tzfsilo.v1\x1a\x1bbuf/validate/validate.proto\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"5\n" +
-	"\x12GetCapacityRequest:\x1f\xbaG\x1c\x92\x02\x19The get capacity request.\"\x99\x01\n" +
+	"\x16zfsilo/v1/zfsilo.proto\x12\tzfsilo.v1\x1a\x1bbuf/validate/validate.proto\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf1\x01\n" +
+	"\x12GetCapacityRequest\x12\xb9\x01\n" +
+	"\x11parent_dataset_id\x18\x01 \x01(\tB\x8c\x01\xbaG\x88\x01\x92\x02\x84\x01Only return the capacity available under this parent dataset. Otherwise the capacity of every pool a server host allows is returned.R\x0fparentDatasetId:\x1f\xbaG\x1c\x92\x02\x19The get capacity request.\"\xf5\x02\n" +
 	"\x13GetCapacityResponse\x12`\n" +
-	"\x18available_capacity_bytes\x18\x01 \x01(\x03B&\xbaG#\x92\x02 The available capacity in bytes.R\x16availableCapacityBytes: \xbaG\x1d\x92\x02\x1aThe get capacity response.\"\xd6\v\n" +
+	"\x18available_capacity_bytes\x18\x01 \x01(\x03B&\xbaG#\x92\x02 The available capacity in bytes.R\x16availableCapacityBytes\x12\xd9\x01\n" +
+	"\x19maximum_volume_size_bytes\x18\x02 \x01(\x03B\x9d\x01\xbaG\x99\x01\x92\x02\x95\x01The largest volume, in bytes, that can be created. A volume cannot span pools, so it is the available capacity of the largest pool or parent dataset.R\x16maximumVolumeSizeBytes: \xbaG\x1d\x92\x02\x1aThe get capacity response.\"\x80\x1d\n" +
 	"\x04Host\x12_\n" +
 	"\vcreate_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\"\xbaG\x1f\x18\x01\x92\x02\x1aWhen the host was created.R\n" +
 	"createTime\x12d\n" +
@@ -3052,7 +8153,8 @@ const file_zfsilo_v1_zfsilo_proto_rawDesc = "" +
 	"\x03key\x18\a \x01(\tB6\xbaG3\x92\x020Storage protocol key (used for deriving secrets)R\x03key\x12T\n" +
 	"\x04role\x18\b \x01(\v2\x14.zfsilo.v1.Host.RoleB*\xbaG'\x92\x02$The role of the host in the cluster.R\x04role\x12^\n" +
 	"\tby_config\x18\n" +
-	" \x01(\bBA\xbaG>\x18\x01\x92\x029Whether the host is maintained by the configuration file.R\bbyConfig\x1a\xc5\x02\n" +
+	" \x01(\bBA\xbaG>\x18\x01\x92\x029Whether the host is maintained by the configuration file.R\bbyConfig\x12a\n" +
+	"\x06status\x18\v \x01(\v2\x16.zfsilo.v1.Host.StatusB1\xbaG.\x18\x01\x92\x02)The status of the pools of a server host.R\x06status\x1a\xc5\x02\n" +
 	"\n" +
 	"Connection\x128\n" +
 	"\x05local\x18\x01 \x01(\v2 .zfsilo.v1.Host.Connection.LocalH\x00R\x05local\x12;\n" +
@@ -3065,14 +8167,34 @@ const file_zfsilo_v1_zfsilo_proto_rawDesc = "" +
 	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x1e\n" +
 	"\vrun_as_root\x18\x05 \x01(\bR\trunAsRootB\x06\n" +
-	"\x04type\x1a\xef\x01\n" +
+	"\x04type\x1a\xed\x0f\n" +
 	"\x04Role\x125\n" +
 	"\x06server\x18\x01 \x01(\v2\x1b.zfsilo.v1.Host.Role.ServerH\x00R\x06server\x125\n" +
-	"\x06client\x18\x02 \x01(\v2\x1b.zfsilo.v1.Host.Role.ClientH\x00R\x06client\x1ag\n" +
+	"\x06client\x18\x02 \x01(\v2\x1b.zfsilo.v1.Host.Role.ClientH\x00R\x06client\x1a\xff\r\n" +
 	"\x06Server\x12]\n" +
-	"\bendpoint\x18\x01 \x01(\tBA\xbaG>\x92\x02;The data plane address or hostname for storage connections.R\bendpoint\x1a\b\n" +
-	"\x06ClientB\x06\n" +
-	"\x04type:\x8d\x01\xbaG\x15\x92\x02\x12The host resource.\xbaHr\x1ap\n" +
+	"\bendpoint\x18\x01 \x01(\tBA\xbaG>\x92\x02;The data plane address or hostname for storage connections.R\bendpoint\x12\xc9\x01\n" +
+	"\bdatasets\x18\x02 \x03(\tB\xac\x01\xbaGl\x92\x02iThe pools or parent datasets volumes may be created under. Every pool of the host may be used when empty.\xbaH:\x92\x017\"5r321^[a-zA-Z0-9][a-zA-Z0-9-_.:]*(/[a-zA-Z0-9-_.:]+)*$R\bdatasets\x12\xdd\x01\n" +
+	"\x0fscrub_schedules\x18\x03 \x03(\v2/.zfsilo.v1.Host.Role.Server.ScrubSchedulesEntryB\x82\x01\xbaG\x7f\x92\x02|The cron schedules, in the form 'minute hour day-of-month month day-of-week', of when to scrub each pool keyed by pool name.R\x0escrubSchedules\x12\x91\x02\n" +
+	"\x0edata_addresses\x18\x04 \x03(\tB\xe9\x01\xbaG\xe5\x01\x92\x02\xe1\x01The data plane addresses, optionally with a port, targets are served on. Each should be reached over its own network path, as clients connect through every one and combine them with multipath. The endpoint is used when empty.R\rdataAddresses\x12\x85\x02\n" +
+	"\fnvmeof_ports\x18\x05 \x03(\v2&.zfsilo.v1.Host.Role.Server.NvmeofPortB\xb9\x01\xbaG\xb5\x01\x92\x02\xb1\x01The nvmet ports NVMe-oF subsystems are served on. A port is derived for each data address when empty and there are several, and otherwise a single port listens on every address.R\vnvmeofPorts\x1a\x8a\x05\n" +
+	"\n" +
+	"NvmeofPort\x12\x98\x01\n" +
+	"\x02id\x18\x01 \x01(\x05B\x87\x01\xbaG}\x92\x02zThe ID of the nvmet port. A port that already exists on the host with another address is left as is and fails the publish.\xbaH\x04\x1a\x02(\x01R\x02id\x12\xae\x01\n" +
+	"\aaddress\x18\x02 \x01(\tB\x93\x01\xbaG\x88\x01\x92\x02\x84\x01The IPv4 or IPv6 address the port listens on. An unspecified address, such as 0.0.0.0 or ::, listens on every address of its family.\xbaH\x04r\x02p\x01R\aaddress\x12c\n" +
+	"\n" +
+	"service_id\x18\x03 \x01(\x05BD\xbaG6\x92\x023The TCP port the port listens on. Defaults to 4420.\xbaH\b\x1a\x06\x18\xff\xff\x03(\x00R\tserviceId\x12\xca\x01\n" +
+	"\x03tls\x18\x04 \x01(\bB\xb7\x01\xbaG\xb3\x01\x92\x02\xaf\x01Whether hosts must connect to the port over TLS 1.3 with a pre-shared key. Volumes published with TLS are served only on such ports, and other volumes only on the other ports.R\x03tls\x1aA\n" +
+	"\x13ScrubSchedulesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1am\n" +
+	"\x06Client\x12c\n" +
+	"\bendpoint\x18\x01 \x01(\tBG\xbaGD\x92\x02AThe data plane address or hostname the host reaches storage from.R\bendpointB\x06\n" +
+	"\x04type\x1a\xc6\x02\n" +
+	"\x06Status\x12t\n" +
+	"\x0elast_poll_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB2\xbaG/\x92\x02,When the pools of the host were last polled.R\flastPollTime\x12N\n" +
+	"\n" +
+	"last_error\x18\x02 \x01(\tB/\xbaG,\x92\x02)The error of the last poll, if it failed.R\tlastError\x12v\n" +
+	"\x05pools\x18\x03 \x03(\v2\x15.zfsilo.v1.PoolStatusBI\xbaGF\x92\x02CThe status of each pool of the host as of the last successful poll.R\x05pools:\x8d\x01\xbaG\x15\x92\x02\x12The host resource.\xbaHr\x1ap\n" +
 	"\x18host.name_id_consistency\x123The 'name' field must be in the format 'hosts/{id}'\x1a\x1fthis.name == 'hosts/' + this.id\"R\n" +
 	"\x0eGetHostRequest\x12@\n" +
 	"\x02id\x18\x01 \x01(\tB0\xbaG\x0f\x92\x02\fThe host id.\xbaH\x1b\xc8\x01\x01r\x162\x14^hst_[a-zA-Z0-9-_]+$R\x02id\"P\n" +
@@ -3097,7 +8219,97 @@ const file_zfsilo_v1_zfsilo_proto_rawDesc = "" +
 	"\x04host\x18\x01 \x01(\v2\x0f.zfsilo.v1.HostR\x04host\"U\n" +
 	"\x11DeleteHostRequest\x12@\n" +
 	"\x02id\x18\x01 \x01(\tB0\xbaG\x0f\x92\x02\fThe host id.\xbaH\x1b\xc8\x01\x01r\x162\x14^hst_[a-zA-Z0-9-_]+$R\x02id\"\x14\n" +
-	"\x12DeleteHostResponse\"\x8e\x11\n" +
+	"\x12DeleteHostResponse\"\xd7\x03\n" +
+	"\x14RotateHostKeyRequest\x12\\\n" +
+	"\x02id\x18\x01 \x01(\tBL\xbaG+\x92\x02(The id of the host to rotate the key of.\xbaH\x1b\xc8\x01\x01r\x162\x14^hst_[a-zA-Z0-9-_]+$R\x02id\x12\xe0\x02\n" +
+	"\x03key\x18\x02 \x01(\tB\xcd\x02\xbaG\xc9\x02\x92\x02\xc5\x02The new key of the host. A key is generated when empty. The key of a host declared in the configuration is changed in the configuration instead, and is applied on startup; such a host is rotated without a key, or with its current key, to update its volumes to it. Giving the current key updates the volumes of the host to it.R\x03key\"\xb8\x06\n" +
+	"\x15RotateHostKeyResponse\x12#\n" +
+	"\x04host\x18\x01 \x01(\v2\x0f.zfsilo.v1.HostR\x04host\x12\xc1\x03\n" +
+	"\aresults\x18\x02 \x03(\v2-.zfsilo.v1.RotateHostKeyResponse.VolumeResultB\xf7\x02\xbaG\xf3\x02\x92\x02\xef\x02The outcome for each volume the host serves or is connected to that uses the host key. The host key only covers volumes published before volumes were given credentials of their own; other volumes are not affected and are left out. The key of the host is changed even when some volumes fail to be updated, and rotating again updates every volume to the key given then.R\aresults\x1a\xb5\x02\n" +
+	"\fVolumeResult\x12m\n" +
+	"\x06volume\x18\x01 \x01(\tBU\xbaGR\x92\x02OThe id of the volume the host serves or is connected to that uses the host key.R\x06volume\x12\xb5\x01\n" +
+	"\x05error\x18\x02 \x01(\tB\x9e\x01\xbaG\x9a\x01\x92\x02\x96\x01The error updating the volume to the new key, if it failed. Connected clients stay logged in, but may not connect again until the rotation is retried.R\x05error\"\xe5\t\n" +
+	"\x04Pool\x12`\n" +
+	"\vserver_host\x18\x01 \x01(\tB?\xbaG<\x92\x029The resource name of the server host the pool belongs to.R\n" +
+	"serverHost\x12(\n" +
+	"\x04name\x18\x02 \x01(\tB\x14\xbaG\x11\x92\x02\x0eThe pool name.R\x04name\x12C\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03B$\xbaG!\x92\x02\x1eThe size of the pool in bytes.R\tsizeBytes\x12O\n" +
+	"\x0fallocated_bytes\x18\x04 \x01(\x03B&\xbaG#\x92\x02 The bytes allocated in the pool.R\x0eallocatedBytes\x12@\n" +
+	"\n" +
+	"free_bytes\x18\x05 \x01(\x03B!\xbaG\x1e\x92\x02\x1bThe bytes free in the pool.R\tfreeBytes\x12{\n" +
+	"\x15fragmentation_percent\x18\x06 \x01(\x05BF\xbaGC\x92\x02@The fragmentation of the free space of the pool as a percentage.R\x14fragmentationPercent\x12M\n" +
+	"\x06health\x18\a \x01(\x0e2\x16.zfsilo.v1.Pool.HealthB\x1d\xbaG\x1a\x92\x02\x17The health of the pool.R\x06health\x12p\n" +
+	"\x06status\x18\b \x01(\tBX\xbaGU\x92\x02RWhy the pool needs attention, as reported by zpool status. Empty when it does not.R\x06status\x12h\n" +
+	"\x06action\x18\t \x01(\tBP\xbaGM\x92\x02JThe action recommended to resolve the status, as reported by zpool status.R\x06action\x12W\n" +
+	"\x06errors\x18\n" +
+	" \x01(\tB?\xbaG<\x92\x029The data errors of the pool, as reported by zpool status.R\x06errors\x12\xa2\x01\n" +
+	"\bdatasets\x18\v \x03(\tB\x85\x01\xbaG\x81\x01\x92\x02~The pool or parent datasets in the pool that volumes may be created under. Empty when the server host does not allow the pool.R\bdatasets\"\xae\x01\n" +
+	"\x06Health\x12\x16\n" +
+	"\x12HEALTH_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rHEALTH_ONLINE\x10\x01\x12\x13\n" +
+	"\x0fHEALTH_DEGRADED\x10\x02\x12\x12\n" +
+	"\x0eHEALTH_FAULTED\x10\x03\x12\x12\n" +
+	"\x0eHEALTH_OFFLINE\x10\x04\x12\x12\n" +
+	"\x0eHEALTH_REMOVED\x10\x05\x12\x12\n" +
+	"\x0eHEALTH_UNAVAIL\x10\x06\x12\x14\n" +
+	"\x10HEALTH_SUSPENDED\x10\a:\"\xbaG\x1f\x92\x02\x1cA ZFS pool of a server host.\"\xf2\x13\n" +
+	"\n" +
+	"PoolStatus\x12`\n" +
+	"\vserver_host\x18\x01 \x01(\tB?\xbaG<\x92\x029The resource name of the server host the pool belongs to.R\n" +
+	"serverHost\x12(\n" +
+	"\x04name\x18\x02 \x01(\tB\x14\xbaG\x11\x92\x02\x0eThe pool name.R\x04name\x12]\n" +
+	"\tpoll_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB$\xbaG!\x92\x02\x1eWhen the pool was last polled.R\bpollTime\x12M\n" +
+	"\x06health\x18\x04 \x01(\x0e2\x16.zfsilo.v1.Pool.HealthB\x1d\xbaG\x1a\x92\x02\x17The health of the pool.R\x06health\x12\x99\x01\n" +
+	"\x0fprevious_health\x18\x05 \x01(\x0e2\x16.zfsilo.v1.Pool.HealthBX\xbaGU\x92\x02RThe health of the pool before it last changed. Unspecified until it first changes.R\x0epreviousHealth\x12\x8b\x01\n" +
+	"\x12health_change_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampBA\xbaG>\x92\x02;When the health of the pool was first seen or last changed.R\x10healthChangeTime\x12p\n" +
+	"\x06status\x18\a \x01(\tBX\xbaGU\x92\x02RWhy the pool needs attention, as reported by zpool status. Empty when it does not.R\x06status\x12h\n" +
+	"\x06action\x18\b \x01(\tBP\xbaGM\x92\x02JThe action recommended to resolve the status, as reported by zpool status.R\x06action\x12W\n" +
+	"\x06errors\x18\t \x01(\tB?\xbaG<\x92\x029The data errors of the pool, as reported by zpool status.R\x06errors\x12[\n" +
+	"\vread_errors\x18\n" +
+	" \x01(\x03B:\xbaG7\x92\x024The read errors summed over the devices of the pool.R\n" +
+	"readErrors\x12^\n" +
+	"\fwrite_errors\x18\v \x01(\x03B;\xbaG8\x92\x025The write errors summed over the devices of the pool.R\vwriteErrors\x12g\n" +
+	"\x0fchecksum_errors\x18\f \x01(\x03B>\xbaG;\x92\x028The checksum errors summed over the devices of the pool.R\x0echecksumErrors\x12\x95\x01\n" +
+	"\x15resilver_percent_done\x18\r \x01(\x01Ba\xbaG^\x92\x02[The progress of an ongoing resilver as a percentage. Zero when the pool is not resilvering.R\x13resilverPercentDone\x12]\n" +
+	"\n" +
+	"last_scrub\x18\x0e \x01(\v2\x1b.zfsilo.v1.PoolStatus.ScrubB!\xbaG\x1e\x92\x02\x1bThe last scrub of the pool.R\tlastScrub\x12\x8f\x01\n" +
+	"\x0escrub_schedule\x18\x0f \x01(\tBh\xbaGe\x92\x02bThe cron schedule of when the pool is scrubbed. Empty when the pool is not scrubbed on a schedule.R\rscrubSchedule\x12y\n" +
+	"\x0fnext_scrub_time\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampB5\xbaG2\x92\x02/When the pool is next scrubbed on its schedule.R\rnextScrubTime\x12d\n" +
+	"\vscrub_error\x18\x11 \x01(\tBC\xbaG@\x92\x02=The error of the last scheduled scrub, if it failed to start.R\n" +
+	"scrubError\x1a\xf8\x04\n" +
+	"\x05Scrub\x12V\n" +
+	"\x05state\x18\x01 \x01(\x0e2!.zfsilo.v1.PoolStatus.Scrub.StateB\x1d\xbaG\x1a\x92\x02\x17The state of the scrub.R\x05state\x12X\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x1d\xbaG\x1a\x92\x02\x17When the scrub started.R\tstartTime\x12e\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB.\xbaG+\x92\x02(When the scrub finished or was canceled.R\aendTime\x12M\n" +
+	"\x0erepaired_bytes\x18\x04 \x01(\x03B&\xbaG#\x92\x02 The bytes repaired by the scrub.R\rrepairedBytes\x12D\n" +
+	"\x06errors\x18\x05 \x01(\x03B,\xbaG)\x92\x02&The errors the scrub could not repair.R\x06errors\x12S\n" +
+	"\fpercent_done\x18\x06 \x01(\x01B0\xbaG-\x92\x02*The progress of the scrub as a percentage.R\vpercentDone\"l\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSTATE_SCANNING\x10\x01\x12\x12\n" +
+	"\x0eSTATE_FINISHED\x10\x02\x12\x12\n" +
+	"\x0eSTATE_CANCELED\x10\x03\x12\x10\n" +
+	"\fSTATE_PAUSED\x10\x04:@\xbaG=\x92\x02:The health of a pool of a server host as of the last poll.\"\xd3\x01\n" +
+	"\x0eGetPoolRequest\x12p\n" +
+	"\vserver_host\x18\x01 \x01(\tBO\xbaG(\x92\x02%The resource name of the server host.\xbaH!\xc8\x01\x01r\x1c2\x1a^hosts/hst_[a-zA-Z0-9-_]+$R\n" +
+	"serverHost\x12O\n" +
+	"\x04name\x18\x02 \x01(\tB;\xbaG\x11\x92\x02\x0eThe pool name.\xbaH$\xc8\x01\x01r\x1f2\x1d^[a-zA-Z0-9][a-zA-Z0-9-_.:]*$R\x04name\"G\n" +
+	"\x0fGetPoolResponse\x124\n" +
+	"\x04pool\x18\x01 \x01(\v2\x0f.zfsilo.v1.PoolB\x0f\xbaG\f\x92\x02\tThe pool.R\x04pool\"\xd9\x01\n" +
+	"\x14GetPoolStatusRequest\x12p\n" +
+	"\vserver_host\x18\x01 \x01(\tBO\xbaG(\x92\x02%The resource name of the server host.\xbaH!\xc8\x01\x01r\x1c2\x1a^hosts/hst_[a-zA-Z0-9-_]+$R\n" +
+	"serverHost\x12O\n" +
+	"\x04name\x18\x02 \x01(\tB;\xbaG\x11\x92\x02\x0eThe pool name.\xbaH$\xc8\x01\x01r\x1f2\x1d^[a-zA-Z0-9][a-zA-Z0-9-_.:]*$R\x04name\"\x82\x01\n" +
+	"\x15GetPoolStatusResponse\x12i\n" +
+	"\vpool_status\x18\x01 \x01(\v2\x15.zfsilo.v1.PoolStatusB1\xbaG.\x92\x02+The status of the pool as of the last poll.R\n" +
+	"poolStatus\"\xa1\x01\n" +
+	"\x10ListPoolsRequest\x12\x8c\x01\n" +
+	"\vserver_host\x18\x01 \x01(\tBk\xbaGD\x92\x02AOnly return the pools of the server host with this resource name.\xbaH!r\x1f2\x1d^(hosts/hst_[a-zA-Z0-9-_]+)?$R\n" +
+	"serverHost\"T\n" +
+	"\x11ListPoolsResponse\x12?\n" +
+	"\x05pools\x18\x01 \x03(\v2\x0f.zfsilo.v1.PoolB\x18\xbaG\x15\x92\x02\x12The list of pools.R\x05pools\"\xb8$\n" +
 	"\x06Volume\x12f\n" +
 	"\x06struct\x18\x01 \x01(\v2\x17.google.protobuf.StructB5\xbaG2\x92\x02/Loosely structured data stored with the volume.R\x06struct\x12a\n" +
 	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB$\xbaG!\x18\x01\x92\x02\x1cWhen the volume was created.R\n" +
@@ -3116,37 +8328,67 @@ const file_zfsilo_v1_zfsilo_proto_rawDesc = "" +
 	"\x06status\x18\v \x01(\x0e2\x18.zfsilo.v1.Volume.StatusB!\xbaG\x1e\x18\x01\x92\x02\x19The status of the volume.R\x06status\x12p\n" +
 	"\ttransport\x18\f \x01(\x0e2\x1b.zfsilo.v1.Volume.TransportB0\xbaG-\x18\x01\x92\x02(The protocol used to expose this volume.H\x01R\ttransport\x88\x01\x01\x12q\n" +
 	"\vserver_host\x18\r \x01(\tBK\xbaGA\x18\x01\x92\x02<The resource name of the host where the volume is published.\xbaH\x04r\x02\x10\x01H\x02R\n" +
-	"serverHost\x88\x01\x01\x12q\n" +
-	"\vclient_host\x18\x0e \x01(\tBK\xbaGA\x18\x01\x92\x02<The resource name of the host where the volume is connected.\xbaH\x04r\x02\x10\x01H\x03R\n" +
-	"clientHost\x88\x01\x01\x12d\n" +
-	"\fstaging_path\x18\x10 \x01(\tB<\xbaG$\x18\x01\x92\x02\x1fThe staging path on the client.\xbaH\x12r\x102\x0e^(/[^/ ]*)+/?$H\x04R\vstagingPath\x88\x01\x01\x12\x80\x01\n" +
-	"\ftarget_paths\x18\x11 \x03(\tB]\xbaG@\x18\x01\x92\x02;The target paths on the client where the volume is mounted.\xbaH\x17\x92\x01\x14\"\x12r\x102\x0e^(/[^/ ]*)+/?$R\vtargetPaths\x1a0\n" +
+	"serverHost\x88\x01\x01\x12\x8b\x01\n" +
+	"\x0fsource_snapshot\x18\x12 \x01(\tB]\xbaG?\x92\x02<The id of the snapshot the volume is cloned from. Immutable.\xbaH\x18r\x162\x14^snp_[a-zA-Z0-9-_]+$H\x03R\x0esourceSnapshot\x88\x01\x01\x12\x8d\x01\n" +
+	"\apromote\x18\x13 \x01(\bBn\xbaGk\x92\x02hWhether the volume is promoted after being cloned so that it no longer depends on its source. Immutable.H\x04R\apromote\x88\x01\x01\x12\x85\x01\n" +
+	"\rsource_volume\x18\x14 \x01(\tB[\xbaG=\x92\x02:The id of the volume the volume is cloned from. Immutable.\xbaH\x18r\x162\x14^vol_[a-zA-Z0-9-_]+$H\x05R\fsourceVolume\x88\x01\x01\x12\x87\x01\n" +
+	"\x0fsnapshot_policy\x18\x15 \x01(\tBY\xbaG8\x92\x025The id of the snapshot policy attached to the volume.\xbaH\x1br\x192\x17^(spl_[a-zA-Z0-9-_]+)?$H\x06R\x0esnapshotPolicy\x88\x01\x01\x12\xb0\x01\n" +
+	"\fstandby_host\x18\x16 \x01(\tB\x87\x01\xbaG`\x92\x02]The resource name of the server host that keeps a standby copy of the volume to fail over to.\xbaH!r\x1f2\x1d^(hosts/hst_[a-zA-Z0-9-_]+)?$H\aR\vstandbyHost\x88\x01\x01\x12\x9a\x01\n" +
+	"\ffenced_hosts\x18\x17 \x03(\tBw\xbaGt\x18\x01\x92\x02oThe resource names of the server hosts the volume failed over from that still hold a stale copy of it to fence.R\vfencedHosts\x12\xf2\x01\n" +
+	"\tencrypted\x18\x18 \x01(\bB\xce\x01\xbaG\xca\x01\x92\x02\xc6\x01Whether the volume is encrypted with ZFS native encryption using a key generated and kept by the application. A cloned volume must match the encryption of its source, whose key it shares. Immutable.H\bR\tencrypted\x88\x01\x01\x12\x96\x01\n" +
+	"\afs_type\x18\x19 \x01(\x0e2\x18.zfsilo.v1.Volume.FSTypeBc\xbaG`\x92\x02]The filesystem type a filesystem volume is formatted with, which defaults to ext4. Immutable.R\x06fsType\x12\x91\x01\n" +
+	"\fmkfs_options\x18\x1a \x03(\tBn\xbaGV\x92\x02SAdditional options passed to mkfs when a filesystem volume is formatted. Immutable.\xbaH\x12\x92\x01\x0f\"\rr\v2\t^[^'\\s]+$R\vmkfsOptions\x12\xba\x01\n" +
+	"\rmount_options\x18\x1b \x03(\tB\x94\x01\xbaGo\x92\x02lAdditional options the volume is mounted with when staged. Changes apply the next time the volume is staged.\xbaH\x1f\x92\x01\x1c\"\x1ar\x182\x16^[a-zA-Z0-9_.=:/@+-]+$R\fmountOptions\x12t\n" +
+	"\vattachments\x18\x1e \x03(\v2\x1c.zfsilo.v1.Volume.AttachmentB4\xbaG1\x18\x01\x92\x02,The client hosts the volume is connected to.R\vattachments\x12\\\n" +
+	"\x03tls\x18\x1f \x01(\bBE\xbaGB\x18\x01\x92\x02=Whether clients connect to the volume over NVMe/TCP with TLS.H\tR\x03tls\x88\x01\x01\x1a0\n" +
 	"\x06Option\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"A\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x1a\x9c\x05\n" +
+	"\n" +
+	"Attachment\x12`\n" +
+	"\vclient_host\x18\x01 \x01(\tB?\xbaG<\x92\x029The resource name of the host the volume is connected to.R\n" +
+	"clientHost\x12\xc2\x01\n" +
+	"\tread_only\x18\x02 \x01(\bB\xa4\x01\xbaG\xa0\x01\x92\x02\x9c\x01Whether the volume is connected read-only, in which case the target refuses writes from the host and the volume is staged and mounted read-only on the host.R\breadOnly\x12F\n" +
+	"\fstaging_path\x18\x03 \x01(\tB#\xbaG \x92\x02\x1dThe staging path on the host.R\vstagingPath\x12b\n" +
+	"\ftarget_paths\x18\x04 \x03(\tB?\xbaG<\x92\x029The target paths on the host where the volume is mounted.R\vtargetPaths\x12\xba\x01\n" +
+	"\x16read_only_target_paths\x18\x05 \x03(\tB\x84\x01\xbaG\x80\x01\x92\x02}The target paths on the host where the volume is mounted read-only. Only the mount on the host refuses writes to these paths.R\x13readOnlyTargetPaths\"S\n" +
 	"\x04Mode\x12\x14\n" +
 	"\x10MODE_UNSPECIFIED\x10\x00\x12\x0e\n" +
 	"\n" +
 	"MODE_BLOCK\x10\x01\x12\x13\n" +
-	"\x0fMODE_FILESYSTEM\x10\x02\"\x87\x01\n" +
+	"\x0fMODE_FILESYSTEM\x10\x02\x12\x10\n" +
+	"\fMODE_DATASET\x10\x03\"\x87\x01\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eSTATUS_INITIAL\x10\x01\x12\x14\n" +
 	"\x10STATUS_PUBLISHED\x10\x02\x12\x14\n" +
 	"\x10STATUS_CONNECTED\x10\x03\x12\x11\n" +
 	"\rSTATUS_STAGED\x10\x04\x12\x12\n" +
-	"\x0eSTATUS_MOUNTED\x10\x05\"U\n" +
+	"\x0eSTATUS_MOUNTED\x10\x05\"h\n" +
 	"\tTransport\x12\x19\n" +
 	"\x15TRANSPORT_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fTRANSPORT_ISCSI\x10\x01\x12\x18\n" +
-	"\x14TRANSPORT_NVMEOF_TCP\x10\x02:\x95\x01\xbaG\x17\x92\x02\x14The volume resource.\xbaHx\x1av\n" +
+	"\x14TRANSPORT_NVMEOF_TCP\x10\x02\x12\x11\n" +
+	"\rTRANSPORT_NFS\x10\x03\"W\n" +
+	"\x06FSType\x12\x17\n" +
+	"\x13FS_TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fFS_TYPE_EXT4\x10\x01\x12\x0f\n" +
+	"\vFS_TYPE_XFS\x10\x02\x12\x11\n" +
+	"\rFS_TYPE_BTRFS\x10\x03:\x95\x01\xbaG\x17\x92\x02\x14The volume resource.\xbaHx\x1av\n" +
 	"\x1avolume.name_id_consistency\x125The 'name' field must be in the format 'volumes/{id}'\x1a!this.name == 'volumes/' + this.idB\t\n" +
 	"\a_sparseB\f\n" +
 	"\n" +
 	"_transportB\x0e\n" +
-	"\f_server_hostB\x0e\n" +
-	"\f_client_hostB\x0f\n" +
-	"\r_staging_path\"V\n" +
+	"\f_server_hostB\x12\n" +
+	"\x10_source_snapshotB\n" +
+	"\n" +
+	"\b_promoteB\x10\n" +
+	"\x0e_source_volumeB\x12\n" +
+	"\x10_snapshot_policyB\x0f\n" +
+	"\r_standby_hostB\f\n" +
+	"\n" +
+	"_encryptedB\x06\n" +
+	"\x04_tlsJ\x04\b\x0e\x10\x0fJ\x04\b\x10\x10\x11J\x04\b\x11\x10\x12J\x04\b\x1c\x10\x1dJ\x04\b\x1d\x10\x1eR\vclient_hostR\fstaging_pathR\ftarget_pathsR\tread_onlyR\x16read_only_target_paths\"V\n" +
 	"\x10GetVolumeRequest\x12B\n" +
 	"\x02id\x18\x01 \x01(\tB2\xbaG\x11\x92\x02\x0eThe volume id.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\"Z\n" +
 	"\x11GetVolumeResponse\x12E\n" +
@@ -3170,47 +8412,60 @@ const file_zfsilo_v1_zfsilo_proto_rawDesc = "" +
 	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"Y\n" +
 	"\x13DeleteVolumeRequest\x12B\n" +
 	"\x02id\x18\x01 \x01(\tB2\xbaG\x11\x92\x02\x0eThe volume id.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\"\x16\n" +
-	"\x14DeleteVolumeResponse\"\xc6\x02\n" +
+	"\x14DeleteVolumeResponse\"\xb4\x04\n" +
 	"\x14PublishVolumeRequest\x12Y\n" +
 	"\x02id\x18\x01 \x01(\tBI\xbaG(\x92\x02%The id of the volume to be published.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12i\n" +
 	"\ttransport\x18\x02 \x01(\x0e2\x1b.zfsilo.v1.Volume.TransportB.\xbaG+\x92\x02(The protocol used to expose this volume.R\ttransport\x12h\n" +
 	"\vserver_host\x18\x03 \x01(\tBG\xbaGD\x92\x02AThe resource name of the host where the volume will be published.R\n" +
-	"serverHost\"B\n" +
+	"serverHost\x12\xeb\x01\n" +
+	"\x03tls\x18\x04 \x01(\bB\xd8\x01\xbaG\xd4\x01\x92\x02\xd0\x01Whether clients connect over TLS 1.3 with a pre-shared key generated for each pair of client and server host. Only valid with the NVMe-oF TCP transport, and the server host must have an NVMe-oF port with TLS.R\x03tls\"B\n" +
 	"\x15PublishVolumeResponse\x12)\n" +
 	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"u\n" +
 	"\x16UnpublishVolumeRequest\x12[\n" +
 	"\x02id\x18\x01 \x01(\tBK\xbaG*\x92\x02'The id of the volume to be unpublished.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\"D\n" +
 	"\x17UnpublishVolumeResponse\x12)\n" +
-	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xdb\x01\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xd8\x04\n" +
 	"\x14ConnectVolumeRequest\x12Y\n" +
 	"\x02id\x18\x01 \x01(\tBI\xbaG(\x92\x02%The id of the volume to be connected.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12h\n" +
 	"\vclient_host\x18\x02 \x01(\tBG\xbaG:\x92\x027The resource name of the host to connect the volume to.\xbaH\a\xc8\x01\x01r\x02\x10\x01R\n" +
-	"clientHost\"B\n" +
+	"clientHost\x12\xfa\x02\n" +
+	"\tread_only\x18\x03 \x01(\bB\xdc\x02\xbaG\xd8\x02\x92\x02\xd4\x02Whether the volume is connected read-only. The target refuses writes from the client, with a write-protected LUN over iSCSI and a read-only export over NFS, and the volume is staged and mounted read-only. Volumes published over NVMe-oF cannot be connected read-only, as the target has no way to refuse the writes of a client to a namespace.R\breadOnly\"B\n" +
 	"\x15ConnectVolumeResponse\x12)\n" +
-	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"w\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xe6\x01\n" +
 	"\x17DisconnectVolumeRequest\x12\\\n" +
-	"\x02id\x18\x01 \x01(\tBL\xbaG+\x92\x02(The id of the volume to be disconnected.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\"E\n" +
+	"\x02id\x18\x01 \x01(\tBL\xbaG+\x92\x02(The id of the volume to be disconnected.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12m\n" +
+	"\vclient_host\x18\x02 \x01(\tBL\xbaG?\x92\x02<The resource name of the host to disconnect the volume from.\xbaH\a\xc8\x01\x01r\x02\x10\x01R\n" +
+	"clientHost\"E\n" +
 	"\x18DisconnectVolumeResponse\x12)\n" +
-	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xc0\x01\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xa8\x02\n" +
 	"\x12StageVolumeRequest\x12V\n" +
 	"\x02id\x18\x01 \x01(\tBF\xbaG%\x92\x02\"The id of the volume to be staged.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12R\n" +
-	"\fstaging_path\x18\x02 \x01(\tB/\xbaG\x14\x92\x02\x11The staging path.\xbaH\x15\xc8\x01\x01r\x102\x0e^(/[^/ ]*)+/?$R\vstagingPath\"@\n" +
+	"\fstaging_path\x18\x02 \x01(\tB/\xbaG\x14\x92\x02\x11The staging path.\xbaH\x15\xc8\x01\x01r\x102\x0e^(/[^/ ]*)+/?$R\vstagingPath\x12f\n" +
+	"\vclient_host\x18\x03 \x01(\tBE\xbaG8\x92\x025The resource name of the host to stage the volume on.\xbaH\a\xc8\x01\x01r\x02\x10\x01R\n" +
+	"clientHost\"@\n" +
 	"\x13StageVolumeResponse\x12)\n" +
-	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"p\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xda\x01\n" +
 	"\x14UnstageVolumeRequest\x12X\n" +
-	"\x02id\x18\x01 \x01(\tBH\xbaG'\x92\x02$The id of the volume to be unstaged.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\"B\n" +
+	"\x02id\x18\x01 \x01(\tBH\xbaG'\x92\x02$The id of the volume to be unstaged.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12h\n" +
+	"\vclient_host\x18\x02 \x01(\tBG\xbaG:\x92\x027The resource name of the host to unstage the volume on.\xbaH\a\xc8\x01\x01r\x02\x10\x01R\n" +
+	"clientHost\"B\n" +
 	"\x15UnstageVolumeResponse\x12)\n" +
-	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xbb\x01\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xbd\x03\n" +
 	"\x12MountVolumeRequest\x12W\n" +
 	"\x02id\x18\x01 \x01(\tBG\xbaG&\x92\x02#The id of the volume to be mounted.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12L\n" +
 	"\n" +
-	"mount_path\x18\x02 \x01(\tB-\xbaG\x12\x92\x02\x0fThe mount path.\xbaH\x15\xc8\x01\x01r\x102\x0e^(/[^/ ]*)+/?$R\tmountPath\"@\n" +
+	"mount_path\x18\x02 \x01(\tB-\xbaG\x12\x92\x02\x0fThe mount path.\xbaH\x15\xc8\x01\x01r\x102\x0e^(/[^/ ]*)+/?$R\tmountPath\x12\x97\x01\n" +
+	"\tread_only\x18\x03 \x01(\bBz\xbaGw\x92\x02tWhether the volume is mounted read-only at the mount path. A volume connected read-only is always mounted read-only.R\breadOnly\x12f\n" +
+	"\vclient_host\x18\x04 \x01(\tBE\xbaG8\x92\x025The resource name of the host to mount the volume on.\xbaH\a\xc8\x01\x01r\x02\x10\x01R\n" +
+	"clientHost\"@\n" +
 	"\x13MountVolumeResponse\x12)\n" +
-	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xbf\x01\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xa9\x02\n" +
 	"\x14UnmountVolumeRequest\x12Y\n" +
 	"\x02id\x18\x01 \x01(\tBI\xbaG(\x92\x02%The id of the volume to be unmounted.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12L\n" +
 	"\n" +
-	"mount_path\x18\x02 \x01(\tB-\xbaG\x12\x92\x02\x0fThe mount path.\xbaH\x15\xc8\x01\x01r\x102\x0e^(/[^/ ]*)+/?$R\tmountPath\"B\n" +
+	"mount_path\x18\x02 \x01(\tB-\xbaG\x12\x92\x02\x0fThe mount path.\xbaH\x15\xc8\x01\x01r\x102\x0e^(/[^/ ]*)+/?$R\tmountPath\x12h\n" +
+	"\vclient_host\x18\x03 \x01(\tBG\xbaG:\x92\x027The resource name of the host to unmount the volume on.\xbaH\a\xc8\x01\x01r\x02\x10\x01R\n" +
+	"clientHost\"B\n" +
 	"\x15UnmountVolumeResponse\x12)\n" +
 	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"_\n" +
 	"\x12StatsVolumeRequest\x12I\n" +
@@ -3233,9 +8488,279 @@ const file_zfsilo_v1_zfsilo_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tBA\xbaG \x92\x02\x1dThe id of the volume to sync.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\"\x14\n" +
 	"\x12SyncVolumeResponse\"\x14\n" +
 	"\x12SyncVolumesRequest\"\x15\n" +
-	"\x13SyncVolumesResponse2\x90\x02\n" +
+	"\x13SyncVolumesResponse\"\xf3\v\n" +
+	"\bSnapshot\x12h\n" +
+	"\x06struct\x18\x01 \x01(\v2\x17.google.protobuf.StructB7\xbaG4\x92\x021Loosely structured data stored with the snapshot.R\x06struct\x12c\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB&\xbaG#\x18\x01\x92\x02\x1eWhen the snapshot was created.R\n" +
+	"createTime\x12h\n" +
+	"\vupdate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB+\xbaG(\x18\x01\x92\x02#When the snapshot was last updated.R\n" +
+	"updateTime\x12O\n" +
+	"\x02id\x18\x04 \x01(\tB?\xbaG\x1e\x92\x02\x1bThe resource id. Immutable.\xbaH\x1b\xc8\x01\x01r\x162\x14^snp_[a-zA-Z0-9-_]+$R\x02id\x12_\n" +
+	"\x04name\x18\x05 \x01(\tBK\xbaG \x92\x02\x1dThe resource name. Immutable.\xbaH%\xc8\x01\x01r 2\x1e^snapshots/snp_[a-zA-Z0-9-_]+$R\x04name\x12{\n" +
+	"\tvolume_id\x18\x06 \x01(\tB^\xbaG=\x92\x02:The id of the volume the snapshot was taken of. Immutable.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\bvolumeId\x12X\n" +
+	"\n" +
+	"dataset_id\x18\a \x01(\tB9\xbaG6\x18\x01\x92\x021The ZFS snapshot id in the form dataset@snapshot.R\tdatasetId\x12m\n" +
+	"\x0ecapacity_bytes\x18\b \x01(\x03BF\xbaGC\x18\x01\x92\x02>The capacity of the volume at the time the snapshot was taken.R\rcapacityBytes\x12\xec\x01\n" +
+	"\vserver_host\x18\t \x01(\tB\xc5\x01\xbaG\xba\x01\x18\x01\x92\x02\xb4\x01The resource name of the host where the snapshot resides. A snapshot that had not been replicated to the standby host when its volume failed over stays on the host it was taken on.\xbaH\x04r\x02\x10\x01H\x00R\n" +
+	"serverHost\x88\x01\x01\x12s\n" +
+	"\x0fsnapshot_policy\x18\n" +
+	" \x01(\tBE\xbaGB\x18\x01\x92\x02=The id of the snapshot policy that took the snapshot, if any.H\x01R\x0esnapshotPolicy\x88\x01\x01\x12{\n" +
+	"\x0esnapshot_group\x18\v \x01(\tBO\xbaGL\x18\x01\x92\x02GThe id of the snapshot group the snapshot was taken as part of, if any.H\x02R\rsnapshotGroup\x88\x01\x01:\x9d\x01\xbaG\x19\x92\x02\x16The snapshot resource.\xbaH~\x1a|\n" +
+	"\x1csnapshot.name_id_consistency\x127The 'name' field must be in the format 'snapshots/{id}'\x1a#this.name == 'snapshots/' + this.idB\x0e\n" +
+	"\f_server_hostB\x12\n" +
+	"\x10_snapshot_policyB\x11\n" +
+	"\x0f_snapshot_group\"Z\n" +
+	"\x12GetSnapshotRequest\x12D\n" +
+	"\x02id\x18\x01 \x01(\tB4\xbaG\x13\x92\x02\x10The snapshot id.\xbaH\x1b\xc8\x01\x01r\x162\x14^snp_[a-zA-Z0-9-_]+$R\x02id\"d\n" +
+	"\x13GetSnapshotResponse\x12M\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x13.zfsilo.v1.SnapshotB\x1c\xbaG\x19\x92\x02\x16The snapshot resource.R\bsnapshot\"\x9b\x03\n" +
+	"\x14ListSnapshotsRequest\x128\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x1b\xbaG\x11\x92\x02\x0eThe page size.\xbaH\x04\x1a\x02(\x00R\bpageSize\x12A\n" +
+	"\x06filter\x18\x02 \x01(\tB)\xbaG&\x92\x02#The filter to apply over snapshots.R\x06filter\x12F\n" +
+	"\border_by\x18\x03 \x01(\tB+\xbaG(\x92\x02%The ordering to apply over snapshots.R\aorderBy\x12h\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tBI\xbaGF\x92\x02CThe page token. Used in subsequent requests to page over snapshots.R\tpageToken\x12T\n" +
+	"\tvolume_id\x18\x05 \x01(\tB7\xbaG4\x92\x021Only return snapshots of the volume with this id.R\bvolumeId\"\xc6\x01\n" +
+	"\x15ListSnapshotsResponse\x12O\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x13.zfsilo.v1.SnapshotB\x1c\xbaG\x19\x92\x02\x16The list of snapshots.R\tsnapshots\x12\\\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB4\xbaG1\x92\x02.The page token for the next page of snapshots.R\rnextPageToken\"l\n" +
+	"\x15CreateSnapshotRequest\x12S\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x13.zfsilo.v1.SnapshotB\"\xbaG\x19\x92\x02\x16The snapshot resource.\xbaH\x03\xc8\x01\x01R\bsnapshot\"I\n" +
+	"\x16CreateSnapshotResponse\x12/\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x13.zfsilo.v1.SnapshotR\bsnapshot\"]\n" +
+	"\x15DeleteSnapshotRequest\x12D\n" +
+	"\x02id\x18\x01 \x01(\tB4\xbaG\x13\x92\x02\x10The snapshot id.\xbaH\x1b\xc8\x01\x01r\x162\x14^snp_[a-zA-Z0-9-_]+$R\x02id\"\x18\n" +
+	"\x16DeleteSnapshotResponse\"\xb0\t\n" +
+	"\rSnapshotGroup\x12n\n" +
+	"\x06struct\x18\x01 \x01(\v2\x17.google.protobuf.StructB=\xbaG:\x92\x027Loosely structured data stored with the snapshot group.R\x06struct\x12i\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB,\xbaG)\x18\x01\x92\x02$When the snapshot group was created.R\n" +
+	"createTime\x12n\n" +
+	"\vupdate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB1\xbaG.\x18\x01\x92\x02)When the snapshot group was last updated.R\n" +
+	"updateTime\x12O\n" +
+	"\x02id\x18\x04 \x01(\tB?\xbaG\x1e\x92\x02\x1bThe resource id. Immutable.\xbaH\x1b\xc8\x01\x01r\x162\x14^sgp_[a-zA-Z0-9-_]+$R\x02id\x12d\n" +
+	"\x04name\x18\x05 \x01(\tBP\xbaG \x92\x02\x1dThe resource name. Immutable.\xbaH*\xc8\x01\x01r%2#^snapshotgroups/sgp_[a-zA-Z0-9-_]+$R\x04name\x12\xc6\x01\n" +
+	"\n" +
+	"volume_ids\x18\x06 \x03(\tB\xa6\x01\xbaG\x7f\x92\x02|The ids of the volumes to snapshot. They must be published on the same server host under the same parent dataset. Immutable.\xbaH!\x92\x01\x1e\b\x01\x18\x01\"\x18r\x162\x14^vol_[a-zA-Z0-9-_]+$R\tvolumeIds\x12q\n" +
+	"\fsnapshot_ids\x18\a \x03(\tBN\xbaGK\x18\x01\x92\x02FThe ids of the snapshots in the group, in the order of the volume ids.R\vsnapshotIds\x12o\n" +
+	"\vserver_host\x18\b \x01(\tBI\xbaG?\x18\x01\x92\x02:The resource name of the host the snapshots were taken on.\xbaH\x04r\x02\x10\x01H\x00R\n" +
+	"serverHost\x88\x01\x01:\xdf\x01\xbaGI\x92\x02FA set of snapshots of several volumes taken at the same point in time.\xbaH\x8f\x01\x1a\x8c\x01\n" +
+	"\"snapshot_group.name_id_consistency\x12<The 'name' field must be in the format 'snapshotgroups/{id}'\x1a(this.name == 'snapshotgroups/' + this.idB\x0e\n" +
+	"\f_server_host\"e\n" +
+	"\x17GetSnapshotGroupRequest\x12J\n" +
+	"\x02id\x18\x01 \x01(\tB:\xbaG\x19\x92\x02\x16The snapshot group id.\xbaH\x1b\xc8\x01\x01r\x162\x14^sgp_[a-zA-Z0-9-_]+$R\x02id\"\xd5\x01\n" +
+	"\x18GetSnapshotGroupResponse\x12c\n" +
+	"\x0esnapshot_group\x18\x01 \x01(\v2\x18.zfsilo.v1.SnapshotGroupB\"\xbaG\x1f\x92\x02\x1cThe snapshot group resource.R\rsnapshotGroup\x12T\n" +
+	"\tsnapshots\x18\x02 \x03(\v2\x13.zfsilo.v1.SnapshotB!\xbaG\x1e\x92\x02\x1bThe snapshots in the group.R\tsnapshots\"\x87\x01\n" +
+	"\x1aCreateSnapshotGroupRequest\x12i\n" +
+	"\x0esnapshot_group\x18\x01 \x01(\v2\x18.zfsilo.v1.SnapshotGroupB(\xbaG\x1f\x92\x02\x1cThe snapshot group resource.\xbaH\x03\xc8\x01\x01R\rsnapshotGroup\"\x91\x01\n" +
+	"\x1bCreateSnapshotGroupResponse\x12?\n" +
+	"\x0esnapshot_group\x18\x01 \x01(\v2\x18.zfsilo.v1.SnapshotGroupR\rsnapshotGroup\x121\n" +
+	"\tsnapshots\x18\x02 \x03(\v2\x13.zfsilo.v1.SnapshotR\tsnapshots\"h\n" +
+	"\x1aDeleteSnapshotGroupRequest\x12J\n" +
+	"\x02id\x18\x01 \x01(\tB:\xbaG\x19\x92\x02\x16The snapshot group id.\xbaH\x1b\xc8\x01\x01r\x162\x14^sgp_[a-zA-Z0-9-_]+$R\x02id\"\x1d\n" +
+	"\x1bDeleteSnapshotGroupResponse\"\x99\x03\n" +
+	"\x15RollbackVolumeRequest\x12V\n" +
+	"\x02id\x18\x01 \x01(\tBF\xbaG%\x92\x02\"The id of the volume to roll back.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12l\n" +
+	"\vsnapshot_id\x18\x02 \x01(\tBK\xbaG*\x92\x02'The id of the snapshot to roll back to.\xbaH\x1b\xc8\x01\x01r\x162\x14^snp_[a-zA-Z0-9-_]+$R\n" +
+	"snapshotId\x12\xb9\x01\n" +
+	"\rdestroy_newer\x18\x03 \x01(\bB\x93\x01\xbaG\x8f\x01\x92\x02\x8b\x01Whether to destroy snapshots more recent than the one being rolled back to. The rollback fails if such snapshots exist and this is not set.R\fdestroyNewer\"C\n" +
+	"\x16RollbackVolumeResponse\x12)\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xf8\x01\n" +
+	"\x14MigrateVolumeRequest\x12T\n" +
+	"\x02id\x18\x01 \x01(\tBD\xbaG#\x92\x02 The id of the volume to migrate.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12\x89\x01\n" +
+	"\vserver_host\x18\x02 \x01(\tBh\xbaGA\x92\x02>The resource name of the server host to migrate the volume to.\xbaH!\xc8\x01\x01r\x1c2\x1a^hosts/hst_[a-zA-Z0-9-_]+$R\n" +
+	"serverHost\"\xa3\x01\n" +
+	"\x15MigrateVolumeResponse\x12)\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\x12_\n" +
+	"\x11bytes_transferred\x18\x02 \x01(\x03B2\xbaG/\x92\x02,The number of bytes sent to the server host.R\x10bytesTransferred\"\x83\x01\n" +
+	"\x15FailoverVolumeRequest\x12j\n" +
+	"\x02id\x18\x01 \x01(\tBZ\xbaG9\x92\x026The id of the volume to fail over to its standby host.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\"C\n" +
+	"\x16FailoverVolumeResponse\x12)\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\x87\x04\n" +
+	"\fVolumeExport\x12j\n" +
+	"\x04name\x18\x01 \x01(\tBV\xbaGS\x92\x02PThe name of the export, which is also the name of the snapshot it was sent from.R\x04name\x12p\n" +
+	"\x04base\x18\x02 \x01(\tB\\\xbaGY\x92\x02VThe name of the export this export is incremental from. It is empty for a full export.R\x04base\x12e\n" +
+	"\n" +
+	"object_key\x18\x03 \x01(\tBF\xbaGC\x92\x02@The key of the object holding the send stream within the bucket.R\tobjectKey\x128\n" +
+	"\x05bytes\x18\x04 \x01(\x03B\"\xbaG\x1f\x92\x02\x1cThe size of the send stream.R\x05bytes\x12x\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB;\xbaG8\x92\x025When the snapshot the export was sent from was taken.R\n" +
+	"createTime\"\x87\x02\n" +
+	"\x13ExportVolumeRequest\x12S\n" +
+	"\x02id\x18\x01 \x01(\tBC\xbaG\"\x92\x02\x1fThe id of the volume to export.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12\x9a\x01\n" +
+	"\vincremental\x18\x02 \x01(\bBx\xbaGu\x92\x02rWhether to only export what changed since the last export of the volume. Otherwise the volume is exported in full.R\vincremental\"G\n" +
+	"\x14ExportVolumeResponse\x12/\n" +
+	"\x06export\x18\x01 \x01(\v2\x17.zfsilo.v1.VolumeExportR\x06export\"\xc4\x04\n" +
+	"\x13ImportVolumeRequest\x12\x85\x01\n" +
+	"\x02id\x18\x01 \x01(\tBu\xbaGT\x92\x02QThe id of the volume to import into. The volume must not have been published yet.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12\x88\x01\n" +
+	"\vserver_host\x18\x02 \x01(\tBg\xbaG@\x92\x02=The resource name of the server host to create the volume on.\xbaH!\xc8\x01\x01r\x1c2\x1a^hosts/hst_[a-zA-Z0-9-_]+$R\n" +
+	"serverHost\x12\x9f\x01\n" +
+	"\x10source_volume_id\x18\x03 \x01(\tBu\xbaGT\x92\x02QThe id of the volume the exports were taken of. Defaults to the id of the volume.\xbaH\x1br\x192\x17^(vol_[a-zA-Z0-9-_]+)?$R\x0esourceVolumeId\x12x\n" +
+	"\x06export\x18\x04 \x01(\tB`\xbaGD\x92\x02AThe name of the export to restore. Defaults to the latest export.\xbaH\x16r\x142\x12^(exp-[a-z0-9]+)?$R\x06export\"\xa6\x02\n" +
+	"\x14ImportVolumeResponse\x12)\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\x12~\n" +
+	"\aexports\x18\x02 \x03(\v2\x17.zfsilo.v1.VolumeExportBK\xbaGH\x92\x02EThe exports received, from the full export up to the restored export.R\aexports\x12c\n" +
+	"\x11bytes_transferred\x18\x03 \x01(\x03B6\xbaG3\x92\x020The number of bytes received on the server host.R\x10bytesTransferred\"\x89\x01\n" +
+	"\x18ListVolumeExportsRequest\x12m\n" +
+	"\tvolume_id\x18\x01 \x01(\tBP\xbaG/\x92\x02,The id of the volume to list the exports of.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\bvolumeId\"~\n" +
+	"\x19ListVolumeExportsResponse\x12a\n" +
+	"\aexports\x18\x01 \x03(\v2\x17.zfsilo.v1.VolumeExportB.\xbaG+\x92\x02(The exports of the volume, oldest first.R\aexports\"\x82\x01\n" +
+	"\x16RotateVolumeKeyRequest\x12h\n" +
+	"\x02id\x18\x01 \x01(\tBX\xbaG7\x92\x024The id of the encrypted volume to rotate the key of.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\"D\n" +
+	"\x17RotateVolumeKeyResponse\x12)\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xef\x02\n" +
+	"\x13ExpandVolumeRequest\x12S\n" +
+	"\x02id\x18\x01 \x01(\tBC\xbaG\"\x92\x02\x1fThe id of the volume to expand.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12\x82\x02\n" +
+	"\x0ecapacity_bytes\x18\x02 \x01(\x03B\xda\x01\xbaG\xcf\x01\x92\x02\xcb\x01The capacity to expand the volume to, which must not be less than its current capacity. An expansion to the current capacity grows the device and filesystem on the client host if they have not caught up.\xbaH\x04\"\x02 \x00R\rcapacityBytes\"\x85\x02\n" +
+	"\x14ExpandVolumeResponse\x12)\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\x12\xc1\x01\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03B\xa1\x01\xbaG\x9d\x01\x92\x02\x99\x01The size of the volume as seen by the client host after the expansion. It is the capacity of the volume when the volume is not connected or is a dataset.R\tsizeBytes\"\xfe\x02\n" +
+	"\x1eRotateVolumeCredentialsRequest\x12\xdb\x02\n" +
+	"\x02id\x18\x01 \x01(\tB\xca\x02\xbaG\xa8\x02\x92\x02\xa4\x02The id of the volume published over iSCSI or NVMe-oF to rotate the CHAP credentials of. Connected clients stay connected and use the new credentials the next time they connect. Over NVMe-oF, clients that still connect with the NQN of their host must be disconnected and connected again first.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\"L\n" +
+	"\x1fRotateVolumeCredentialsResponse\x12)\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.zfsilo.v1.VolumeR\x06volume\"\xb4\x04\n" +
+	"\x0eVolumeProperty\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xbaG \x92\x02\x1dThe name of the ZFS property.R\x04name\x12f\n" +
+	"\x05value\x18\x02 \x01(\tBP\xbaGM\x92\x02JThe effective value of the property, with sizes and numbers in exact form.R\x05value\x12\x80\x01\n" +
+	"\x06source\x18\x03 \x01(\x0e2 .zfsilo.v1.VolumeProperty.SourceBF\xbaGC\x92\x02@Where the value comes from. Read-only properties have no source.R\x06source\x12c\n" +
+	"\x0einherited_from\x18\x04 \x01(\tB<\xbaG9\x92\x026The dataset the value is inherited from, if inherited.R\rinheritedFrom\"\x98\x01\n" +
+	"\x06Source\x12\x16\n" +
+	"\x12SOURCE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fSOURCE_LOCAL\x10\x01\x12\x12\n" +
+	"\x0eSOURCE_DEFAULT\x10\x02\x12\x14\n" +
+	"\x10SOURCE_INHERITED\x10\x03\x12\x14\n" +
+	"\x10SOURCE_TEMPORARY\x10\x04\x12\x13\n" +
+	"\x0fSOURCE_RECEIVED\x10\x05\x12\x0f\n" +
+	"\vSOURCE_NONE\x10\x06\"\xef\x01\n" +
+	"\x1aGetVolumePropertiesRequest\x12I\n" +
+	"\x02id\x18\x01 \x01(\tB9\xbaG\x18\x92\x02\x15The id of the volume.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\x02id\x12\x85\x01\n" +
+	"\x05names\x18\x02 \x03(\tBo\xbaGL\x92\x02IThe names of the properties to get. All properties are returned if empty.\xbaH\x1d\x92\x01\x1a\"\x18r\x162\x14^[a-z][a-z0-9_.:-]*$R\x05names\"\x8e\x01\n" +
+	"\x1bGetVolumePropertiesResponse\x12o\n" +
+	"\n" +
+	"properties\x18\x01 \x03(\v2\x19.zfsilo.v1.VolumePropertyB4\xbaG1\x92\x02.The properties of the volume, ordered by name.R\n" +
+	"properties\"\xcd\t\n" +
+	"\x0eSnapshotPolicy\x12o\n" +
+	"\x06struct\x18\x01 \x01(\v2\x17.google.protobuf.StructB>\xbaG;\x92\x028Loosely structured data stored with the snapshot policy.R\x06struct\x12j\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB-\xbaG*\x18\x01\x92\x02%When the snapshot policy was created.R\n" +
+	"createTime\x12o\n" +
+	"\vupdate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB2\xbaG/\x18\x01\x92\x02*When the snapshot policy was last updated.R\n" +
+	"updateTime\x12O\n" +
+	"\x02id\x18\x04 \x01(\tB?\xbaG\x1e\x92\x02\x1bThe resource id. Immutable.\xbaH\x1b\xc8\x01\x01r\x162\x14^spl_[a-zA-Z0-9-_]+$R\x02id\x12f\n" +
+	"\x04name\x18\x05 \x01(\tBR\xbaG \x92\x02\x1dThe resource name. Immutable.\xbaH,\xc8\x01\x01r'2%^snapshotpolicies/spl_[a-zA-Z0-9-_]+$R\x04name\x12\xa9\x01\n" +
+	"\bschedule\x18\x06 \x01(\tB\x8c\x01\xbaG\x82\x01\x92\x02\x7fThe cron schedule, in the form 'minute hour day-of-month month day-of-week' and evaluated in UTC, on which snapshots are taken.\xbaH\x03\xc8\x01\x01R\bschedule\x12V\n" +
+	"\tkeep_last\x18\a \x01(\x05B9\xbaG/\x92\x02,The number of most recent snapshots to keep.\xbaH\x04\x1a\x02(\x00R\bkeepLast\x12v\n" +
+	"\n" +
+	"keep_daily\x18\b \x01(\x05BW\xbaGM\x92\x02JThe number of days for which the most recent snapshot of each day is kept.\xbaH\x04\x1a\x02(\x00R\tkeepDaily\x12z\n" +
+	"\vkeep_weekly\x18\t \x01(\x05BY\xbaGO\x92\x02LThe number of weeks for which the most recent snapshot of each week is kept.\xbaH\x04\x1a\x02(\x00R\n" +
+	"keepWeekly:\xbb\x01\xbaG \x92\x02\x1dThe snapshot policy resource.\xbaH\x94\x01\x1a\x91\x01\n" +
+	"#snapshot_policy.name_id_consistency\x12>The 'name' field must be in the format 'snapshotpolicies/{id}'\x1a*this.name == 'snapshotpolicies/' + this.id\"\x81\x06\n" +
+	"\x11SnapshotPolicyRun\x12O\n" +
+	"\tvolume_id\x18\x01 \x01(\tB2\xbaG/\x92\x02,The id of the volume the policy ran against.R\bvolumeId\x12[\n" +
+	"\x12snapshot_policy_id\x18\x02 \x01(\tB-\xbaG*\x92\x02'The id of the snapshot policy that ran.R\x10snapshotPolicyId\x12U\n" +
+	"\brun_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x1e\xbaG\x1b\x92\x02\x18When the run took place.R\arunTime\x12`\n" +
+	"\aoutcome\x18\x04 \x01(\x0e2$.zfsilo.v1.SnapshotPolicyRun.OutcomeB \xbaG\x1d\x92\x02\x1aWhether the run succeeded.R\aoutcome\x12F\n" +
+	"\amessage\x18\x05 \x01(\tB,\xbaG)\x92\x02&The error message when the run failed.R\amessage\x12O\n" +
+	"\vsnapshot_id\x18\x06 \x01(\tB.\xbaG+\x92\x02(The id of the snapshot taken by the run.R\n" +
+	"snapshotId\x12S\n" +
+	"\fpruned_count\x18\a \x01(\x05B0\xbaG-\x92\x02*The number of snapshots pruned by the run.R\vprunedCount\"M\n" +
+	"\aOutcome\x12\x17\n" +
+	"\x13OUTCOME_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11OUTCOME_SUCCEEDED\x10\x01\x12\x12\n" +
+	"\x0eOUTCOME_FAILED\x10\x02:H\xbaGE\x92\x02BThe outcome of the last run of a snapshot policy against a volume.\"g\n" +
+	"\x18GetSnapshotPolicyRequest\x12K\n" +
+	"\x02id\x18\x01 \x01(\tB;\xbaG\x1a\x92\x02\x17The snapshot policy id.\xbaH\x1b\xc8\x01\x01r\x162\x14^spl_[a-zA-Z0-9-_]+$R\x02id\"\x84\x01\n" +
+	"\x19GetSnapshotPolicyResponse\x12g\n" +
+	"\x0fsnapshot_policy\x18\x01 \x01(\v2\x19.zfsilo.v1.SnapshotPolicyB#\xbaG \x92\x02\x1dThe snapshot policy resource.R\x0esnapshotPolicy\"\xe4\x02\n" +
+	"\x1bListSnapshotPoliciesRequest\x128\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x1b\xbaG\x11\x92\x02\x0eThe page size.\xbaH\x04\x1a\x02(\x00R\bpageSize\x12I\n" +
+	"\x06filter\x18\x02 \x01(\tB1\xbaG.\x92\x02+The filter to apply over snapshot policies.R\x06filter\x12N\n" +
+	"\border_by\x18\x03 \x01(\tB3\xbaG0\x92\x02-The ordering to apply over snapshot policies.R\aorderBy\x12p\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tBQ\xbaGN\x92\x02KThe page token. Used in subsequent requests to page over snapshot policies.R\tpageToken\"\xf2\x01\n" +
+	"\x1cListSnapshotPoliciesResponse\x12l\n" +
+	"\x11snapshot_policies\x18\x01 \x03(\v2\x19.zfsilo.v1.SnapshotPolicyB$\xbaG!\x92\x02\x1eThe list of snapshot policies.R\x10snapshotPolicies\x12d\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB<\xbaG9\x92\x026The page token for the next page of snapshot policies.R\rnextPageToken\"\x8c\x01\n" +
+	"\x1bCreateSnapshotPolicyRequest\x12m\n" +
+	"\x0fsnapshot_policy\x18\x01 \x01(\v2\x19.zfsilo.v1.SnapshotPolicyB)\xbaG \x92\x02\x1dThe snapshot policy resource.\xbaH\x03\xc8\x01\x01R\x0esnapshotPolicy\"b\n" +
+	"\x1cCreateSnapshotPolicyResponse\x12B\n" +
+	"\x0fsnapshot_policy\x18\x01 \x01(\v2\x19.zfsilo.v1.SnapshotPolicyR\x0esnapshotPolicy\"\xef\x01\n" +
+	"\x1bUpdateSnapshotPolicyRequest\x12\xcf\x01\n" +
+	"\x0fsnapshot_policy\x18\x01 \x01(\v2\x17.google.protobuf.StructB\x8c\x01\xbaG\x82\x01\x92\x02\x7fThe snapshot policy to updated. Requires the snapshot policy id to be specified and then include only the fields to be changed.\xbaH\x03\xc8\x01\x01R\x0esnapshotPolicy\"b\n" +
+	"\x1cUpdateSnapshotPolicyResponse\x12B\n" +
+	"\x0fsnapshot_policy\x18\x01 \x01(\v2\x19.zfsilo.v1.SnapshotPolicyR\x0esnapshotPolicy\"j\n" +
+	"\x1bDeleteSnapshotPolicyRequest\x12K\n" +
+	"\x02id\x18\x01 \x01(\tB;\xbaG\x1a\x92\x02\x17The snapshot policy id.\xbaH\x1b\xc8\x01\x01r\x162\x14^spl_[a-zA-Z0-9-_]+$R\x02id\"\x1e\n" +
+	"\x1cDeleteSnapshotPolicyResponse\"\xb0\x04\n" +
+	"\x1dListSnapshotPolicyRunsRequest\x128\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x1b\xbaG\x11\x92\x02\x0eThe page size.\xbaH\x04\x1a\x02(\x00R\bpageSize\x12L\n" +
+	"\x06filter\x18\x02 \x01(\tB4\xbaG1\x92\x02.The filter to apply over snapshot policy runs.R\x06filter\x12Q\n" +
+	"\border_by\x18\x03 \x01(\tB6\xbaG3\x92\x020The ordering to apply over snapshot policy runs.R\aorderBy\x12s\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tBT\xbaGQ\x92\x02NThe page token. Used in subsequent requests to page over snapshot policy runs.R\tpageToken\x12i\n" +
+	"\x12snapshot_policy_id\x18\x05 \x01(\tB;\xbaG8\x92\x025Only return runs of the snapshot policy with this id.R\x10snapshotPolicyId\x12T\n" +
+	"\tvolume_id\x18\x06 \x01(\tB7\xbaG4\x92\x021Only return runs against the volume with this id.R\bvolumeId\"\x82\x02\n" +
+	"\x1eListSnapshotPolicyRunsResponse\x12w\n" +
+	"\x14snapshot_policy_runs\x18\x01 \x03(\v2\x1c.zfsilo.v1.SnapshotPolicyRunB'\xbaG$\x92\x02!The list of snapshot policy runs.R\x12snapshotPolicyRuns\x12g\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB?\xbaG<\x92\x029The page token for the next page of snapshot policy runs.R\rnextPageToken\"\x92\x13\n" +
+	"\vReplication\x12k\n" +
+	"\x06struct\x18\x01 \x01(\v2\x17.google.protobuf.StructB:\xbaG7\x92\x024Loosely structured data stored with the replication.R\x06struct\x12f\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB)\xbaG&\x18\x01\x92\x02!When the replication was created.R\n" +
+	"createTime\x12k\n" +
+	"\vupdate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB.\xbaG+\x18\x01\x92\x02&When the replication was last updated.R\n" +
+	"updateTime\x12O\n" +
+	"\x02id\x18\x04 \x01(\tB?\xbaG\x1e\x92\x02\x1bThe resource id. Immutable.\xbaH\x1b\xc8\x01\x01r\x162\x14^rpl_[a-zA-Z0-9-_]+$R\x02id\x12b\n" +
+	"\x04name\x18\x05 \x01(\tBN\xbaG \x92\x02\x1dThe resource name. Immutable.\xbaH(\xc8\x01\x01r#2!^replications/rpl_[a-zA-Z0-9-_]+$R\x04name\x12r\n" +
+	"\tvolume_id\x18\x06 \x01(\tBU\xbaG4\x92\x021The id of the volume being replicated. Immutable.\xbaH\x1b\xc8\x01\x01r\x162\x14^vol_[a-zA-Z0-9-_]+$R\bvolumeId\x12\x97\x01\n" +
+	"\vtarget_host\x18\a \x01(\tBv\xbaGO\x92\x02LThe resource name of the server host the volume is replicated to. Immutable.\xbaH!\xc8\x01\x01r\x1c2\x1a^hosts/hst_[a-zA-Z0-9-_]+$R\n" +
+	"targetHost\x12\x82\x01\n" +
+	"\x11target_dataset_id\x18\b \x01(\tBV\xbaGM\x92\x02JThe ZFS dataset on the target host the volume is replicated to. Immutable.\xbaH\x03\xc8\x01\x01R\x0ftargetDatasetId\x12\x8f\x01\n" +
+	"\x10interval_seconds\x18\t \x01(\x05Bd\xbaGZ\x92\x02WHow often, in seconds, the volume is replicated. Must be at least 60. Defaults to 3600.\xbaH\x04\x1a\x02(\x00R\x0fintervalSeconds\x12]\n" +
+	"\x06status\x18\n" +
+	" \x01(\v2\x1d.zfsilo.v1.Replication.StatusB&\xbaG#\x18\x01\x92\x02\x1eThe status of the replication.R\x06status\x12\x95\x01\n" +
+	"\astandby\x18\v \x01(\bB{\xbaGx\x18\x01\x92\x02sWhether the replication keeps the standby copy of the volume. It is managed through the standby host of the volume.R\astandby\x1a\xc1\a\n" +
+	"\x06Status\x12y\n" +
+	"\x0elast_sync_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB7\xbaG4\x92\x021When the volume was last successfully replicated.R\flastSyncTime\x12\x80\x01\n" +
+	"\x11last_attempt_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB8\xbaG5\x92\x022When replication of the volume was last attempted.R\x0flastAttemptTime\x12x\n" +
+	"\x16last_bytes_transferred\x18\x03 \x01(\x03BB\xbaG?\x92\x02<The number of bytes transferred by the last successful sync.R\x14lastBytesTransferred\x12v\n" +
+	"\x17total_bytes_transferred\x18\x04 \x01(\x03B>\xbaG;\x92\x028The number of bytes transferred by all successful syncs.R\x15totalBytesTransferred\x12\x82\x01\n" +
+	"\vlag_seconds\x18\x05 \x01(\x03Ba\xbaG^\x92\x02[How far, in seconds, the target is behind the volume. Zero until the first successful sync.R\n" +
+	"lagSeconds\x12Q\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tB2\xbaG/\x92\x02,The error of the last attempt, if it failed.R\tlastError\x12b\n" +
+	"\rlast_snapshot\x18\a \x01(\tB=\xbaG:\x92\x027The name of the last snapshot replicated to the target.R\flastSnapshot\x12\x8a\x01\n" +
+	"\x12last_snapshot_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB@\xbaG=\x92\x02:When the last snapshot replicated to the target was taken.R\x10lastSnapshotTime:\xab\x01\xbaG\x1c\x92\x02\x19The replication resource.\xbaH\x88\x01\x1a\x85\x01\n" +
+	"\x1freplication.name_id_consistency\x12:The 'name' field must be in the format 'replications/{id}'\x1a&this.name == 'replications/' + this.id\"`\n" +
+	"\x15GetReplicationRequest\x12G\n" +
+	"\x02id\x18\x01 \x01(\tB7\xbaG\x16\x92\x02\x13The replication id.\xbaH\x1b\xc8\x01\x01r\x162\x14^rpl_[a-zA-Z0-9-_]+$R\x02id\"s\n" +
+	"\x16GetReplicationResponse\x12Y\n" +
+	"\vreplication\x18\x01 \x01(\v2\x16.zfsilo.v1.ReplicationB\x1f\xbaG\x1c\x92\x02\x19The replication resource.R\vreplication\"\xaa\x03\n" +
+	"\x17ListReplicationsRequest\x128\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x1b\xbaG\x11\x92\x02\x0eThe page size.\xbaH\x04\x1a\x02(\x00R\bpageSize\x12D\n" +
+	"\x06filter\x18\x02 \x01(\tB,\xbaG)\x92\x02&The filter to apply over replications.R\x06filter\x12I\n" +
+	"\border_by\x18\x03 \x01(\tB.\xbaG+\x92\x02(The ordering to apply over replications.R\aorderBy\x12k\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tBL\xbaGI\x92\x02FThe page token. Used in subsequent requests to page over replications.R\tpageToken\x12W\n" +
+	"\tvolume_id\x18\x05 \x01(\tB:\xbaG7\x92\x024Only return replications of the volume with this id.R\bvolumeId\"\xd8\x01\n" +
+	"\x18ListReplicationsResponse\x12[\n" +
+	"\freplications\x18\x01 \x03(\v2\x16.zfsilo.v1.ReplicationB\x1f\xbaG\x1c\x92\x02\x19The list of replications.R\freplications\x12_\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB7\xbaG4\x92\x021The page token for the next page of replications.R\rnextPageToken\"{\n" +
+	"\x18CreateReplicationRequest\x12_\n" +
+	"\vreplication\x18\x01 \x01(\v2\x16.zfsilo.v1.ReplicationB%\xbaG\x1c\x92\x02\x19The replication resource.\xbaH\x03\xc8\x01\x01R\vreplication\"U\n" +
+	"\x19CreateReplicationResponse\x128\n" +
+	"\vreplication\x18\x01 \x01(\v2\x16.zfsilo.v1.ReplicationR\vreplication\"\xdc\x01\n" +
+	"\x18UpdateReplicationRequest\x12\xbf\x01\n" +
+	"\vreplication\x18\x01 \x01(\v2\x17.google.protobuf.StructB\x83\x01\xbaGz\x92\x02wThe replication to updated. Requires the replication id to be specified and then include only the fields to be changed.\xbaH\x03\xc8\x01\x01R\vreplication\"U\n" +
+	"\x19UpdateReplicationResponse\x128\n" +
+	"\vreplication\x18\x01 \x01(\v2\x16.zfsilo.v1.ReplicationR\vreplication\"c\n" +
+	"\x18DeleteReplicationRequest\x12G\n" +
+	"\x02id\x18\x01 \x01(\tB7\xbaG\x16\x92\x02\x13The replication id.\xbaH\x1b\xc8\x01\x01r\x162\x14^rpl_[a-zA-Z0-9-_]+$R\x02id\"\x1b\n" +
+	"\x19DeleteReplicationResponse\"p\n" +
+	"\x16SyncReplicationRequest\x12V\n" +
+	"\x02id\x18\x01 \x01(\tBF\xbaG%\x92\x02\"The id of the replication to sync.\xbaH\x1b\xc8\x01\x01r\x162\x14^rpl_[a-zA-Z0-9-_]+$R\x02id\"S\n" +
+	"\x17SyncReplicationResponse\x128\n" +
+	"\vreplication\x18\x01 \x01(\v2\x16.zfsilo.v1.ReplicationR\vreplication2\x90\x02\n" +
 	"\aService\x12\x84\x02\n" +
-	"\vGetCapacity\x12\x1d.zfsilo.v1.GetCapacityRequest\x1a\x1e.zfsilo.v1.GetCapacityResponse\"\xb5\x01\xbaG\xb1\x01\x12*Return the current free capacity in bytes.\x1a\x82\x01GetCapacity returns a non‑negative available_capacity_bytes value indicating how many bytes are still available for allocation. 2\x82\x03\n" +
+	"\vGetCapacity\x12\x1d.zfsilo.v1.GetCapacityRequest\x1a\x1e.zfsilo.v1.GetCapacityResponse\"\xb5\x01\xbaG\xb1\x01\x12*Return the current free capacity in bytes.\x1a\x82\x01GetCapacity returns a non‑negative available_capacity_bytes value indicating how many bytes are still available for allocation. 2\xd8\x03\n" +
 	"\vHostService\x12B\n" +
 	"\aGetHost\x12\x19.zfsilo.v1.GetHostRequest\x1a\x1a.zfsilo.v1.GetHostResponse\"\x00\x12H\n" +
 	"\tListHosts\x12\x1b.zfsilo.v1.ListHostsRequest\x1a\x1c.zfsilo.v1.ListHostsResponse\"\x00\x12K\n" +
@@ -3244,8 +8769,12 @@ const file_zfsilo_v1_zfsilo_proto_rawDesc = "" +
 	"\n" +
 	"UpdateHost\x12\x1c.zfsilo.v1.UpdateHostRequest\x1a\x1d.zfsilo.v1.UpdateHostResponse\"\x00\x12K\n" +
 	"\n" +
-	"DeleteHost\x12\x1c.zfsilo.v1.DeleteHostRequest\x1a\x1d.zfsilo.v1.DeleteHostResponse\"\x002\xc2\n" +
-	"\n" +
+	"DeleteHost\x12\x1c.zfsilo.v1.DeleteHostRequest\x1a\x1d.zfsilo.v1.DeleteHostResponse\"\x00\x12T\n" +
+	"\rRotateHostKey\x12\x1f.zfsilo.v1.RotateHostKeyRequest\x1a .zfsilo.v1.RotateHostKeyResponse\"\x002\xf1\x01\n" +
+	"\vPoolService\x12B\n" +
+	"\aGetPool\x12\x19.zfsilo.v1.GetPoolRequest\x1a\x1a.zfsilo.v1.GetPoolResponse\"\x00\x12H\n" +
+	"\tListPools\x12\x1b.zfsilo.v1.ListPoolsRequest\x1a\x1c.zfsilo.v1.ListPoolsResponse\"\x00\x12T\n" +
+	"\rGetPoolStatus\x12\x1f.zfsilo.v1.GetPoolStatusRequest\x1a .zfsilo.v1.GetPoolStatusResponse\"\x002\xe4\x16\n" +
 	"\rVolumeService\x12H\n" +
 	"\tGetVolume\x12\x1b.zfsilo.v1.GetVolumeRequest\x1a\x1c.zfsilo.v1.GetVolumeResponse\"\x00\x12N\n" +
 	"\vListVolumes\x12\x1d.zfsilo.v1.ListVolumesRequest\x1a\x1e.zfsilo.v1.ListVolumesResponse\"\x00\x12Q\n" +
@@ -3263,7 +8792,38 @@ const file_zfsilo_v1_zfsilo_proto_rawDesc = "" +
 	"\vStatsVolume\x12\x1d.zfsilo.v1.StatsVolumeRequest\x1a\x1e.zfsilo.v1.StatsVolumeResponse\"\x00\x12K\n" +
 	"\n" +
 	"SyncVolume\x12\x1c.zfsilo.v1.SyncVolumeRequest\x1a\x1d.zfsilo.v1.SyncVolumeResponse\"\x00\x12N\n" +
-	"\vSyncVolumes\x12\x1d.zfsilo.v1.SyncVolumesRequest\x1a\x1e.zfsilo.v1.SyncVolumesResponse\"\x00B\x8c\x03\xbaG\xee\x01\x12\xc7\x01\n" +
+	"\vSyncVolumes\x12\x1d.zfsilo.v1.SyncVolumesRequest\x1a\x1e.zfsilo.v1.SyncVolumesResponse\"\x00\x12N\n" +
+	"\vGetSnapshot\x12\x1d.zfsilo.v1.GetSnapshotRequest\x1a\x1e.zfsilo.v1.GetSnapshotResponse\"\x00\x12T\n" +
+	"\rListSnapshots\x12\x1f.zfsilo.v1.ListSnapshotsRequest\x1a .zfsilo.v1.ListSnapshotsResponse\"\x00\x12W\n" +
+	"\x0eCreateSnapshot\x12 .zfsilo.v1.CreateSnapshotRequest\x1a!.zfsilo.v1.CreateSnapshotResponse\"\x00\x12W\n" +
+	"\x0eDeleteSnapshot\x12 .zfsilo.v1.DeleteSnapshotRequest\x1a!.zfsilo.v1.DeleteSnapshotResponse\"\x00\x12]\n" +
+	"\x10GetSnapshotGroup\x12\".zfsilo.v1.GetSnapshotGroupRequest\x1a#.zfsilo.v1.GetSnapshotGroupResponse\"\x00\x12f\n" +
+	"\x13CreateSnapshotGroup\x12%.zfsilo.v1.CreateSnapshotGroupRequest\x1a&.zfsilo.v1.CreateSnapshotGroupResponse\"\x00\x12f\n" +
+	"\x13DeleteSnapshotGroup\x12%.zfsilo.v1.DeleteSnapshotGroupRequest\x1a&.zfsilo.v1.DeleteSnapshotGroupResponse\"\x00\x12W\n" +
+	"\x0eRollbackVolume\x12 .zfsilo.v1.RollbackVolumeRequest\x1a!.zfsilo.v1.RollbackVolumeResponse\"\x00\x12T\n" +
+	"\rMigrateVolume\x12\x1f.zfsilo.v1.MigrateVolumeRequest\x1a .zfsilo.v1.MigrateVolumeResponse\"\x00\x12W\n" +
+	"\x0eFailoverVolume\x12 .zfsilo.v1.FailoverVolumeRequest\x1a!.zfsilo.v1.FailoverVolumeResponse\"\x00\x12Q\n" +
+	"\fExportVolume\x12\x1e.zfsilo.v1.ExportVolumeRequest\x1a\x1f.zfsilo.v1.ExportVolumeResponse\"\x00\x12Q\n" +
+	"\fImportVolume\x12\x1e.zfsilo.v1.ImportVolumeRequest\x1a\x1f.zfsilo.v1.ImportVolumeResponse\"\x00\x12`\n" +
+	"\x11ListVolumeExports\x12#.zfsilo.v1.ListVolumeExportsRequest\x1a$.zfsilo.v1.ListVolumeExportsResponse\"\x00\x12Z\n" +
+	"\x0fRotateVolumeKey\x12!.zfsilo.v1.RotateVolumeKeyRequest\x1a\".zfsilo.v1.RotateVolumeKeyResponse\"\x00\x12f\n" +
+	"\x13GetVolumeProperties\x12%.zfsilo.v1.GetVolumePropertiesRequest\x1a&.zfsilo.v1.GetVolumePropertiesResponse\"\x00\x12Q\n" +
+	"\fExpandVolume\x12\x1e.zfsilo.v1.ExpandVolumeRequest\x1a\x1f.zfsilo.v1.ExpandVolumeResponse\"\x00\x12r\n" +
+	"\x17RotateVolumeCredentials\x12).zfsilo.v1.RotateVolumeCredentialsRequest\x1a*.zfsilo.v1.RotateVolumeCredentialsResponse\"\x002\x96\x05\n" +
+	"\x15SnapshotPolicyService\x12`\n" +
+	"\x11GetSnapshotPolicy\x12#.zfsilo.v1.GetSnapshotPolicyRequest\x1a$.zfsilo.v1.GetSnapshotPolicyResponse\"\x00\x12i\n" +
+	"\x14ListSnapshotPolicies\x12&.zfsilo.v1.ListSnapshotPoliciesRequest\x1a'.zfsilo.v1.ListSnapshotPoliciesResponse\"\x00\x12i\n" +
+	"\x14CreateSnapshotPolicy\x12&.zfsilo.v1.CreateSnapshotPolicyRequest\x1a'.zfsilo.v1.CreateSnapshotPolicyResponse\"\x00\x12i\n" +
+	"\x14UpdateSnapshotPolicy\x12&.zfsilo.v1.UpdateSnapshotPolicyRequest\x1a'.zfsilo.v1.UpdateSnapshotPolicyResponse\"\x00\x12i\n" +
+	"\x14DeleteSnapshotPolicy\x12&.zfsilo.v1.DeleteSnapshotPolicyRequest\x1a'.zfsilo.v1.DeleteSnapshotPolicyResponse\"\x00\x12o\n" +
+	"\x16ListSnapshotPolicyRuns\x12(.zfsilo.v1.ListSnapshotPolicyRunsRequest\x1a).zfsilo.v1.ListSnapshotPolicyRunsResponse\"\x002\xce\x04\n" +
+	"\x12ReplicationService\x12W\n" +
+	"\x0eGetReplication\x12 .zfsilo.v1.GetReplicationRequest\x1a!.zfsilo.v1.GetReplicationResponse\"\x00\x12]\n" +
+	"\x10ListReplications\x12\".zfsilo.v1.ListReplicationsRequest\x1a#.zfsilo.v1.ListReplicationsResponse\"\x00\x12`\n" +
+	"\x11CreateReplication\x12#.zfsilo.v1.CreateReplicationRequest\x1a$.zfsilo.v1.CreateReplicationResponse\"\x00\x12`\n" +
+	"\x11UpdateReplication\x12#.zfsilo.v1.UpdateReplicationRequest\x1a$.zfsilo.v1.UpdateReplicationResponse\"\x00\x12`\n" +
+	"\x11DeleteReplication\x12#.zfsilo.v1.DeleteReplicationRequest\x1a$.zfsilo.v1.DeleteReplicationResponse\"\x00\x12Z\n" +
+	"\x0fSyncReplication\x12!.zfsilo.v1.SyncReplicationRequest\x1a\".zfsilo.v1.SyncReplicationResponse\"\x00B\x8c\x03\xbaG\xee\x01\x12\xc7\x01\n" +
 	"\x06ZFSilo\x12-A ZFS-based network storage layer over iSCSI.\"C\n" +
 	"\vJosip Vulic\x12!https://github.com/jovulic/zfsilo\x1a\x11jovulic@gmail.com*B\n" +
 	"\vMIT License\x123https://github.com/jovulic/zfsilo/blob/main/LICENSE2\x050.1.0*\": \n" +
@@ -3287,160 +8847,389 @@ func file_zfsilo_v1_zfsilo_proto_rawDescGZIP() []byte {
 	return file_zfsilo_v1_zfsilo_proto_rawDescData
 }
 
-var file_zfsilo_v1_zfsilo_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_zfsilo_v1_zfsilo_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_zfsilo_v1_zfsilo_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_zfsilo_v1_zfsilo_proto_msgTypes = make([]protoimpl.MessageInfo, 137)
 var file_zfsilo_v1_zfsilo_proto_goTypes = []any{
-	(Volume_Mode)(0),                          // 0: zfsilo.v1.Volume.Mode
-	(Volume_Status)(0),                        // 1: zfsilo.v1.Volume.Status
-	(Volume_Transport)(0),                     // 2: zfsilo.v1.Volume.Transport
-	(StatsVolumeResponse_Stats_Usage_Unit)(0), // 3: zfsilo.v1.StatsVolumeResponse.Stats.Usage.Unit
-	(*GetCapacityRequest)(nil),                // 4: zfsilo.v1.GetCapacityRequest
-	(*GetCapacityResponse)(nil),               // 5: zfsilo.v1.GetCapacityResponse
-	(*Host)(nil),                              // 6: zfsilo.v1.Host
-	(*GetHostRequest)(nil),                    // 7: zfsilo.v1.GetHostRequest
-	(*GetHostResponse)(nil),                   // 8: zfsilo.v1.GetHostResponse
-	(*ListHostsRequest)(nil),                  // 9: zfsilo.v1.ListHostsRequest
-	(*ListHostsResponse)(nil),                 // 10: zfsilo.v1.ListHostsResponse
-	(*CreateHostRequest)(nil),                 // 11: zfsilo.v1.CreateHostRequest
-	(*CreateHostResponse)(nil),                // 12: zfsilo.v1.CreateHostResponse
-	(*UpdateHostRequest)(nil),                 // 13: zfsilo.v1.UpdateHostRequest
-	(*UpdateHostResponse)(nil),                // 14: zfsilo.v1.UpdateHostResponse
-	(*DeleteHostRequest)(nil),                 // 15: zfsilo.v1.DeleteHostRequest
-	(*DeleteHostResponse)(nil),                // 16: zfsilo.v1.DeleteHostResponse
-	(*Volume)(nil),                            // 17: zfsilo.v1.Volume
-	(*GetVolumeRequest)(nil),                  // 18: zfsilo.v1.GetVolumeRequest
-	(*GetVolumeResponse)(nil),                 // 19: zfsilo.v1.GetVolumeResponse
-	(*ListVolumesRequest)(nil),                // 20: zfsilo.v1.ListVolumesRequest
-	(*ListVolumesResponse)(nil),               // 21: zfsilo.v1.ListVolumesResponse
-	(*CreateVolumeRequest)(nil),               // 22: zfsilo.v1.CreateVolumeRequest
-	(*CreateVolumeResponse)(nil),              // 23: zfsilo.v1.CreateVolumeResponse
-	(*UpdateVolumeRequest)(nil),               // 24: zfsilo.v1.UpdateVolumeRequest
-	(*UpdateVolumeResponse)(nil),              // 25: zfsilo.v1.UpdateVolumeResponse
-	(*DeleteVolumeRequest)(nil),               // 26: zfsilo.v1.DeleteVolumeRequest
-	(*DeleteVolumeResponse)(nil),              // 27: zfsilo.v1.DeleteVolumeResponse
-	(*PublishVolumeRequest)(nil),              // 28: zfsilo.v1.PublishVolumeRequest
-	(*PublishVolumeResponse)(nil),             // 29: zfsilo.v1.PublishVolumeResponse
-	(*UnpublishVolumeRequest)(nil),            // 30: zfsilo.v1.UnpublishVolumeRequest
-	(*UnpublishVolumeResponse)(nil),           // 31: zfsilo.v1.UnpublishVolumeResponse
-	(*ConnectVolumeRequest)(nil),              // 32: zfsilo.v1.ConnectVolumeRequest
-	(*ConnectVolumeResponse)(nil),             // 33: zfsilo.v1.ConnectVolumeResponse
-	(*DisconnectVolumeRequest)(nil),           // 34: zfsilo.v1.DisconnectVolumeRequest
-	(*DisconnectVolumeResponse)(nil),          // 35: zfsilo.v1.DisconnectVolumeResponse
-	(*StageVolumeRequest)(nil),                // 36: zfsilo.v1.StageVolumeRequest
-	(*StageVolumeResponse)(nil),               // 37: zfsilo.v1.StageVolumeResponse
-	(*UnstageVolumeRequest)(nil),              // 38: zfsilo.v1.UnstageVolumeRequest
-	(*UnstageVolumeResponse)(nil),             // 39: zfsilo.v1.UnstageVolumeResponse
-	(*MountVolumeRequest)(nil),                // 40: zfsilo.v1.MountVolumeRequest
-	(*MountVolumeResponse)(nil),               // 41: zfsilo.v1.MountVolumeResponse
-	(*UnmountVolumeRequest)(nil),              // 42: zfsilo.v1.UnmountVolumeRequest
-	(*UnmountVolumeResponse)(nil),             // 43: zfsilo.v1.UnmountVolumeResponse
-	(*StatsVolumeRequest)(nil),                // 44: zfsilo.v1.StatsVolumeRequest
-	(*StatsVolumeResponse)(nil),               // 45: zfsilo.v1.StatsVolumeResponse
-	(*SyncVolumeRequest)(nil),                 // 46: zfsilo.v1.SyncVolumeRequest
-	(*SyncVolumeResponse)(nil),                // 47: zfsilo.v1.SyncVolumeResponse
-	(*SyncVolumesRequest)(nil),                // 48: zfsilo.v1.SyncVolumesRequest
-	(*SyncVolumesResponse)(nil),               // 49: zfsilo.v1.SyncVolumesResponse
-	(*Host_Connection)(nil),                   // 50: zfsilo.v1.Host.Connection
-	(*Host_Role)(nil),                         // 51: zfsilo.v1.Host.Role
-	(*Host_Connection_Local)(nil),             // 52: zfsilo.v1.Host.Connection.Local
-	(*Host_Connection_Remote)(nil),            // 53: zfsilo.v1.Host.Connection.Remote
-	(*Host_Role_Server)(nil),                  // 54: zfsilo.v1.Host.Role.Server
-	(*Host_Role_Client)(nil),                  // 55: zfsilo.v1.Host.Role.Client
-	(*Volume_Option)(nil),                     // 56: zfsilo.v1.Volume.Option
-	(*StatsVolumeResponse_Stats)(nil),         // 57: zfsilo.v1.StatsVolumeResponse.Stats
-	(*StatsVolumeResponse_Stats_Usage)(nil),   // 58: zfsilo.v1.StatsVolumeResponse.Stats.Usage
-	(*timestamppb.Timestamp)(nil),             // 59: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),                   // 60: google.protobuf.Struct
+	(Pool_Health)(0),                           // 0: zfsilo.v1.Pool.Health
+	(PoolStatus_Scrub_State)(0),                // 1: zfsilo.v1.PoolStatus.Scrub.State
+	(Volume_Mode)(0),                           // 2: zfsilo.v1.Volume.Mode
+	(Volume_Status)(0),                         // 3: zfsilo.v1.Volume.Status
+	(Volume_Transport)(0),                      // 4: zfsilo.v1.Volume.Transport
+	(Volume_FSType)(0),                         // 5: zfsilo.v1.Volume.FSType
+	(StatsVolumeResponse_Stats_Usage_Unit)(0),  // 6: zfsilo.v1.StatsVolumeResponse.Stats.Usage.Unit
+	(VolumeProperty_Source)(0),                 // 7: zfsilo.v1.VolumeProperty.Source
+	(SnapshotPolicyRun_Outcome)(0),             // 8: zfsilo.v1.SnapshotPolicyRun.Outcome
+	(*GetCapacityRequest)(nil),                 // 9: zfsilo.v1.GetCapacityRequest
+	(*GetCapacityResponse)(nil),                // 10: zfsilo.v1.GetCapacityResponse
+	(*Host)(nil),                               // 11: zfsilo.v1.Host
+	(*GetHostRequest)(nil),                     // 12: zfsilo.v1.GetHostRequest
+	(*GetHostResponse)(nil),                    // 13: zfsilo.v1.GetHostResponse
+	(*ListHostsRequest)(nil),                   // 14: zfsilo.v1.ListHostsRequest
+	(*ListHostsResponse)(nil),                  // 15: zfsilo.v1.ListHostsResponse
+	(*CreateHostRequest)(nil),                  // 16: zfsilo.v1.CreateHostRequest
+	(*CreateHostResponse)(nil),                 // 17: zfsilo.v1.CreateHostResponse
+	(*UpdateHostRequest)(nil),                  // 18: zfsilo.v1.UpdateHostRequest
+	(*UpdateHostResponse)(nil),                 // 19: zfsilo.v1.UpdateHostResponse
+	(*DeleteHostRequest)(nil),                  // 20: zfsilo.v1.DeleteHostRequest
+	(*DeleteHostResponse)(nil),                 // 21: zfsilo.v1.DeleteHostResponse
+	(*RotateHostKeyRequest)(nil),               // 22: zfsilo.v1.RotateHostKeyRequest
+	(*RotateHostKeyResponse)(nil),              // 23: zfsilo.v1.RotateHostKeyResponse
+	(*Pool)(nil),                               // 24: zfsilo.v1.Pool
+	(*PoolStatus)(nil),                         // 25: zfsilo.v1.PoolStatus
+	(*GetPoolRequest)(nil),                     // 26: zfsilo.v1.GetPoolRequest
+	(*GetPoolResponse)(nil),                    // 27: zfsilo.v1.GetPoolResponse
+	(*GetPoolStatusRequest)(nil),               // 28: zfsilo.v1.GetPoolStatusRequest
+	(*GetPoolStatusResponse)(nil),              // 29: zfsilo.v1.GetPoolStatusResponse
+	(*ListPoolsRequest)(nil),                   // 30: zfsilo.v1.ListPoolsRequest
+	(*ListPoolsResponse)(nil),                  // 31: zfsilo.v1.ListPoolsResponse
+	(*Volume)(nil),                             // 32: zfsilo.v1.Volume
+	(*GetVolumeRequest)(nil),                   // 33: zfsilo.v1.GetVolumeRequest
+	(*GetVolumeResponse)(nil),                  // 34: zfsilo.v1.GetVolumeResponse
+	(*ListVolumesRequest)(nil),                 // 35: zfsilo.v1.ListVolumesRequest
+	(*ListVolumesResponse)(nil),                // 36: zfsilo.v1.ListVolumesResponse
+	(*CreateVolumeRequest)(nil),                // 37: zfsilo.v1.CreateVolumeRequest
+	(*CreateVolumeResponse)(nil),               // 38: zfsilo.v1.CreateVolumeResponse
+	(*UpdateVolumeRequest)(nil),                // 39: zfsilo.v1.UpdateVolumeRequest
+	(*UpdateVolumeResponse)(nil),               // 40: zfsilo.v1.UpdateVolumeResponse
+	(*DeleteVolumeRequest)(nil),                // 41: zfsilo.v1.DeleteVolumeRequest
+	(*DeleteVolumeResponse)(nil),               // 42: zfsilo.v1.DeleteVolumeResponse
+	(*PublishVolumeRequest)(nil),               // 43: zfsilo.v1.PublishVolumeRequest
+	(*PublishVolumeResponse)(nil),              // 44: zfsilo.v1.PublishVolumeResponse
+	(*UnpublishVolumeRequest)(nil),             // 45: zfsilo.v1.UnpublishVolumeRequest
+	(*UnpublishVolumeResponse)(nil),            // 46: zfsilo.v1.UnpublishVolumeResponse
+	(*ConnectVolumeRequest)(nil),               // 47: zfsilo.v1.ConnectVolumeRequest
+	(*ConnectVolumeResponse)(nil),              // 48: zfsilo.v1.ConnectVolumeResponse
+	(*DisconnectVolumeRequest)(nil),            // 49: zfsilo.v1.DisconnectVolumeRequest
+	(*DisconnectVolumeResponse)(nil),           // 50: zfsilo.v1.DisconnectVolumeResponse
+	(*StageVolumeRequest)(nil),                 // 51: zfsilo.v1.StageVolumeRequest
+	(*StageVolumeResponse)(nil),                // 52: zfsilo.v1.StageVolumeResponse
+	(*UnstageVolumeRequest)(nil),               // 53: zfsilo.v1.UnstageVolumeRequest
+	(*UnstageVolumeResponse)(nil),              // 54: zfsilo.v1.UnstageVolumeResponse
+	(*MountVolumeRequest)(nil),                 // 55: zfsilo.v1.MountVolumeRequest
+	(*MountVolumeResponse)(nil),                // 56: zfsilo.v1.MountVolumeResponse
+	(*UnmountVolumeRequest)(nil),               // 57: zfsilo.v1.UnmountVolumeRequest
+	(*UnmountVolumeResponse)(nil),              // 58: zfsilo.v1.UnmountVolumeResponse
+	(*StatsVolumeRequest)(nil),                 // 59: zfsilo.v1.StatsVolumeRequest
+	(*StatsVolumeResponse)(nil),                // 60: zfsilo.v1.StatsVolumeResponse
+	(*SyncVolumeRequest)(nil),                  // 61: zfsilo.v1.SyncVolumeRequest
+	(*SyncVolumeResponse)(nil),                 // 62: zfsilo.v1.SyncVolumeResponse
+	(*SyncVolumesRequest)(nil),                 // 63: zfsilo.v1.SyncVolumesRequest
+	(*SyncVolumesResponse)(nil),                // 64: zfsilo.v1.SyncVolumesResponse
+	(*Snapshot)(nil),                           // 65: zfsilo.v1.Snapshot
+	(*GetSnapshotRequest)(nil),                 // 66: zfsilo.v1.GetSnapshotRequest
+	(*GetSnapshotResponse)(nil),                // 67: zfsilo.v1.GetSnapshotResponse
+	(*ListSnapshotsRequest)(nil),               // 68: zfsilo.v1.ListSnapshotsRequest
+	(*ListSnapshotsResponse)(nil),              // 69: zfsilo.v1.ListSnapshotsResponse
+	(*CreateSnapshotRequest)(nil),              // 70: zfsilo.v1.CreateSnapshotRequest
+	(*CreateSnapshotResponse)(nil),             // 71: zfsilo.v1.CreateSnapshotResponse
+	(*DeleteSnapshotRequest)(nil),              // 72: zfsilo.v1.DeleteSnapshotRequest
+	(*DeleteSnapshotResponse)(nil),             // 73: zfsilo.v1.DeleteSnapshotResponse
+	(*SnapshotGroup)(nil),                      // 74: zfsilo.v1.SnapshotGroup
+	(*GetSnapshotGroupRequest)(nil),            // 75: zfsilo.v1.GetSnapshotGroupRequest
+	(*GetSnapshotGroupResponse)(nil),           // 76: zfsilo.v1.GetSnapshotGroupResponse
+	(*CreateSnapshotGroupRequest)(nil),         // 77: zfsilo.v1.CreateSnapshotGroupRequest
+	(*CreateSnapshotGroupResponse)(nil),        // 78: zfsilo.v1.CreateSnapshotGroupResponse
+	(*DeleteSnapshotGroupRequest)(nil),         // 79: zfsilo.v1.DeleteSnapshotGroupRequest
+	(*DeleteSnapshotGroupResponse)(nil),        // 80: zfsilo.v1.DeleteSnapshotGroupResponse
+	(*RollbackVolumeRequest)(nil),              // 81: zfsilo.v1.RollbackVolumeRequest
+	(*RollbackVolumeResponse)(nil),             // 82: zfsilo.v1.RollbackVolumeResponse
+	(*MigrateVolumeRequest)(nil),               // 83: zfsilo.v1.MigrateVolumeRequest
+	(*MigrateVolumeResponse)(nil),              // 84: zfsilo.v1.MigrateVolumeResponse
+	(*FailoverVolumeRequest)(nil),              // 85: zfsilo.v1.FailoverVolumeRequest
+	(*FailoverVolumeResponse)(nil),             // 86: zfsilo.v1.FailoverVolumeResponse
+	(*VolumeExport)(nil),                       // 87: zfsilo.v1.VolumeExport
+	(*ExportVolumeRequest)(nil),                // 88: zfsilo.v1.ExportVolumeRequest
+	(*ExportVolumeResponse)(nil),               // 89: zfsilo.v1.ExportVolumeResponse
+	(*ImportVolumeRequest)(nil),                // 90: zfsilo.v1.ImportVolumeRequest
+	(*ImportVolumeResponse)(nil),               // 91: zfsilo.v1.ImportVolumeResponse
+	(*ListVolumeExportsRequest)(nil),           // 92: zfsilo.v1.ListVolumeExportsRequest
+	(*ListVolumeExportsResponse)(nil),          // 93: zfsilo.v1.ListVolumeExportsResponse
+	(*RotateVolumeKeyRequest)(nil),             // 94: zfsilo.v1.RotateVolumeKeyRequest
+	(*RotateVolumeKeyResponse)(nil),            // 95: zfsilo.v1.RotateVolumeKeyResponse
+	(*ExpandVolumeRequest)(nil),                // 96: zfsilo.v1.ExpandVolumeRequest
+	(*ExpandVolumeResponse)(nil),               // 97: zfsilo.v1.ExpandVolumeResponse
+	(*RotateVolumeCredentialsRequest)(nil),     // 98: zfsilo.v1.RotateVolumeCredentialsRequest
+	(*RotateVolumeCredentialsResponse)(nil),    // 99: zfsilo.v1.RotateVolumeCredentialsResponse
+	(*VolumeProperty)(nil),                     // 100: zfsilo.v1.VolumeProperty
+	(*GetVolumePropertiesRequest)(nil),         // 101: zfsilo.v1.GetVolumePropertiesRequest
+	(*GetVolumePropertiesResponse)(nil),        // 102: zfsilo.v1.GetVolumePropertiesResponse
+	(*SnapshotPolicy)(nil),                     // 103: zfsilo.v1.SnapshotPolicy
+	(*SnapshotPolicyRun)(nil),                  // 104: zfsilo.v1.SnapshotPolicyRun
+	(*GetSnapshotPolicyRequest)(nil),           // 105: zfsilo.v1.GetSnapshotPolicyRequest
+	(*GetSnapshotPolicyResponse)(nil),          // 106: zfsilo.v1.GetSnapshotPolicyResponse
+	(*ListSnapshotPoliciesRequest)(nil),        // 107: zfsilo.v1.ListSnapshotPoliciesRequest
+	(*ListSnapshotPoliciesResponse)(nil),       // 108: zfsilo.v1.ListSnapshotPoliciesResponse
+	(*CreateSnapshotPolicyRequest)(nil),        // 109: zfsilo.v1.CreateSnapshotPolicyRequest
+	(*CreateSnapshotPolicyResponse)(nil),       // 110: zfsilo.v1.CreateSnapshotPolicyResponse
+	(*UpdateSnapshotPolicyRequest)(nil),        // 111: zfsilo.v1.UpdateSnapshotPolicyRequest
+	(*UpdateSnapshotPolicyResponse)(nil),       // 112: zfsilo.v1.UpdateSnapshotPolicyResponse
+	(*DeleteSnapshotPolicyRequest)(nil),        // 113: zfsilo.v1.DeleteSnapshotPolicyRequest
+	(*DeleteSnapshotPolicyResponse)(nil),       // 114: zfsilo.v1.DeleteSnapshotPolicyResponse
+	(*ListSnapshotPolicyRunsRequest)(nil),      // 115: zfsilo.v1.ListSnapshotPolicyRunsRequest
+	(*ListSnapshotPolicyRunsResponse)(nil),     // 116: zfsilo.v1.ListSnapshotPolicyRunsResponse
+	(*Replication)(nil),                        // 117: zfsilo.v1.Replication
+	(*GetReplicationRequest)(nil),              // 118: zfsilo.v1.GetReplicationRequest
+	(*GetReplicationResponse)(nil),             // 119: zfsilo.v1.GetReplicationResponse
+	(*ListReplicationsRequest)(nil),            // 120: zfsilo.v1.ListReplicationsRequest
+	(*ListReplicationsResponse)(nil),           // 121: zfsilo.v1.ListReplicationsResponse
+	(*CreateReplicationRequest)(nil),           // 122: zfsilo.v1.CreateReplicationRequest
+	(*CreateReplicationResponse)(nil),          // 123: zfsilo.v1.CreateReplicationResponse
+	(*UpdateReplicationRequest)(nil),           // 124: zfsilo.v1.UpdateReplicationRequest
+	(*UpdateReplicationResponse)(nil),          // 125: zfsilo.v1.UpdateReplicationResponse
+	(*DeleteReplicationRequest)(nil),           // 126: zfsilo.v1.DeleteReplicationRequest
+	(*DeleteReplicationResponse)(nil),          // 127: zfsilo.v1.DeleteReplicationResponse
+	(*SyncReplicationRequest)(nil),             // 128: zfsilo.v1.SyncReplicationRequest
+	(*SyncReplicationResponse)(nil),            // 129: zfsilo.v1.SyncReplicationResponse
+	(*Host_Connection)(nil),                    // 130: zfsilo.v1.Host.Connection
+	(*Host_Role)(nil),                          // 131: zfsilo.v1.Host.Role
+	(*Host_Status)(nil),                        // 132: zfsilo.v1.Host.Status
+	(*Host_Connection_Local)(nil),              // 133: zfsilo.v1.Host.Connection.Local
+	(*Host_Connection_Remote)(nil),             // 134: zfsilo.v1.Host.Connection.Remote
+	(*Host_Role_Server)(nil),                   // 135: zfsilo.v1.Host.Role.Server
+	(*Host_Role_Client)(nil),                   // 136: zfsilo.v1.Host.Role.Client
+	(*Host_Role_Server_NvmeofPort)(nil),        // 137: zfsilo.v1.Host.Role.Server.NvmeofPort
+	nil,                                        // 138: zfsilo.v1.Host.Role.Server.ScrubSchedulesEntry
+	(*RotateHostKeyResponse_VolumeResult)(nil), // 139: zfsilo.v1.RotateHostKeyResponse.VolumeResult
+	(*PoolStatus_Scrub)(nil),                   // 140: zfsilo.v1.PoolStatus.Scrub
+	(*Volume_Option)(nil),                      // 141: zfsilo.v1.Volume.Option
+	(*Volume_Attachment)(nil),                  // 142: zfsilo.v1.Volume.Attachment
+	(*StatsVolumeResponse_Stats)(nil),          // 143: zfsilo.v1.StatsVolumeResponse.Stats
+	(*StatsVolumeResponse_Stats_Usage)(nil),    // 144: zfsilo.v1.StatsVolumeResponse.Stats.Usage
+	(*Replication_Status)(nil),                 // 145: zfsilo.v1.Replication.Status
+	(*timestamppb.Timestamp)(nil),              // 146: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                    // 147: google.protobuf.Struct
 }
 var file_zfsilo_v1_zfsilo_proto_depIdxs = []int32{
-	59, // 0: zfsilo.v1.Host.create_time:type_name -> google.protobuf.Timestamp
-	59, // 1: zfsilo.v1.Host.update_time:type_name -> google.protobuf.Timestamp
-	50, // 2: zfsilo.v1.Host.connection:type_name -> zfsilo.v1.Host.Connection
-	51, // 3: zfsilo.v1.Host.role:type_name -> zfsilo.v1.Host.Role
-	6,  // 4: zfsilo.v1.GetHostResponse.host:type_name -> zfsilo.v1.Host
-	6,  // 5: zfsilo.v1.ListHostsResponse.hosts:type_name -> zfsilo.v1.Host
-	6,  // 6: zfsilo.v1.CreateHostRequest.host:type_name -> zfsilo.v1.Host
-	6,  // 7: zfsilo.v1.CreateHostResponse.host:type_name -> zfsilo.v1.Host
-	60, // 8: zfsilo.v1.UpdateHostRequest.host:type_name -> google.protobuf.Struct
-	6,  // 9: zfsilo.v1.UpdateHostResponse.host:type_name -> zfsilo.v1.Host
-	60, // 10: zfsilo.v1.Volume.struct:type_name -> google.protobuf.Struct
-	59, // 11: zfsilo.v1.Volume.create_time:type_name -> google.protobuf.Timestamp
-	59, // 12: zfsilo.v1.Volume.update_time:type_name -> google.protobuf.Timestamp
-	56, // 13: zfsilo.v1.Volume.options:type_name -> zfsilo.v1.Volume.Option
-	0,  // 14: zfsilo.v1.Volume.mode:type_name -> zfsilo.v1.Volume.Mode
-	1,  // 15: zfsilo.v1.Volume.status:type_name -> zfsilo.v1.Volume.Status
-	2,  // 16: zfsilo.v1.Volume.transport:type_name -> zfsilo.v1.Volume.Transport
-	17, // 17: zfsilo.v1.GetVolumeResponse.volume:type_name -> zfsilo.v1.Volume
-	17, // 18: zfsilo.v1.ListVolumesResponse.volumes:type_name -> zfsilo.v1.Volume
-	17, // 19: zfsilo.v1.CreateVolumeRequest.volume:type_name -> zfsilo.v1.Volume
-	17, // 20: zfsilo.v1.CreateVolumeResponse.volume:type_name -> zfsilo.v1.Volume
-	60, // 21: zfsilo.v1.UpdateVolumeRequest.volume:type_name -> google.protobuf.Struct
-	17, // 22: zfsilo.v1.UpdateVolumeResponse.volume:type_name -> zfsilo.v1.Volume
-	2,  // 23: zfsilo.v1.PublishVolumeRequest.transport:type_name -> zfsilo.v1.Volume.Transport
-	17, // 24: zfsilo.v1.PublishVolumeResponse.volume:type_name -> zfsilo.v1.Volume
-	17, // 25: zfsilo.v1.UnpublishVolumeResponse.volume:type_name -> zfsilo.v1.Volume
-	17, // 26: zfsilo.v1.ConnectVolumeResponse.volume:type_name -> zfsilo.v1.Volume
-	17, // 27: zfsilo.v1.DisconnectVolumeResponse.volume:type_name -> zfsilo.v1.Volume
-	17, // 28: zfsilo.v1.StageVolumeResponse.volume:type_name -> zfsilo.v1.Volume
-	17, // 29: zfsilo.v1.UnstageVolumeResponse.volume:type_name -> zfsilo.v1.Volume
-	17, // 30: zfsilo.v1.MountVolumeResponse.volume:type_name -> zfsilo.v1.Volume
-	17, // 31: zfsilo.v1.UnmountVolumeResponse.volume:type_name -> zfsilo.v1.Volume
-	57, // 32: zfsilo.v1.StatsVolumeResponse.stats:type_name -> zfsilo.v1.StatsVolumeResponse.Stats
-	52, // 33: zfsilo.v1.Host.Connection.local:type_name -> zfsilo.v1.Host.Connection.Local
-	53, // 34: zfsilo.v1.Host.Connection.remote:type_name -> zfsilo.v1.Host.Connection.Remote
-	54, // 35: zfsilo.v1.Host.Role.server:type_name -> zfsilo.v1.Host.Role.Server
-	55, // 36: zfsilo.v1.Host.Role.client:type_name -> zfsilo.v1.Host.Role.Client
-	58, // 37: zfsilo.v1.StatsVolumeResponse.Stats.usage:type_name -> zfsilo.v1.StatsVolumeResponse.Stats.Usage
-	3,  // 38: zfsilo.v1.StatsVolumeResponse.Stats.Usage.unit:type_name -> zfsilo.v1.StatsVolumeResponse.Stats.Usage.Unit
-	4,  // 39: zfsilo.v1.Service.GetCapacity:input_type -> zfsilo.v1.GetCapacityRequest
-	7,  // 40: zfsilo.v1.HostService.GetHost:input_type -> zfsilo.v1.GetHostRequest
-	9,  // 41: zfsilo.v1.HostService.ListHosts:input_type -> zfsilo.v1.ListHostsRequest
-	11, // 42: zfsilo.v1.HostService.CreateHost:input_type -> zfsilo.v1.CreateHostRequest
-	13, // 43: zfsilo.v1.HostService.UpdateHost:input_type -> zfsilo.v1.UpdateHostRequest
-	15, // 44: zfsilo.v1.HostService.DeleteHost:input_type -> zfsilo.v1.DeleteHostRequest
-	18, // 45: zfsilo.v1.VolumeService.GetVolume:input_type -> zfsilo.v1.GetVolumeRequest
-	20, // 46: zfsilo.v1.VolumeService.ListVolumes:input_type -> zfsilo.v1.ListVolumesRequest
-	22, // 47: zfsilo.v1.VolumeService.CreateVolume:input_type -> zfsilo.v1.CreateVolumeRequest
-	24, // 48: zfsilo.v1.VolumeService.UpdateVolume:input_type -> zfsilo.v1.UpdateVolumeRequest
-	26, // 49: zfsilo.v1.VolumeService.DeleteVolume:input_type -> zfsilo.v1.DeleteVolumeRequest
-	28, // 50: zfsilo.v1.VolumeService.PublishVolume:input_type -> zfsilo.v1.PublishVolumeRequest
-	30, // 51: zfsilo.v1.VolumeService.UnpublishVolume:input_type -> zfsilo.v1.UnpublishVolumeRequest
-	32, // 52: zfsilo.v1.VolumeService.ConnectVolume:input_type -> zfsilo.v1.ConnectVolumeRequest
-	34, // 53: zfsilo.v1.VolumeService.DisconnectVolume:input_type -> zfsilo.v1.DisconnectVolumeRequest
-	36, // 54: zfsilo.v1.VolumeService.StageVolume:input_type -> zfsilo.v1.StageVolumeRequest
-	38, // 55: zfsilo.v1.VolumeService.UnstageVolume:input_type -> zfsilo.v1.UnstageVolumeRequest
-	40, // 56: zfsilo.v1.VolumeService.MountVolume:input_type -> zfsilo.v1.MountVolumeRequest
-	42, // 57: zfsilo.v1.VolumeService.UnmountVolume:input_type -> zfsilo.v1.UnmountVolumeRequest
-	44, // 58: zfsilo.v1.VolumeService.StatsVolume:input_type -> zfsilo.v1.StatsVolumeRequest
-	46, // 59: zfsilo.v1.VolumeService.SyncVolume:input_type -> zfsilo.v1.SyncVolumeRequest
-	48, // 60: zfsilo.v1.VolumeService.SyncVolumes:input_type -> zfsilo.v1.SyncVolumesRequest
-	5,  // 61: zfsilo.v1.Service.GetCapacity:output_type -> zfsilo.v1.GetCapacityResponse
-	8,  // 62: zfsilo.v1.HostService.GetHost:output_type -> zfsilo.v1.GetHostResponse
-	10, // 63: zfsilo.v1.HostService.ListHosts:output_type -> zfsilo.v1.ListHostsResponse
-	12, // 64: zfsilo.v1.HostService.CreateHost:output_type -> zfsilo.v1.CreateHostResponse
-	14, // 65: zfsilo.v1.HostService.UpdateHost:output_type -> zfsilo.v1.UpdateHostResponse
-	16, // 66: zfsilo.v1.HostService.DeleteHost:output_type -> zfsilo.v1.DeleteHostResponse
-	19, // 67: zfsilo.v1.VolumeService.GetVolume:output_type -> zfsilo.v1.GetVolumeResponse
-	21, // 68: zfsilo.v1.VolumeService.ListVolumes:output_type -> zfsilo.v1.ListVolumesResponse
-	23, // 69: zfsilo.v1.VolumeService.CreateVolume:output_type -> zfsilo.v1.CreateVolumeResponse
-	25, // 70: zfsilo.v1.VolumeService.UpdateVolume:output_type -> zfsilo.v1.UpdateVolumeResponse
-	27, // 71: zfsilo.v1.VolumeService.DeleteVolume:output_type -> zfsilo.v1.DeleteVolumeResponse
-	29, // 72: zfsilo.v1.VolumeService.PublishVolume:output_type -> zfsilo.v1.PublishVolumeResponse
-	31, // 73: zfsilo.v1.VolumeService.UnpublishVolume:output_type -> zfsilo.v1.UnpublishVolumeResponse
-	33, // 74: zfsilo.v1.VolumeService.ConnectVolume:output_type -> zfsilo.v1.ConnectVolumeResponse
-	35, // 75: zfsilo.v1.VolumeService.DisconnectVolume:output_type -> zfsilo.v1.DisconnectVolumeResponse
-	37, // 76: zfsilo.v1.VolumeService.StageVolume:output_type -> zfsilo.v1.StageVolumeResponse
-	39, // 77: zfsilo.v1.VolumeService.UnstageVolume:output_type -> zfsilo.v1.UnstageVolumeResponse
-	41, // 78: zfsilo.v1.VolumeService.MountVolume:output_type -> zfsilo.v1.MountVolumeResponse
-	43, // 79: zfsilo.v1.VolumeService.UnmountVolume:output_type -> zfsilo.v1.UnmountVolumeResponse
-	45, // 80: zfsilo.v1.VolumeService.StatsVolume:output_type -> zfsilo.v1.StatsVolumeResponse
-	47, // 81: zfsilo.v1.VolumeService.SyncVolume:output_type -> zfsilo.v1.SyncVolumeResponse
-	49, // 82: zfsilo.v1.VolumeService.SyncVolumes:output_type -> zfsilo.v1.SyncVolumesResponse
-	61, // [61:83] is the sub-list for method output_type
-	39, // [39:61] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	146, // 0: zfsilo.v1.Host.create_time:type_name -> google.protobuf.Timestamp
+	146, // 1: zfsilo.v1.Host.update_time:type_name -> google.protobuf.Timestamp
+	130, // 2: zfsilo.v1.Host.connection:type_name -> zfsilo.v1.Host.Connection
+	131, // 3: zfsilo.v1.Host.role:type_name -> zfsilo.v1.Host.Role
+	132, // 4: zfsilo.v1.Host.status:type_name -> zfsilo.v1.Host.Status
+	11,  // 5: zfsilo.v1.GetHostResponse.host:type_name -> zfsilo.v1.Host
+	11,  // 6: zfsilo.v1.ListHostsResponse.hosts:type_name -> zfsilo.v1.Host
+	11,  // 7: zfsilo.v1.CreateHostRequest.host:type_name -> zfsilo.v1.Host
+	11,  // 8: zfsilo.v1.CreateHostResponse.host:type_name -> zfsilo.v1.Host
+	147, // 9: zfsilo.v1.UpdateHostRequest.host:type_name -> google.protobuf.Struct
+	11,  // 10: zfsilo.v1.UpdateHostResponse.host:type_name -> zfsilo.v1.Host
+	11,  // 11: zfsilo.v1.RotateHostKeyResponse.host:type_name -> zfsilo.v1.Host
+	139, // 12: zfsilo.v1.RotateHostKeyResponse.results:type_name -> zfsilo.v1.RotateHostKeyResponse.VolumeResult
+	0,   // 13: zfsilo.v1.Pool.health:type_name -> zfsilo.v1.Pool.Health
+	146, // 14: zfsilo.v1.PoolStatus.poll_time:type_name -> google.protobuf.Timestamp
+	0,   // 15: zfsilo.v1.PoolStatus.health:type_name -> zfsilo.v1.Pool.Health
+	0,   // 16: zfsilo.v1.PoolStatus.previous_health:type_name -> zfsilo.v1.Pool.Health
+	146, // 17: zfsilo.v1.PoolStatus.health_change_time:type_name -> google.protobuf.Timestamp
+	140, // 18: zfsilo.v1.PoolStatus.last_scrub:type_name -> zfsilo.v1.PoolStatus.Scrub
+	146, // 19: zfsilo.v1.PoolStatus.next_scrub_time:type_name -> google.protobuf.Timestamp
+	24,  // 20: zfsilo.v1.GetPoolResponse.pool:type_name -> zfsilo.v1.Pool
+	25,  // 21: zfsilo.v1.GetPoolStatusResponse.pool_status:type_name -> zfsilo.v1.PoolStatus
+	24,  // 22: zfsilo.v1.ListPoolsResponse.pools:type_name -> zfsilo.v1.Pool
+	147, // 23: zfsilo.v1.Volume.struct:type_name -> google.protobuf.Struct
+	146, // 24: zfsilo.v1.Volume.create_time:type_name -> google.protobuf.Timestamp
+	146, // 25: zfsilo.v1.Volume.update_time:type_name -> google.protobuf.Timestamp
+	141, // 26: zfsilo.v1.Volume.options:type_name -> zfsilo.v1.Volume.Option
+	2,   // 27: zfsilo.v1.Volume.mode:type_name -> zfsilo.v1.Volume.Mode
+	3,   // 28: zfsilo.v1.Volume.status:type_name -> zfsilo.v1.Volume.Status
+	4,   // 29: zfsilo.v1.Volume.transport:type_name -> zfsilo.v1.Volume.Transport
+	5,   // 30: zfsilo.v1.Volume.fs_type:type_name -> zfsilo.v1.Volume.FSType
+	142, // 31: zfsilo.v1.Volume.attachments:type_name -> zfsilo.v1.Volume.Attachment
+	32,  // 32: zfsilo.v1.GetVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	32,  // 33: zfsilo.v1.ListVolumesResponse.volumes:type_name -> zfsilo.v1.Volume
+	32,  // 34: zfsilo.v1.CreateVolumeRequest.volume:type_name -> zfsilo.v1.Volume
+	32,  // 35: zfsilo.v1.CreateVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	147, // 36: zfsilo.v1.UpdateVolumeRequest.volume:type_name -> google.protobuf.Struct
+	32,  // 37: zfsilo.v1.UpdateVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	4,   // 38: zfsilo.v1.PublishVolumeRequest.transport:type_name -> zfsilo.v1.Volume.Transport
+	32,  // 39: zfsilo.v1.PublishVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	32,  // 40: zfsilo.v1.UnpublishVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	32,  // 41: zfsilo.v1.ConnectVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	32,  // 42: zfsilo.v1.DisconnectVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	32,  // 43: zfsilo.v1.StageVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	32,  // 44: zfsilo.v1.UnstageVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	32,  // 45: zfsilo.v1.MountVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	32,  // 46: zfsilo.v1.UnmountVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	143, // 47: zfsilo.v1.StatsVolumeResponse.stats:type_name -> zfsilo.v1.StatsVolumeResponse.Stats
+	147, // 48: zfsilo.v1.Snapshot.struct:type_name -> google.protobuf.Struct
+	146, // 49: zfsilo.v1.Snapshot.create_time:type_name -> google.protobuf.Timestamp
+	146, // 50: zfsilo.v1.Snapshot.update_time:type_name -> google.protobuf.Timestamp
+	65,  // 51: zfsilo.v1.GetSnapshotResponse.snapshot:type_name -> zfsilo.v1.Snapshot
+	65,  // 52: zfsilo.v1.ListSnapshotsResponse.snapshots:type_name -> zfsilo.v1.Snapshot
+	65,  // 53: zfsilo.v1.CreateSnapshotRequest.snapshot:type_name -> zfsilo.v1.Snapshot
+	65,  // 54: zfsilo.v1.CreateSnapshotResponse.snapshot:type_name -> zfsilo.v1.Snapshot
+	147, // 55: zfsilo.v1.SnapshotGroup.struct:type_name -> google.protobuf.Struct
+	146, // 56: zfsilo.v1.SnapshotGroup.create_time:type_name -> google.protobuf.Timestamp
+	146, // 57: zfsilo.v1.SnapshotGroup.update_time:type_name -> google.protobuf.Timestamp
+	74,  // 58: zfsilo.v1.GetSnapshotGroupResponse.snapshot_group:type_name -> zfsilo.v1.SnapshotGroup
+	65,  // 59: zfsilo.v1.GetSnapshotGroupResponse.snapshots:type_name -> zfsilo.v1.Snapshot
+	74,  // 60: zfsilo.v1.CreateSnapshotGroupRequest.snapshot_group:type_name -> zfsilo.v1.SnapshotGroup
+	74,  // 61: zfsilo.v1.CreateSnapshotGroupResponse.snapshot_group:type_name -> zfsilo.v1.SnapshotGroup
+	65,  // 62: zfsilo.v1.CreateSnapshotGroupResponse.snapshots:type_name -> zfsilo.v1.Snapshot
+	32,  // 63: zfsilo.v1.RollbackVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	32,  // 64: zfsilo.v1.MigrateVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	32,  // 65: zfsilo.v1.FailoverVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	146, // 66: zfsilo.v1.VolumeExport.create_time:type_name -> google.protobuf.Timestamp
+	87,  // 67: zfsilo.v1.ExportVolumeResponse.export:type_name -> zfsilo.v1.VolumeExport
+	32,  // 68: zfsilo.v1.ImportVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	87,  // 69: zfsilo.v1.ImportVolumeResponse.exports:type_name -> zfsilo.v1.VolumeExport
+	87,  // 70: zfsilo.v1.ListVolumeExportsResponse.exports:type_name -> zfsilo.v1.VolumeExport
+	32,  // 71: zfsilo.v1.RotateVolumeKeyResponse.volume:type_name -> zfsilo.v1.Volume
+	32,  // 72: zfsilo.v1.ExpandVolumeResponse.volume:type_name -> zfsilo.v1.Volume
+	32,  // 73: zfsilo.v1.RotateVolumeCredentialsResponse.volume:type_name -> zfsilo.v1.Volume
+	7,   // 74: zfsilo.v1.VolumeProperty.source:type_name -> zfsilo.v1.VolumeProperty.Source
+	100, // 75: zfsilo.v1.GetVolumePropertiesResponse.properties:type_name -> zfsilo.v1.VolumeProperty
+	147, // 76: zfsilo.v1.SnapshotPolicy.struct:type_name -> google.protobuf.Struct
+	146, // 77: zfsilo.v1.SnapshotPolicy.create_time:type_name -> google.protobuf.Timestamp
+	146, // 78: zfsilo.v1.SnapshotPolicy.update_time:type_name -> google.protobuf.Timestamp
+	146, // 79: zfsilo.v1.SnapshotPolicyRun.run_time:type_name -> google.protobuf.Timestamp
+	8,   // 80: zfsilo.v1.SnapshotPolicyRun.outcome:type_name -> zfsilo.v1.SnapshotPolicyRun.Outcome
+	103, // 81: zfsilo.v1.GetSnapshotPolicyResponse.snapshot_policy:type_name -> zfsilo.v1.SnapshotPolicy
+	103, // 82: zfsilo.v1.ListSnapshotPoliciesResponse.snapshot_policies:type_name -> zfsilo.v1.SnapshotPolicy
+	103, // 83: zfsilo.v1.CreateSnapshotPolicyRequest.snapshot_policy:type_name -> zfsilo.v1.SnapshotPolicy
+	103, // 84: zfsilo.v1.CreateSnapshotPolicyResponse.snapshot_policy:type_name -> zfsilo.v1.SnapshotPolicy
+	147, // 85: zfsilo.v1.UpdateSnapshotPolicyRequest.snapshot_policy:type_name -> google.protobuf.Struct
+	103, // 86: zfsilo.v1.UpdateSnapshotPolicyResponse.snapshot_policy:type_name -> zfsilo.v1.SnapshotPolicy
+	104, // 87: zfsilo.v1.ListSnapshotPolicyRunsResponse.snapshot_policy_runs:type_name -> zfsilo.v1.SnapshotPolicyRun
+	147, // 88: zfsilo.v1.Replication.struct:type_name -> google.protobuf.Struct
+	146, // 89: zfsilo.v1.Replication.create_time:type_name -> google.protobuf.Timestamp
+	146, // 90: zfsilo.v1.Replication.update_time:type_name -> google.protobuf.Timestamp
+	145, // 91: zfsilo.v1.Replication.status:type_name -> zfsilo.v1.Replication.Status
+	117, // 92: zfsilo.v1.GetReplicationResponse.replication:type_name -> zfsilo.v1.Replication
+	117, // 93: zfsilo.v1.ListReplicationsResponse.replications:type_name -> zfsilo.v1.Replication
+	117, // 94: zfsilo.v1.CreateReplicationRequest.replication:type_name -> zfsilo.v1.Replication
+	117, // 95: zfsilo.v1.CreateReplicationResponse.replication:type_name -> zfsilo.v1.Replication
+	147, // 96: zfsilo.v1.UpdateReplicationRequest.replication:type_name -> google.protobuf.Struct
+	117, // 97: zfsilo.v1.UpdateReplicationResponse.replication:type_name -> zfsilo.v1.Replication
+	117, // 98: zfsilo.v1.SyncReplicationResponse.replication:type_name -> zfsilo.v1.Replication
+	133, // 99: zfsilo.v1.Host.Connection.local:type_name -> zfsilo.v1.Host.Connection.Local
+	134, // 100: zfsilo.v1.Host.Connection.remote:type_name -> zfsilo.v1.Host.Connection.Remote
+	135, // 101: zfsilo.v1.Host.Role.server:type_name -> zfsilo.v1.Host.Role.Server
+	136, // 102: zfsilo.v1.Host.Role.client:type_name -> zfsilo.v1.Host.Role.Client
+	146, // 103: zfsilo.v1.Host.Status.last_poll_time:type_name -> google.protobuf.Timestamp
+	25,  // 104: zfsilo.v1.Host.Status.pools:type_name -> zfsilo.v1.PoolStatus
+	138, // 105: zfsilo.v1.Host.Role.Server.scrub_schedules:type_name -> zfsilo.v1.Host.Role.Server.ScrubSchedulesEntry
+	137, // 106: zfsilo.v1.Host.Role.Server.nvmeof_ports:type_name -> zfsilo.v1.Host.Role.Server.NvmeofPort
+	1,   // 107: zfsilo.v1.PoolStatus.Scrub.state:type_name -> zfsilo.v1.PoolStatus.Scrub.State
+	146, // 108: zfsilo.v1.PoolStatus.Scrub.start_time:type_name -> google.protobuf.Timestamp
+	146, // 109: zfsilo.v1.PoolStatus.Scrub.end_time:type_name -> google.protobuf.Timestamp
+	144, // 110: zfsilo.v1.StatsVolumeResponse.Stats.usage:type_name -> zfsilo.v1.StatsVolumeResponse.Stats.Usage
+	6,   // 111: zfsilo.v1.StatsVolumeResponse.Stats.Usage.unit:type_name -> zfsilo.v1.StatsVolumeResponse.Stats.Usage.Unit
+	146, // 112: zfsilo.v1.Replication.Status.last_sync_time:type_name -> google.protobuf.Timestamp
+	146, // 113: zfsilo.v1.Replication.Status.last_attempt_time:type_name -> google.protobuf.Timestamp
+	146, // 114: zfsilo.v1.Replication.Status.last_snapshot_time:type_name -> google.protobuf.Timestamp
+	9,   // 115: zfsilo.v1.Service.GetCapacity:input_type -> zfsilo.v1.GetCapacityRequest
+	12,  // 116: zfsilo.v1.HostService.GetHost:input_type -> zfsilo.v1.GetHostRequest
+	14,  // 117: zfsilo.v1.HostService.ListHosts:input_type -> zfsilo.v1.ListHostsRequest
+	16,  // 118: zfsilo.v1.HostService.CreateHost:input_type -> zfsilo.v1.CreateHostRequest
+	18,  // 119: zfsilo.v1.HostService.UpdateHost:input_type -> zfsilo.v1.UpdateHostRequest
+	20,  // 120: zfsilo.v1.HostService.DeleteHost:input_type -> zfsilo.v1.DeleteHostRequest
+	22,  // 121: zfsilo.v1.HostService.RotateHostKey:input_type -> zfsilo.v1.RotateHostKeyRequest
+	26,  // 122: zfsilo.v1.PoolService.GetPool:input_type -> zfsilo.v1.GetPoolRequest
+	30,  // 123: zfsilo.v1.PoolService.ListPools:input_type -> zfsilo.v1.ListPoolsRequest
+	28,  // 124: zfsilo.v1.PoolService.GetPoolStatus:input_type -> zfsilo.v1.GetPoolStatusRequest
+	33,  // 125: zfsilo.v1.VolumeService.GetVolume:input_type -> zfsilo.v1.GetVolumeRequest
+	35,  // 126: zfsilo.v1.VolumeService.ListVolumes:input_type -> zfsilo.v1.ListVolumesRequest
+	37,  // 127: zfsilo.v1.VolumeService.CreateVolume:input_type -> zfsilo.v1.CreateVolumeRequest
+	39,  // 128: zfsilo.v1.VolumeService.UpdateVolume:input_type -> zfsilo.v1.UpdateVolumeRequest
+	41,  // 129: zfsilo.v1.VolumeService.DeleteVolume:input_type -> zfsilo.v1.DeleteVolumeRequest
+	43,  // 130: zfsilo.v1.VolumeService.PublishVolume:input_type -> zfsilo.v1.PublishVolumeRequest
+	45,  // 131: zfsilo.v1.VolumeService.UnpublishVolume:input_type -> zfsilo.v1.UnpublishVolumeRequest
+	47,  // 132: zfsilo.v1.VolumeService.ConnectVolume:input_type -> zfsilo.v1.ConnectVolumeRequest
+	49,  // 133: zfsilo.v1.VolumeService.DisconnectVolume:input_type -> zfsilo.v1.DisconnectVolumeRequest
+	51,  // 134: zfsilo.v1.VolumeService.StageVolume:input_type -> zfsilo.v1.StageVolumeRequest
+	53,  // 135: zfsilo.v1.VolumeService.UnstageVolume:input_type -> zfsilo.v1.UnstageVolumeRequest
+	55,  // 136: zfsilo.v1.VolumeService.MountVolume:input_type -> zfsilo.v1.MountVolumeRequest
+	57,  // 137: zfsilo.v1.VolumeService.UnmountVolume:input_type -> zfsilo.v1.UnmountVolumeRequest
+	59,  // 138: zfsilo.v1.VolumeService.StatsVolume:input_type -> zfsilo.v1.StatsVolumeRequest
+	61,  // 139: zfsilo.v1.VolumeService.SyncVolume:input_type -> zfsilo.v1.SyncVolumeRequest
+	63,  // 140: zfsilo.v1.VolumeService.SyncVolumes:input_type -> zfsilo.v1.SyncVolumesRequest
+	66,  // 141: zfsilo.v1.VolumeService.GetSnapshot:input_type -> zfsilo.v1.GetSnapshotRequest
+	68,  // 142: zfsilo.v1.VolumeService.ListSnapshots:input_type -> zfsilo.v1.ListSnapshotsRequest
+	70,  // 143: zfsilo.v1.VolumeService.CreateSnapshot:input_type -> zfsilo.v1.CreateSnapshotRequest
+	72,  // 144: zfsilo.v1.VolumeService.DeleteSnapshot:input_type -> zfsilo.v1.DeleteSnapshotRequest
+	75,  // 145: zfsilo.v1.VolumeService.GetSnapshotGroup:input_type -> zfsilo.v1.GetSnapshotGroupRequest
+	77,  // 146: zfsilo.v1.VolumeService.CreateSnapshotGroup:input_type -> zfsilo.v1.CreateSnapshotGroupRequest
+	79,  // 147: zfsilo.v1.VolumeService.DeleteSnapshotGroup:input_type -> zfsilo.v1.DeleteSnapshotGroupRequest
+	81,  // 148: zfsilo.v1.VolumeService.RollbackVolume:input_type -> zfsilo.v1.RollbackVolumeRequest
+	83,  // 149: zfsilo.v1.VolumeService.MigrateVolume:input_type -> zfsilo.v1.MigrateVolumeRequest
+	85,  // 150: zfsilo.v1.VolumeService.FailoverVolume:input_type -> zfsilo.v1.FailoverVolumeRequest
+	88,  // 151: zfsilo.v1.VolumeService.ExportVolume:input_type -> zfsilo.v1.ExportVolumeRequest
+	90,  // 152: zfsilo.v1.VolumeService.ImportVolume:input_type -> zfsilo.v1.ImportVolumeRequest
+	92,  // 153: zfsilo.v1.VolumeService.ListVolumeExports:input_type -> zfsilo.v1.ListVolumeExportsRequest
+	94,  // 154: zfsilo.v1.VolumeService.RotateVolumeKey:input_type -> zfsilo.v1.RotateVolumeKeyRequest
+	101, // 155: zfsilo.v1.VolumeService.GetVolumeProperties:input_type -> zfsilo.v1.GetVolumePropertiesRequest
+	96,  // 156: zfsilo.v1.VolumeService.ExpandVolume:input_type -> zfsilo.v1.ExpandVolumeRequest
+	98,  // 157: zfsilo.v1.VolumeService.RotateVolumeCredentials:input_type -> zfsilo.v1.RotateVolumeCredentialsRequest
+	105, // 158: zfsilo.v1.SnapshotPolicyService.GetSnapshotPolicy:input_type -> zfsilo.v1.GetSnapshotPolicyRequest
+	107, // 159: zfsilo.v1.SnapshotPolicyService.ListSnapshotPolicies:input_type -> zfsilo.v1.ListSnapshotPoliciesRequest
+	109, // 160: zfsilo.v1.SnapshotPolicyService.CreateSnapshotPolicy:input_type -> zfsilo.v1.CreateSnapshotPolicyRequest
+	111, // 161: zfsilo.v1.SnapshotPolicyService.UpdateSnapshotPolicy:input_type -> zfsilo.v1.UpdateSnapshotPolicyRequest
+	113, // 162: zfsilo.v1.SnapshotPolicyService.DeleteSnapshotPolicy:input_type -> zfsilo.v1.DeleteSnapshotPolicyRequest
+	115, // 163: zfsilo.v1.SnapshotPolicyService.ListSnapshotPolicyRuns:input_type -> zfsilo.v1.ListSnapshotPolicyRunsRequest
+	118, // 164: zfsilo.v1.ReplicationService.GetReplication:input_type -> zfsilo.v1.GetReplicationRequest
+	120, // 165: zfsilo.v1.ReplicationService.ListReplications:input_type -> zfsilo.v1.ListReplicationsRequest
+	122, // 166: zfsilo.v1.ReplicationService.CreateReplication:input_type -> zfsilo.v1.CreateReplicationRequest
+	124, // 167: zfsilo.v1.ReplicationService.UpdateReplication:input_type -> zfsilo.v1.UpdateReplicationRequest
+	126, // 168: zfsilo.v1.ReplicationService.DeleteReplication:input_type -> zfsilo.v1.DeleteReplicationRequest
+	128, // 169: zfsilo.v1.ReplicationService.SyncReplication:input_type -> zfsilo.v1.SyncReplicationRequest
+	10,  // 170: zfsilo.v1.Service.GetCapacity:output_type -> zfsilo.v1.GetCapacityResponse
+	13,  // 171: zfsilo.v1.HostService.GetHost:output_type -> zfsilo.v1.GetHostResponse
+	15,  // 172: zfsilo.v1.HostService.ListHosts:output_type -> zfsilo.v1.ListHostsResponse
+	17,  // 173: zfsilo.v1.HostService.CreateHost:output_type -> zfsilo.v1.CreateHostResponse
+	19,  // 174: zfsilo.v1.HostService.UpdateHost:output_type -> zfsilo.v1.UpdateHostResponse
+	21,  // 175: zfsilo.v1.HostService.DeleteHost:output_type -> zfsilo.v1.DeleteHostResponse
+	23,  // 176: zfsilo.v1.HostService.RotateHostKey:output_type -> zfsilo.v1.RotateHostKeyResponse
+	27,  // 177: zfsilo.v1.PoolService.GetPool:output_type -> zfsilo.v1.GetPoolResponse
+	31,  // 178: zfsilo.v1.PoolService.ListPools:output_type -> zfsilo.v1.ListPoolsResponse
+	29,  // 179: zfsilo.v1.PoolService.GetPoolStatus:output_type -> zfsilo.v1.GetPoolStatusResponse
+	34,  // 180: zfsilo.v1.VolumeService.GetVolume:output_type -> zfsilo.v1.GetVolumeResponse
+	36,  // 181: zfsilo.v1.VolumeService.ListVolumes:output_type -> zfsilo.v1.ListVolumesResponse
+	38,  // 182: zfsilo.v1.VolumeService.CreateVolume:output_type -> zfsilo.v1.CreateVolumeResponse
+	40,  // 183: zfsilo.v1.VolumeService.UpdateVolume:output_type -> zfsilo.v1.UpdateVolumeResponse
+	42,  // 184: zfsilo.v1.VolumeService.DeleteVolume:output_type -> zfsilo.v1.DeleteVolumeResponse
+	44,  // 185: zfsilo.v1.VolumeService.PublishVolume:output_type -> zfsilo.v1.PublishVolumeResponse
+	46,  // 186: zfsilo.v1.VolumeService.UnpublishVolume:output_type -> zfsilo.v1.UnpublishVolumeResponse
+	48,  // 187: zfsilo.v1.VolumeService.ConnectVolume:output_type -> zfsilo.v1.ConnectVolumeResponse
+	50,  // 188: zfsilo.v1.VolumeService.DisconnectVolume:output_type -> zfsilo.v1.DisconnectVolumeResponse
+	52,  // 189: zfsilo.v1.VolumeService.StageVolume:output_type -> zfsilo.v1.StageVolumeResponse
+	54,  // 190: zfsilo.v1.VolumeService.UnstageVolume:output_type -> zfsilo.v1.UnstageVolumeResponse
+	56,  // 191: zfsilo.v1.VolumeService.MountVolume:output_type -> zfsilo.v1.MountVolumeResponse
+	58,  // 192: zfsilo.v1.VolumeService.UnmountVolume:output_type -> zfsilo.v1.UnmountVolumeResponse
+	60,  // 193: zfsilo.v1.VolumeService.StatsVolume:output_type -> zfsilo.v1.StatsVolumeResponse
+	62,  // 194: zfsilo.v1.VolumeService.SyncVolume:output_type -> zfsilo.v1.SyncVolumeResponse
+	64,  // 195: zfsilo.v1.VolumeService.SyncVolumes:output_type -> zfsilo.v1.SyncVolumesResponse
+	67,  // 196: zfsilo.v1.VolumeService.GetSnapshot:output_type -> zfsilo.v1.GetSnapshotResponse
+	69,  // 197: zfsilo.v1.VolumeService.ListSnapshots:output_type -> zfsilo.v1.ListSnapshotsResponse
+	71,  // 198: zfsilo.v1.VolumeService.CreateSnapshot:output_type -> zfsilo.v1.CreateSnapshotResponse
+	73,  // 199: zfsilo.v1.VolumeService.DeleteSnapshot:output_type -> zfsilo.v1.DeleteSnapshotResponse
+	76,  // 200: zfsilo.v1.VolumeService.GetSnapshotGroup:output_type -> zfsilo.v1.GetSnapshotGroupResponse
+	78,  // 201: zfsilo.v1.VolumeService.CreateSnapshotGroup:output_type -> zfsilo.v1.CreateSnapshotGroupResponse
+	80,  // 202: zfsilo.v1.VolumeService.DeleteSnapshotGroup:output_type -> zfsilo.v1.DeleteSnapshotGroupResponse
+	82,  // 203: zfsilo.v1.VolumeService.RollbackVolume:output_type -> zfsilo.v1.RollbackVolumeResponse
+	84,  // 204: zfsilo.v1.VolumeService.MigrateVolume:output_type -> zfsilo.v1.MigrateVolumeResponse
+	86,  // 205: zfsilo.v1.VolumeService.FailoverVolume:output_type -> zfsilo.v1.FailoverVolumeResponse
+	89,  // 206: zfsilo.v1.VolumeService.ExportVolume:output_type -> zfsilo.v1.ExportVolumeResponse
+	91,  // 207: zfsilo.v1.VolumeService.ImportVolume:output_type -> zfsilo.v1.ImportVolumeResponse
+	93,  // 208: zfsilo.v1.VolumeService.ListVolumeExports:output_type -> zfsilo.v1.ListVolumeExportsResponse
+	95,  // 209: zfsilo.v1.VolumeService.RotateVolumeKey:output_type -> zfsilo.v1.RotateVolumeKeyResponse
+	102, // 210: zfsilo.v1.VolumeService.GetVolumeProperties:output_type -> zfsilo.v1.GetVolumePropertiesResponse
+	97,  // 211: zfsilo.v1.VolumeService.ExpandVolume:output_type -> zfsilo.v1.ExpandVolumeResponse
+	99,  // 212: zfsilo.v1.VolumeService.RotateVolumeCredentials:output_type -> zfsilo.v1.RotateVolumeCredentialsResponse
+	106, // 213: zfsilo.v1.SnapshotPolicyService.GetSnapshotPolicy:output_type -> zfsilo.v1.GetSnapshotPolicyResponse
+	108, // 214: zfsilo.v1.SnapshotPolicyService.ListSnapshotPolicies:output_type -> zfsilo.v1.ListSnapshotPoliciesResponse
+	110, // 215: zfsilo.v1.SnapshotPolicyService.CreateSnapshotPolicy:output_type -> zfsilo.v1.CreateSnapshotPolicyResponse
+	112, // 216: zfsilo.v1.SnapshotPolicyService.UpdateSnapshotPolicy:output_type -> zfsilo.v1.UpdateSnapshotPolicyResponse
+	114, // 217: zfsilo.v1.SnapshotPolicyService.DeleteSnapshotPolicy:output_type -> zfsilo.v1.DeleteSnapshotPolicyResponse
+	116, // 218: zfsilo.v1.SnapshotPolicyService.ListSnapshotPolicyRuns:output_type -> zfsilo.v1.ListSnapshotPolicyRunsResponse
+	119, // 219: zfsilo.v1.ReplicationService.GetReplication:output_type -> zfsilo.v1.GetReplicationResponse
+	121, // 220: zfsilo.v1.ReplicationService.ListReplications:output_type -> zfsilo.v1.ListReplicationsResponse
+	123, // 221: zfsilo.v1.ReplicationService.CreateReplication:output_type -> zfsilo.v1.CreateReplicationResponse
+	125, // 222: zfsilo.v1.ReplicationService.UpdateReplication:output_type -> zfsilo.v1.UpdateReplicationResponse
+	127, // 223: zfsilo.v1.ReplicationService.DeleteReplication:output_type -> zfsilo.v1.DeleteReplicationResponse
+	129, // 224: zfsilo.v1.ReplicationService.SyncReplication:output_type -> zfsilo.v1.SyncReplicationResponse
+	170, // [170:225] is the sub-list for method output_type
+	115, // [115:170] is the sub-list for method input_type
+	115, // [115:115] is the sub-list for extension type_name
+	115, // [115:115] is the sub-list for extension extendee
+	0,   // [0:115] is the sub-list for field type_name
 }
 
 func init() { file_zfsilo_v1_zfsilo_proto_init() }
@@ -3448,12 +9237,14 @@ func file_zfsilo_v1_zfsilo_proto_init() {
 	if File_zfsilo_v1_zfsilo_proto != nil {
 		return
 	}
-	file_zfsilo_v1_zfsilo_proto_msgTypes[13].OneofWrappers = []any{}
-	file_zfsilo_v1_zfsilo_proto_msgTypes[46].OneofWrappers = []any{
+	file_zfsilo_v1_zfsilo_proto_msgTypes[23].OneofWrappers = []any{}
+	file_zfsilo_v1_zfsilo_proto_msgTypes[56].OneofWrappers = []any{}
+	file_zfsilo_v1_zfsilo_proto_msgTypes[65].OneofWrappers = []any{}
+	file_zfsilo_v1_zfsilo_proto_msgTypes[121].OneofWrappers = []any{
 		(*Host_Connection_Local_)(nil),
 		(*Host_Connection_Remote_)(nil),
 	}
-	file_zfsilo_v1_zfsilo_proto_msgTypes[47].OneofWrappers = []any{
+	file_zfsilo_v1_zfsilo_proto_msgTypes[122].OneofWrappers = []any{
 		(*Host_Role_Server_)(nil),
 		(*Host_Role_Client_)(nil),
 	}
@@ -3462,10 +9253,10 @@ func file_zfsilo_v1_zfsilo_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_zfsilo_v1_zfsilo_proto_rawDesc), len(file_zfsilo_v1_zfsilo_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   55,
+			NumEnums:      9,
+			NumMessages:   137,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   6,
 		},
 		GoTypes:           file_zfsilo_v1_zfsilo_proto_goTypes,
 		DependencyIndexes: file_zfsilo_v1_zfsilo_proto_depIdxs,
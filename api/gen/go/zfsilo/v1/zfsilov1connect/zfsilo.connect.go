@@ -25,8 +25,14 @@ const (
 	ServiceName = "zfsilo.v1.Service"
 	// HostServiceName is the fully-qualified name of the HostService service.
 	HostServiceName = "zfsilo.v1.HostService"
+	// PoolServiceName is the fully-qualified name of the PoolService service.
+	PoolServiceName = "zfsilo.v1.PoolService"
 	// VolumeServiceName is the fully-qualified name of the VolumeService service.
 	VolumeServiceName = "zfsilo.v1.VolumeService"
+	// SnapshotPolicyServiceName is the fully-qualified name of the SnapshotPolicyService service.
+	SnapshotPolicyServiceName = "zfsilo.v1.SnapshotPolicyService"
+	// ReplicationServiceName is the fully-qualified name of the ReplicationService service.
+	ReplicationServiceName = "zfsilo.v1.ReplicationService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -49,6 +55,16 @@ const (
 	HostServiceUpdateHostProcedure = "/zfsilo.v1.HostService/UpdateHost"
 	// HostServiceDeleteHostProcedure is the fully-qualified name of the HostService's DeleteHost RPC.
 	HostServiceDeleteHostProcedure = "/zfsilo.v1.HostService/DeleteHost"
+	// HostServiceRotateHostKeyProcedure is the fully-qualified name of the HostService's RotateHostKey
+	// RPC.
+	HostServiceRotateHostKeyProcedure = "/zfsilo.v1.HostService/RotateHostKey"
+	// PoolServiceGetPoolProcedure is the fully-qualified name of the PoolService's GetPool RPC.
+	PoolServiceGetPoolProcedure = "/zfsilo.v1.PoolService/GetPool"
+	// PoolServiceListPoolsProcedure is the fully-qualified name of the PoolService's ListPools RPC.
+	PoolServiceListPoolsProcedure = "/zfsilo.v1.PoolService/ListPools"
+	// PoolServiceGetPoolStatusProcedure is the fully-qualified name of the PoolService's GetPoolStatus
+	// RPC.
+	PoolServiceGetPoolStatusProcedure = "/zfsilo.v1.PoolService/GetPoolStatus"
 	// VolumeServiceGetVolumeProcedure is the fully-qualified name of the VolumeService's GetVolume RPC.
 	VolumeServiceGetVolumeProcedure = "/zfsilo.v1.VolumeService/GetVolume"
 	// VolumeServiceListVolumesProcedure is the fully-qualified name of the VolumeService's ListVolumes
@@ -96,6 +112,93 @@ const (
 	// VolumeServiceSyncVolumesProcedure is the fully-qualified name of the VolumeService's SyncVolumes
 	// RPC.
 	VolumeServiceSyncVolumesProcedure = "/zfsilo.v1.VolumeService/SyncVolumes"
+	// VolumeServiceGetSnapshotProcedure is the fully-qualified name of the VolumeService's GetSnapshot
+	// RPC.
+	VolumeServiceGetSnapshotProcedure = "/zfsilo.v1.VolumeService/GetSnapshot"
+	// VolumeServiceListSnapshotsProcedure is the fully-qualified name of the VolumeService's
+	// ListSnapshots RPC.
+	VolumeServiceListSnapshotsProcedure = "/zfsilo.v1.VolumeService/ListSnapshots"
+	// VolumeServiceCreateSnapshotProcedure is the fully-qualified name of the VolumeService's
+	// CreateSnapshot RPC.
+	VolumeServiceCreateSnapshotProcedure = "/zfsilo.v1.VolumeService/CreateSnapshot"
+	// VolumeServiceDeleteSnapshotProcedure is the fully-qualified name of the VolumeService's
+	// DeleteSnapshot RPC.
+	VolumeServiceDeleteSnapshotProcedure = "/zfsilo.v1.VolumeService/DeleteSnapshot"
+	// VolumeServiceGetSnapshotGroupProcedure is the fully-qualified name of the VolumeService's
+	// GetSnapshotGroup RPC.
+	VolumeServiceGetSnapshotGroupProcedure = "/zfsilo.v1.VolumeService/GetSnapshotGroup"
+	// VolumeServiceCreateSnapshotGroupProcedure is the fully-qualified name of the VolumeService's
+	// CreateSnapshotGroup RPC.
+	VolumeServiceCreateSnapshotGroupProcedure = "/zfsilo.v1.VolumeService/CreateSnapshotGroup"
+	// VolumeServiceDeleteSnapshotGroupProcedure is the fully-qualified name of the VolumeService's
+	// DeleteSnapshotGroup RPC.
+	VolumeServiceDeleteSnapshotGroupProcedure = "/zfsilo.v1.VolumeService/DeleteSnapshotGroup"
+	// VolumeServiceRollbackVolumeProcedure is the fully-qualified name of the VolumeService's
+	// RollbackVolume RPC.
+	VolumeServiceRollbackVolumeProcedure = "/zfsilo.v1.VolumeService/RollbackVolume"
+	// VolumeServiceMigrateVolumeProcedure is the fully-qualified name of the VolumeService's
+	// MigrateVolume RPC.
+	VolumeServiceMigrateVolumeProcedure = "/zfsilo.v1.VolumeService/MigrateVolume"
+	// VolumeServiceFailoverVolumeProcedure is the fully-qualified name of the VolumeService's
+	// FailoverVolume RPC.
+	VolumeServiceFailoverVolumeProcedure = "/zfsilo.v1.VolumeService/FailoverVolume"
+	// VolumeServiceExportVolumeProcedure is the fully-qualified name of the VolumeService's
+	// ExportVolume RPC.
+	VolumeServiceExportVolumeProcedure = "/zfsilo.v1.VolumeService/ExportVolume"
+	// VolumeServiceImportVolumeProcedure is the fully-qualified name of the VolumeService's
+	// ImportVolume RPC.
+	VolumeServiceImportVolumeProcedure = "/zfsilo.v1.VolumeService/ImportVolume"
+	// VolumeServiceListVolumeExportsProcedure is the fully-qualified name of the VolumeService's
+	// ListVolumeExports RPC.
+	VolumeServiceListVolumeExportsProcedure = "/zfsilo.v1.VolumeService/ListVolumeExports"
+	// VolumeServiceRotateVolumeKeyProcedure is the fully-qualified name of the VolumeService's
+	// RotateVolumeKey RPC.
+	VolumeServiceRotateVolumeKeyProcedure = "/zfsilo.v1.VolumeService/RotateVolumeKey"
+	// VolumeServiceGetVolumePropertiesProcedure is the fully-qualified name of the VolumeService's
+	// GetVolumeProperties RPC.
+	VolumeServiceGetVolumePropertiesProcedure = "/zfsilo.v1.VolumeService/GetVolumeProperties"
+	// VolumeServiceExpandVolumeProcedure is the fully-qualified name of the VolumeService's
+	// ExpandVolume RPC.
+	VolumeServiceExpandVolumeProcedure = "/zfsilo.v1.VolumeService/ExpandVolume"
+	// VolumeServiceRotateVolumeCredentialsProcedure is the fully-qualified name of the VolumeService's
+	// RotateVolumeCredentials RPC.
+	VolumeServiceRotateVolumeCredentialsProcedure = "/zfsilo.v1.VolumeService/RotateVolumeCredentials"
+	// SnapshotPolicyServiceGetSnapshotPolicyProcedure is the fully-qualified name of the
+	// SnapshotPolicyService's GetSnapshotPolicy RPC.
+	SnapshotPolicyServiceGetSnapshotPolicyProcedure = "/zfsilo.v1.SnapshotPolicyService/GetSnapshotPolicy"
+	// SnapshotPolicyServiceListSnapshotPoliciesProcedure is the fully-qualified name of the
+	// SnapshotPolicyService's ListSnapshotPolicies RPC.
+	SnapshotPolicyServiceListSnapshotPoliciesProcedure = "/zfsilo.v1.SnapshotPolicyService/ListSnapshotPolicies"
+	// SnapshotPolicyServiceCreateSnapshotPolicyProcedure is the fully-qualified name of the
+	// SnapshotPolicyService's CreateSnapshotPolicy RPC.
+	SnapshotPolicyServiceCreateSnapshotPolicyProcedure = "/zfsilo.v1.SnapshotPolicyService/CreateSnapshotPolicy"
+	// SnapshotPolicyServiceUpdateSnapshotPolicyProcedure is the fully-qualified name of the
+	// SnapshotPolicyService's UpdateSnapshotPolicy RPC.
+	SnapshotPolicyServiceUpdateSnapshotPolicyProcedure = "/zfsilo.v1.SnapshotPolicyService/UpdateSnapshotPolicy"
+	// SnapshotPolicyServiceDeleteSnapshotPolicyProcedure is the fully-qualified name of the
+	// SnapshotPolicyService's DeleteSnapshotPolicy RPC.
+	SnapshotPolicyServiceDeleteSnapshotPolicyProcedure = "/zfsilo.v1.SnapshotPolicyService/DeleteSnapshotPolicy"
+	// SnapshotPolicyServiceListSnapshotPolicyRunsProcedure is the fully-qualified name of the
+	// SnapshotPolicyService's ListSnapshotPolicyRuns RPC.
+	SnapshotPolicyServiceListSnapshotPolicyRunsProcedure = "/zfsilo.v1.SnapshotPolicyService/ListSnapshotPolicyRuns"
+	// ReplicationServiceGetReplicationProcedure is the fully-qualified name of the ReplicationService's
+	// GetReplication RPC.
+	ReplicationServiceGetReplicationProcedure = "/zfsilo.v1.ReplicationService/GetReplication"
+	// ReplicationServiceListReplicationsProcedure is the fully-qualified name of the
+	// ReplicationService's ListReplications RPC.
+	ReplicationServiceListReplicationsProcedure = "/zfsilo.v1.ReplicationService/ListReplications"
+	// ReplicationServiceCreateReplicationProcedure is the fully-qualified name of the
+	// ReplicationService's CreateReplication RPC.
+	ReplicationServiceCreateReplicationProcedure = "/zfsilo.v1.ReplicationService/CreateReplication"
+	// ReplicationServiceUpdateReplicationProcedure is the fully-qualified name of the
+	// ReplicationService's UpdateReplication RPC.
+	ReplicationServiceUpdateReplicationProcedure = "/zfsilo.v1.ReplicationService/UpdateReplication"
+	// ReplicationServiceDeleteReplicationProcedure is the fully-qualified name of the
+	// ReplicationService's DeleteReplication RPC.
+	ReplicationServiceDeleteReplicationProcedure = "/zfsilo.v1.ReplicationService/DeleteReplication"
+	// ReplicationServiceSyncReplicationProcedure is the fully-qualified name of the
+	// ReplicationService's SyncReplication RPC.
+	ReplicationServiceSyncReplicationProcedure = "/zfsilo.v1.ReplicationService/SyncReplication"
 )
 
 // ServiceClient is a client for the zfsilo.v1.Service service.
@@ -175,6 +278,7 @@ type HostServiceClient interface {
 	CreateHost(context.Context, *connect.Request[v1.CreateHostRequest]) (*connect.Response[v1.CreateHostResponse], error)
 	UpdateHost(context.Context, *connect.Request[v1.UpdateHostRequest]) (*connect.Response[v1.UpdateHostResponse], error)
 	DeleteHost(context.Context, *connect.Request[v1.DeleteHostRequest]) (*connect.Response[v1.DeleteHostResponse], error)
+	RotateHostKey(context.Context, *connect.Request[v1.RotateHostKeyRequest]) (*connect.Response[v1.RotateHostKeyResponse], error)
 }
 
 // NewHostServiceClient constructs a client for the zfsilo.v1.HostService service. By default, it
@@ -218,16 +322,23 @@ func NewHostServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(hostServiceMethods.ByName("DeleteHost")),
 			connect.WithClientOptions(opts...),
 		),
+		rotateHostKey: connect.NewClient[v1.RotateHostKeyRequest, v1.RotateHostKeyResponse](
+			httpClient,
+			baseURL+HostServiceRotateHostKeyProcedure,
+			connect.WithSchema(hostServiceMethods.ByName("RotateHostKey")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // hostServiceClient implements HostServiceClient.
 type hostServiceClient struct {
-	getHost    *connect.Client[v1.GetHostRequest, v1.GetHostResponse]
-	listHosts  *connect.Client[v1.ListHostsRequest, v1.ListHostsResponse]
-	createHost *connect.Client[v1.CreateHostRequest, v1.CreateHostResponse]
-	updateHost *connect.Client[v1.UpdateHostRequest, v1.UpdateHostResponse]
-	deleteHost *connect.Client[v1.DeleteHostRequest, v1.DeleteHostResponse]
+	getHost       *connect.Client[v1.GetHostRequest, v1.GetHostResponse]
+	listHosts     *connect.Client[v1.ListHostsRequest, v1.ListHostsResponse]
+	createHost    *connect.Client[v1.CreateHostRequest, v1.CreateHostResponse]
+	updateHost    *connect.Client[v1.UpdateHostRequest, v1.UpdateHostResponse]
+	deleteHost    *connect.Client[v1.DeleteHostRequest, v1.DeleteHostResponse]
+	rotateHostKey *connect.Client[v1.RotateHostKeyRequest, v1.RotateHostKeyResponse]
 }
 
 // GetHost calls zfsilo.v1.HostService.GetHost.
@@ -255,6 +366,11 @@ func (c *hostServiceClient) DeleteHost(ctx context.Context, req *connect.Request
 	return c.deleteHost.CallUnary(ctx, req)
 }
 
+// RotateHostKey calls zfsilo.v1.HostService.RotateHostKey.
+func (c *hostServiceClient) RotateHostKey(ctx context.Context, req *connect.Request[v1.RotateHostKeyRequest]) (*connect.Response[v1.RotateHostKeyResponse], error) {
+	return c.rotateHostKey.CallUnary(ctx, req)
+}
+
 // HostServiceHandler is an implementation of the zfsilo.v1.HostService service.
 type HostServiceHandler interface {
 	GetHost(context.Context, *connect.Request[v1.GetHostRequest]) (*connect.Response[v1.GetHostResponse], error)
@@ -262,6 +378,7 @@ type HostServiceHandler interface {
 	CreateHost(context.Context, *connect.Request[v1.CreateHostRequest]) (*connect.Response[v1.CreateHostResponse], error)
 	UpdateHost(context.Context, *connect.Request[v1.UpdateHostRequest]) (*connect.Response[v1.UpdateHostResponse], error)
 	DeleteHost(context.Context, *connect.Request[v1.DeleteHostRequest]) (*connect.Response[v1.DeleteHostResponse], error)
+	RotateHostKey(context.Context, *connect.Request[v1.RotateHostKeyRequest]) (*connect.Response[v1.RotateHostKeyResponse], error)
 }
 
 // NewHostServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -301,6 +418,12 @@ func NewHostServiceHandler(svc HostServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(hostServiceMethods.ByName("DeleteHost")),
 		connect.WithHandlerOptions(opts...),
 	)
+	hostServiceRotateHostKeyHandler := connect.NewUnaryHandler(
+		HostServiceRotateHostKeyProcedure,
+		svc.RotateHostKey,
+		connect.WithSchema(hostServiceMethods.ByName("RotateHostKey")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/zfsilo.v1.HostService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case HostServiceGetHostProcedure:
@@ -313,6 +436,8 @@ func NewHostServiceHandler(svc HostServiceHandler, opts ...connect.HandlerOption
 			hostServiceUpdateHostHandler.ServeHTTP(w, r)
 		case HostServiceDeleteHostProcedure:
 			hostServiceDeleteHostHandler.ServeHTTP(w, r)
+		case HostServiceRotateHostKeyProcedure:
+			hostServiceRotateHostKeyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -342,6 +467,132 @@ func (UnimplementedHostServiceHandler) DeleteHost(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.HostService.DeleteHost is not implemented"))
 }
 
+func (UnimplementedHostServiceHandler) RotateHostKey(context.Context, *connect.Request[v1.RotateHostKeyRequest]) (*connect.Response[v1.RotateHostKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.HostService.RotateHostKey is not implemented"))
+}
+
+// PoolServiceClient is a client for the zfsilo.v1.PoolService service.
+type PoolServiceClient interface {
+	GetPool(context.Context, *connect.Request[v1.GetPoolRequest]) (*connect.Response[v1.GetPoolResponse], error)
+	ListPools(context.Context, *connect.Request[v1.ListPoolsRequest]) (*connect.Response[v1.ListPoolsResponse], error)
+	GetPoolStatus(context.Context, *connect.Request[v1.GetPoolStatusRequest]) (*connect.Response[v1.GetPoolStatusResponse], error)
+}
+
+// NewPoolServiceClient constructs a client for the zfsilo.v1.PoolService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPoolServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PoolServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	poolServiceMethods := v1.File_zfsilo_v1_zfsilo_proto.Services().ByName("PoolService").Methods()
+	return &poolServiceClient{
+		getPool: connect.NewClient[v1.GetPoolRequest, v1.GetPoolResponse](
+			httpClient,
+			baseURL+PoolServiceGetPoolProcedure,
+			connect.WithSchema(poolServiceMethods.ByName("GetPool")),
+			connect.WithClientOptions(opts...),
+		),
+		listPools: connect.NewClient[v1.ListPoolsRequest, v1.ListPoolsResponse](
+			httpClient,
+			baseURL+PoolServiceListPoolsProcedure,
+			connect.WithSchema(poolServiceMethods.ByName("ListPools")),
+			connect.WithClientOptions(opts...),
+		),
+		getPoolStatus: connect.NewClient[v1.GetPoolStatusRequest, v1.GetPoolStatusResponse](
+			httpClient,
+			baseURL+PoolServiceGetPoolStatusProcedure,
+			connect.WithSchema(poolServiceMethods.ByName("GetPoolStatus")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// poolServiceClient implements PoolServiceClient.
+type poolServiceClient struct {
+	getPool       *connect.Client[v1.GetPoolRequest, v1.GetPoolResponse]
+	listPools     *connect.Client[v1.ListPoolsRequest, v1.ListPoolsResponse]
+	getPoolStatus *connect.Client[v1.GetPoolStatusRequest, v1.GetPoolStatusResponse]
+}
+
+// GetPool calls zfsilo.v1.PoolService.GetPool.
+func (c *poolServiceClient) GetPool(ctx context.Context, req *connect.Request[v1.GetPoolRequest]) (*connect.Response[v1.GetPoolResponse], error) {
+	return c.getPool.CallUnary(ctx, req)
+}
+
+// ListPools calls zfsilo.v1.PoolService.ListPools.
+func (c *poolServiceClient) ListPools(ctx context.Context, req *connect.Request[v1.ListPoolsRequest]) (*connect.Response[v1.ListPoolsResponse], error) {
+	return c.listPools.CallUnary(ctx, req)
+}
+
+// GetPoolStatus calls zfsilo.v1.PoolService.GetPoolStatus.
+func (c *poolServiceClient) GetPoolStatus(ctx context.Context, req *connect.Request[v1.GetPoolStatusRequest]) (*connect.Response[v1.GetPoolStatusResponse], error) {
+	return c.getPoolStatus.CallUnary(ctx, req)
+}
+
+// PoolServiceHandler is an implementation of the zfsilo.v1.PoolService service.
+type PoolServiceHandler interface {
+	GetPool(context.Context, *connect.Request[v1.GetPoolRequest]) (*connect.Response[v1.GetPoolResponse], error)
+	ListPools(context.Context, *connect.Request[v1.ListPoolsRequest]) (*connect.Response[v1.ListPoolsResponse], error)
+	GetPoolStatus(context.Context, *connect.Request[v1.GetPoolStatusRequest]) (*connect.Response[v1.GetPoolStatusResponse], error)
+}
+
+// NewPoolServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPoolServiceHandler(svc PoolServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	poolServiceMethods := v1.File_zfsilo_v1_zfsilo_proto.Services().ByName("PoolService").Methods()
+	poolServiceGetPoolHandler := connect.NewUnaryHandler(
+		PoolServiceGetPoolProcedure,
+		svc.GetPool,
+		connect.WithSchema(poolServiceMethods.ByName("GetPool")),
+		connect.WithHandlerOptions(opts...),
+	)
+	poolServiceListPoolsHandler := connect.NewUnaryHandler(
+		PoolServiceListPoolsProcedure,
+		svc.ListPools,
+		connect.WithSchema(poolServiceMethods.ByName("ListPools")),
+		connect.WithHandlerOptions(opts...),
+	)
+	poolServiceGetPoolStatusHandler := connect.NewUnaryHandler(
+		PoolServiceGetPoolStatusProcedure,
+		svc.GetPoolStatus,
+		connect.WithSchema(poolServiceMethods.ByName("GetPoolStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/zfsilo.v1.PoolService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PoolServiceGetPoolProcedure:
+			poolServiceGetPoolHandler.ServeHTTP(w, r)
+		case PoolServiceListPoolsProcedure:
+			poolServiceListPoolsHandler.ServeHTTP(w, r)
+		case PoolServiceGetPoolStatusProcedure:
+			poolServiceGetPoolStatusHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPoolServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPoolServiceHandler struct{}
+
+func (UnimplementedPoolServiceHandler) GetPool(context.Context, *connect.Request[v1.GetPoolRequest]) (*connect.Response[v1.GetPoolResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.PoolService.GetPool is not implemented"))
+}
+
+func (UnimplementedPoolServiceHandler) ListPools(context.Context, *connect.Request[v1.ListPoolsRequest]) (*connect.Response[v1.ListPoolsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.PoolService.ListPools is not implemented"))
+}
+
+func (UnimplementedPoolServiceHandler) GetPoolStatus(context.Context, *connect.Request[v1.GetPoolStatusRequest]) (*connect.Response[v1.GetPoolStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.PoolService.GetPoolStatus is not implemented"))
+}
+
 // VolumeServiceClient is a client for the zfsilo.v1.VolumeService service.
 type VolumeServiceClient interface {
 	GetVolume(context.Context, *connect.Request[v1.GetVolumeRequest]) (*connect.Response[v1.GetVolumeResponse], error)
@@ -360,6 +611,23 @@ type VolumeServiceClient interface {
 	StatsVolume(context.Context, *connect.Request[v1.StatsVolumeRequest]) (*connect.Response[v1.StatsVolumeResponse], error)
 	SyncVolume(context.Context, *connect.Request[v1.SyncVolumeRequest]) (*connect.Response[v1.SyncVolumeResponse], error)
 	SyncVolumes(context.Context, *connect.Request[v1.SyncVolumesRequest]) (*connect.Response[v1.SyncVolumesResponse], error)
+	GetSnapshot(context.Context, *connect.Request[v1.GetSnapshotRequest]) (*connect.Response[v1.GetSnapshotResponse], error)
+	ListSnapshots(context.Context, *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ListSnapshotsResponse], error)
+	CreateSnapshot(context.Context, *connect.Request[v1.CreateSnapshotRequest]) (*connect.Response[v1.CreateSnapshotResponse], error)
+	DeleteSnapshot(context.Context, *connect.Request[v1.DeleteSnapshotRequest]) (*connect.Response[v1.DeleteSnapshotResponse], error)
+	GetSnapshotGroup(context.Context, *connect.Request[v1.GetSnapshotGroupRequest]) (*connect.Response[v1.GetSnapshotGroupResponse], error)
+	CreateSnapshotGroup(context.Context, *connect.Request[v1.CreateSnapshotGroupRequest]) (*connect.Response[v1.CreateSnapshotGroupResponse], error)
+	DeleteSnapshotGroup(context.Context, *connect.Request[v1.DeleteSnapshotGroupRequest]) (*connect.Response[v1.DeleteSnapshotGroupResponse], error)
+	RollbackVolume(context.Context, *connect.Request[v1.RollbackVolumeRequest]) (*connect.Response[v1.RollbackVolumeResponse], error)
+	MigrateVolume(context.Context, *connect.Request[v1.MigrateVolumeRequest]) (*connect.Response[v1.MigrateVolumeResponse], error)
+	FailoverVolume(context.Context, *connect.Request[v1.FailoverVolumeRequest]) (*connect.Response[v1.FailoverVolumeResponse], error)
+	ExportVolume(context.Context, *connect.Request[v1.ExportVolumeRequest]) (*connect.Response[v1.ExportVolumeResponse], error)
+	ImportVolume(context.Context, *connect.Request[v1.ImportVolumeRequest]) (*connect.Response[v1.ImportVolumeResponse], error)
+	ListVolumeExports(context.Context, *connect.Request[v1.ListVolumeExportsRequest]) (*connect.Response[v1.ListVolumeExportsResponse], error)
+	RotateVolumeKey(context.Context, *connect.Request[v1.RotateVolumeKeyRequest]) (*connect.Response[v1.RotateVolumeKeyResponse], error)
+	GetVolumeProperties(context.Context, *connect.Request[v1.GetVolumePropertiesRequest]) (*connect.Response[v1.GetVolumePropertiesResponse], error)
+	ExpandVolume(context.Context, *connect.Request[v1.ExpandVolumeRequest]) (*connect.Response[v1.ExpandVolumeResponse], error)
+	RotateVolumeCredentials(context.Context, *connect.Request[v1.RotateVolumeCredentialsRequest]) (*connect.Response[v1.RotateVolumeCredentialsResponse], error)
 }
 
 // NewVolumeServiceClient constructs a client for the zfsilo.v1.VolumeService service. By default,
@@ -469,27 +737,146 @@ func NewVolumeServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(volumeServiceMethods.ByName("SyncVolumes")),
 			connect.WithClientOptions(opts...),
 		),
+		getSnapshot: connect.NewClient[v1.GetSnapshotRequest, v1.GetSnapshotResponse](
+			httpClient,
+			baseURL+VolumeServiceGetSnapshotProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("GetSnapshot")),
+			connect.WithClientOptions(opts...),
+		),
+		listSnapshots: connect.NewClient[v1.ListSnapshotsRequest, v1.ListSnapshotsResponse](
+			httpClient,
+			baseURL+VolumeServiceListSnapshotsProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("ListSnapshots")),
+			connect.WithClientOptions(opts...),
+		),
+		createSnapshot: connect.NewClient[v1.CreateSnapshotRequest, v1.CreateSnapshotResponse](
+			httpClient,
+			baseURL+VolumeServiceCreateSnapshotProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("CreateSnapshot")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSnapshot: connect.NewClient[v1.DeleteSnapshotRequest, v1.DeleteSnapshotResponse](
+			httpClient,
+			baseURL+VolumeServiceDeleteSnapshotProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("DeleteSnapshot")),
+			connect.WithClientOptions(opts...),
+		),
+		getSnapshotGroup: connect.NewClient[v1.GetSnapshotGroupRequest, v1.GetSnapshotGroupResponse](
+			httpClient,
+			baseURL+VolumeServiceGetSnapshotGroupProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("GetSnapshotGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		createSnapshotGroup: connect.NewClient[v1.CreateSnapshotGroupRequest, v1.CreateSnapshotGroupResponse](
+			httpClient,
+			baseURL+VolumeServiceCreateSnapshotGroupProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("CreateSnapshotGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSnapshotGroup: connect.NewClient[v1.DeleteSnapshotGroupRequest, v1.DeleteSnapshotGroupResponse](
+			httpClient,
+			baseURL+VolumeServiceDeleteSnapshotGroupProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("DeleteSnapshotGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackVolume: connect.NewClient[v1.RollbackVolumeRequest, v1.RollbackVolumeResponse](
+			httpClient,
+			baseURL+VolumeServiceRollbackVolumeProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("RollbackVolume")),
+			connect.WithClientOptions(opts...),
+		),
+		migrateVolume: connect.NewClient[v1.MigrateVolumeRequest, v1.MigrateVolumeResponse](
+			httpClient,
+			baseURL+VolumeServiceMigrateVolumeProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("MigrateVolume")),
+			connect.WithClientOptions(opts...),
+		),
+		failoverVolume: connect.NewClient[v1.FailoverVolumeRequest, v1.FailoverVolumeResponse](
+			httpClient,
+			baseURL+VolumeServiceFailoverVolumeProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("FailoverVolume")),
+			connect.WithClientOptions(opts...),
+		),
+		exportVolume: connect.NewClient[v1.ExportVolumeRequest, v1.ExportVolumeResponse](
+			httpClient,
+			baseURL+VolumeServiceExportVolumeProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("ExportVolume")),
+			connect.WithClientOptions(opts...),
+		),
+		importVolume: connect.NewClient[v1.ImportVolumeRequest, v1.ImportVolumeResponse](
+			httpClient,
+			baseURL+VolumeServiceImportVolumeProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("ImportVolume")),
+			connect.WithClientOptions(opts...),
+		),
+		listVolumeExports: connect.NewClient[v1.ListVolumeExportsRequest, v1.ListVolumeExportsResponse](
+			httpClient,
+			baseURL+VolumeServiceListVolumeExportsProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("ListVolumeExports")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateVolumeKey: connect.NewClient[v1.RotateVolumeKeyRequest, v1.RotateVolumeKeyResponse](
+			httpClient,
+			baseURL+VolumeServiceRotateVolumeKeyProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("RotateVolumeKey")),
+			connect.WithClientOptions(opts...),
+		),
+		getVolumeProperties: connect.NewClient[v1.GetVolumePropertiesRequest, v1.GetVolumePropertiesResponse](
+			httpClient,
+			baseURL+VolumeServiceGetVolumePropertiesProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("GetVolumeProperties")),
+			connect.WithClientOptions(opts...),
+		),
+		expandVolume: connect.NewClient[v1.ExpandVolumeRequest, v1.ExpandVolumeResponse](
+			httpClient,
+			baseURL+VolumeServiceExpandVolumeProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("ExpandVolume")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateVolumeCredentials: connect.NewClient[v1.RotateVolumeCredentialsRequest, v1.RotateVolumeCredentialsResponse](
+			httpClient,
+			baseURL+VolumeServiceRotateVolumeCredentialsProcedure,
+			connect.WithSchema(volumeServiceMethods.ByName("RotateVolumeCredentials")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // volumeServiceClient implements VolumeServiceClient.
 type volumeServiceClient struct {
-	getVolume        *connect.Client[v1.GetVolumeRequest, v1.GetVolumeResponse]
-	listVolumes      *connect.Client[v1.ListVolumesRequest, v1.ListVolumesResponse]
-	createVolume     *connect.Client[v1.CreateVolumeRequest, v1.CreateVolumeResponse]
-	updateVolume     *connect.Client[v1.UpdateVolumeRequest, v1.UpdateVolumeResponse]
-	deleteVolume     *connect.Client[v1.DeleteVolumeRequest, v1.DeleteVolumeResponse]
-	publishVolume    *connect.Client[v1.PublishVolumeRequest, v1.PublishVolumeResponse]
-	unpublishVolume  *connect.Client[v1.UnpublishVolumeRequest, v1.UnpublishVolumeResponse]
-	connectVolume    *connect.Client[v1.ConnectVolumeRequest, v1.ConnectVolumeResponse]
-	disconnectVolume *connect.Client[v1.DisconnectVolumeRequest, v1.DisconnectVolumeResponse]
-	stageVolume      *connect.Client[v1.StageVolumeRequest, v1.StageVolumeResponse]
-	unstageVolume    *connect.Client[v1.UnstageVolumeRequest, v1.UnstageVolumeResponse]
-	mountVolume      *connect.Client[v1.MountVolumeRequest, v1.MountVolumeResponse]
-	unmountVolume    *connect.Client[v1.UnmountVolumeRequest, v1.UnmountVolumeResponse]
-	statsVolume      *connect.Client[v1.StatsVolumeRequest, v1.StatsVolumeResponse]
-	syncVolume       *connect.Client[v1.SyncVolumeRequest, v1.SyncVolumeResponse]
-	syncVolumes      *connect.Client[v1.SyncVolumesRequest, v1.SyncVolumesResponse]
+	getVolume               *connect.Client[v1.GetVolumeRequest, v1.GetVolumeResponse]
+	listVolumes             *connect.Client[v1.ListVolumesRequest, v1.ListVolumesResponse]
+	createVolume            *connect.Client[v1.CreateVolumeRequest, v1.CreateVolumeResponse]
+	updateVolume            *connect.Client[v1.UpdateVolumeRequest, v1.UpdateVolumeResponse]
+	deleteVolume            *connect.Client[v1.DeleteVolumeRequest, v1.DeleteVolumeResponse]
+	publishVolume           *connect.Client[v1.PublishVolumeRequest, v1.PublishVolumeResponse]
+	unpublishVolume         *connect.Client[v1.UnpublishVolumeRequest, v1.UnpublishVolumeResponse]
+	connectVolume           *connect.Client[v1.ConnectVolumeRequest, v1.ConnectVolumeResponse]
+	disconnectVolume        *connect.Client[v1.DisconnectVolumeRequest, v1.DisconnectVolumeResponse]
+	stageVolume             *connect.Client[v1.StageVolumeRequest, v1.StageVolumeResponse]
+	unstageVolume           *connect.Client[v1.UnstageVolumeRequest, v1.UnstageVolumeResponse]
+	mountVolume             *connect.Client[v1.MountVolumeRequest, v1.MountVolumeResponse]
+	unmountVolume           *connect.Client[v1.UnmountVolumeRequest, v1.UnmountVolumeResponse]
+	statsVolume             *connect.Client[v1.StatsVolumeRequest, v1.StatsVolumeResponse]
+	syncVolume              *connect.Client[v1.SyncVolumeRequest, v1.SyncVolumeResponse]
+	syncVolumes             *connect.Client[v1.SyncVolumesRequest, v1.SyncVolumesResponse]
+	getSnapshot             *connect.Client[v1.GetSnapshotRequest, v1.GetSnapshotResponse]
+	listSnapshots           *connect.Client[v1.ListSnapshotsRequest, v1.ListSnapshotsResponse]
+	createSnapshot          *connect.Client[v1.CreateSnapshotRequest, v1.CreateSnapshotResponse]
+	deleteSnapshot          *connect.Client[v1.DeleteSnapshotRequest, v1.DeleteSnapshotResponse]
+	getSnapshotGroup        *connect.Client[v1.GetSnapshotGroupRequest, v1.GetSnapshotGroupResponse]
+	createSnapshotGroup     *connect.Client[v1.CreateSnapshotGroupRequest, v1.CreateSnapshotGroupResponse]
+	deleteSnapshotGroup     *connect.Client[v1.DeleteSnapshotGroupRequest, v1.DeleteSnapshotGroupResponse]
+	rollbackVolume          *connect.Client[v1.RollbackVolumeRequest, v1.RollbackVolumeResponse]
+	migrateVolume           *connect.Client[v1.MigrateVolumeRequest, v1.MigrateVolumeResponse]
+	failoverVolume          *connect.Client[v1.FailoverVolumeRequest, v1.FailoverVolumeResponse]
+	exportVolume            *connect.Client[v1.ExportVolumeRequest, v1.ExportVolumeResponse]
+	importVolume            *connect.Client[v1.ImportVolumeRequest, v1.ImportVolumeResponse]
+	listVolumeExports       *connect.Client[v1.ListVolumeExportsRequest, v1.ListVolumeExportsResponse]
+	rotateVolumeKey         *connect.Client[v1.RotateVolumeKeyRequest, v1.RotateVolumeKeyResponse]
+	getVolumeProperties     *connect.Client[v1.GetVolumePropertiesRequest, v1.GetVolumePropertiesResponse]
+	expandVolume            *connect.Client[v1.ExpandVolumeRequest, v1.ExpandVolumeResponse]
+	rotateVolumeCredentials *connect.Client[v1.RotateVolumeCredentialsRequest, v1.RotateVolumeCredentialsResponse]
 }
 
 // GetVolume calls zfsilo.v1.VolumeService.GetVolume.
@@ -572,6 +959,91 @@ func (c *volumeServiceClient) SyncVolumes(ctx context.Context, req *connect.Requ
 	return c.syncVolumes.CallUnary(ctx, req)
 }
 
+// GetSnapshot calls zfsilo.v1.VolumeService.GetSnapshot.
+func (c *volumeServiceClient) GetSnapshot(ctx context.Context, req *connect.Request[v1.GetSnapshotRequest]) (*connect.Response[v1.GetSnapshotResponse], error) {
+	return c.getSnapshot.CallUnary(ctx, req)
+}
+
+// ListSnapshots calls zfsilo.v1.VolumeService.ListSnapshots.
+func (c *volumeServiceClient) ListSnapshots(ctx context.Context, req *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ListSnapshotsResponse], error) {
+	return c.listSnapshots.CallUnary(ctx, req)
+}
+
+// CreateSnapshot calls zfsilo.v1.VolumeService.CreateSnapshot.
+func (c *volumeServiceClient) CreateSnapshot(ctx context.Context, req *connect.Request[v1.CreateSnapshotRequest]) (*connect.Response[v1.CreateSnapshotResponse], error) {
+	return c.createSnapshot.CallUnary(ctx, req)
+}
+
+// DeleteSnapshot calls zfsilo.v1.VolumeService.DeleteSnapshot.
+func (c *volumeServiceClient) DeleteSnapshot(ctx context.Context, req *connect.Request[v1.DeleteSnapshotRequest]) (*connect.Response[v1.DeleteSnapshotResponse], error) {
+	return c.deleteSnapshot.CallUnary(ctx, req)
+}
+
+// GetSnapshotGroup calls zfsilo.v1.VolumeService.GetSnapshotGroup.
+func (c *volumeServiceClient) GetSnapshotGroup(ctx context.Context, req *connect.Request[v1.GetSnapshotGroupRequest]) (*connect.Response[v1.GetSnapshotGroupResponse], error) {
+	return c.getSnapshotGroup.CallUnary(ctx, req)
+}
+
+// CreateSnapshotGroup calls zfsilo.v1.VolumeService.CreateSnapshotGroup.
+func (c *volumeServiceClient) CreateSnapshotGroup(ctx context.Context, req *connect.Request[v1.CreateSnapshotGroupRequest]) (*connect.Response[v1.CreateSnapshotGroupResponse], error) {
+	return c.createSnapshotGroup.CallUnary(ctx, req)
+}
+
+// DeleteSnapshotGroup calls zfsilo.v1.VolumeService.DeleteSnapshotGroup.
+func (c *volumeServiceClient) DeleteSnapshotGroup(ctx context.Context, req *connect.Request[v1.DeleteSnapshotGroupRequest]) (*connect.Response[v1.DeleteSnapshotGroupResponse], error) {
+	return c.deleteSnapshotGroup.CallUnary(ctx, req)
+}
+
+// RollbackVolume calls zfsilo.v1.VolumeService.RollbackVolume.
+func (c *volumeServiceClient) RollbackVolume(ctx context.Context, req *connect.Request[v1.RollbackVolumeRequest]) (*connect.Response[v1.RollbackVolumeResponse], error) {
+	return c.rollbackVolume.CallUnary(ctx, req)
+}
+
+// MigrateVolume calls zfsilo.v1.VolumeService.MigrateVolume.
+func (c *volumeServiceClient) MigrateVolume(ctx context.Context, req *connect.Request[v1.MigrateVolumeRequest]) (*connect.Response[v1.MigrateVolumeResponse], error) {
+	return c.migrateVolume.CallUnary(ctx, req)
+}
+
+// FailoverVolume calls zfsilo.v1.VolumeService.FailoverVolume.
+func (c *volumeServiceClient) FailoverVolume(ctx context.Context, req *connect.Request[v1.FailoverVolumeRequest]) (*connect.Response[v1.FailoverVolumeResponse], error) {
+	return c.failoverVolume.CallUnary(ctx, req)
+}
+
+// ExportVolume calls zfsilo.v1.VolumeService.ExportVolume.
+func (c *volumeServiceClient) ExportVolume(ctx context.Context, req *connect.Request[v1.ExportVolumeRequest]) (*connect.Response[v1.ExportVolumeResponse], error) {
+	return c.exportVolume.CallUnary(ctx, req)
+}
+
+// ImportVolume calls zfsilo.v1.VolumeService.ImportVolume.
+func (c *volumeServiceClient) ImportVolume(ctx context.Context, req *connect.Request[v1.ImportVolumeRequest]) (*connect.Response[v1.ImportVolumeResponse], error) {
+	return c.importVolume.CallUnary(ctx, req)
+}
+
+// ListVolumeExports calls zfsilo.v1.VolumeService.ListVolumeExports.
+func (c *volumeServiceClient) ListVolumeExports(ctx context.Context, req *connect.Request[v1.ListVolumeExportsRequest]) (*connect.Response[v1.ListVolumeExportsResponse], error) {
+	return c.listVolumeExports.CallUnary(ctx, req)
+}
+
+// RotateVolumeKey calls zfsilo.v1.VolumeService.RotateVolumeKey.
+func (c *volumeServiceClient) RotateVolumeKey(ctx context.Context, req *connect.Request[v1.RotateVolumeKeyRequest]) (*connect.Response[v1.RotateVolumeKeyResponse], error) {
+	return c.rotateVolumeKey.CallUnary(ctx, req)
+}
+
+// GetVolumeProperties calls zfsilo.v1.VolumeService.GetVolumeProperties.
+func (c *volumeServiceClient) GetVolumeProperties(ctx context.Context, req *connect.Request[v1.GetVolumePropertiesRequest]) (*connect.Response[v1.GetVolumePropertiesResponse], error) {
+	return c.getVolumeProperties.CallUnary(ctx, req)
+}
+
+// ExpandVolume calls zfsilo.v1.VolumeService.ExpandVolume.
+func (c *volumeServiceClient) ExpandVolume(ctx context.Context, req *connect.Request[v1.ExpandVolumeRequest]) (*connect.Response[v1.ExpandVolumeResponse], error) {
+	return c.expandVolume.CallUnary(ctx, req)
+}
+
+// RotateVolumeCredentials calls zfsilo.v1.VolumeService.RotateVolumeCredentials.
+func (c *volumeServiceClient) RotateVolumeCredentials(ctx context.Context, req *connect.Request[v1.RotateVolumeCredentialsRequest]) (*connect.Response[v1.RotateVolumeCredentialsResponse], error) {
+	return c.rotateVolumeCredentials.CallUnary(ctx, req)
+}
+
 // VolumeServiceHandler is an implementation of the zfsilo.v1.VolumeService service.
 type VolumeServiceHandler interface {
 	GetVolume(context.Context, *connect.Request[v1.GetVolumeRequest]) (*connect.Response[v1.GetVolumeResponse], error)
@@ -590,6 +1062,23 @@ type VolumeServiceHandler interface {
 	StatsVolume(context.Context, *connect.Request[v1.StatsVolumeRequest]) (*connect.Response[v1.StatsVolumeResponse], error)
 	SyncVolume(context.Context, *connect.Request[v1.SyncVolumeRequest]) (*connect.Response[v1.SyncVolumeResponse], error)
 	SyncVolumes(context.Context, *connect.Request[v1.SyncVolumesRequest]) (*connect.Response[v1.SyncVolumesResponse], error)
+	GetSnapshot(context.Context, *connect.Request[v1.GetSnapshotRequest]) (*connect.Response[v1.GetSnapshotResponse], error)
+	ListSnapshots(context.Context, *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ListSnapshotsResponse], error)
+	CreateSnapshot(context.Context, *connect.Request[v1.CreateSnapshotRequest]) (*connect.Response[v1.CreateSnapshotResponse], error)
+	DeleteSnapshot(context.Context, *connect.Request[v1.DeleteSnapshotRequest]) (*connect.Response[v1.DeleteSnapshotResponse], error)
+	GetSnapshotGroup(context.Context, *connect.Request[v1.GetSnapshotGroupRequest]) (*connect.Response[v1.GetSnapshotGroupResponse], error)
+	CreateSnapshotGroup(context.Context, *connect.Request[v1.CreateSnapshotGroupRequest]) (*connect.Response[v1.CreateSnapshotGroupResponse], error)
+	DeleteSnapshotGroup(context.Context, *connect.Request[v1.DeleteSnapshotGroupRequest]) (*connect.Response[v1.DeleteSnapshotGroupResponse], error)
+	RollbackVolume(context.Context, *connect.Request[v1.RollbackVolumeRequest]) (*connect.Response[v1.RollbackVolumeResponse], error)
+	MigrateVolume(context.Context, *connect.Request[v1.MigrateVolumeRequest]) (*connect.Response[v1.MigrateVolumeResponse], error)
+	FailoverVolume(context.Context, *connect.Request[v1.FailoverVolumeRequest]) (*connect.Response[v1.FailoverVolumeResponse], error)
+	ExportVolume(context.Context, *connect.Request[v1.ExportVolumeRequest]) (*connect.Response[v1.ExportVolumeResponse], error)
+	ImportVolume(context.Context, *connect.Request[v1.ImportVolumeRequest]) (*connect.Response[v1.ImportVolumeResponse], error)
+	ListVolumeExports(context.Context, *connect.Request[v1.ListVolumeExportsRequest]) (*connect.Response[v1.ListVolumeExportsResponse], error)
+	RotateVolumeKey(context.Context, *connect.Request[v1.RotateVolumeKeyRequest]) (*connect.Response[v1.RotateVolumeKeyResponse], error)
+	GetVolumeProperties(context.Context, *connect.Request[v1.GetVolumePropertiesRequest]) (*connect.Response[v1.GetVolumePropertiesResponse], error)
+	ExpandVolume(context.Context, *connect.Request[v1.ExpandVolumeRequest]) (*connect.Response[v1.ExpandVolumeResponse], error)
+	RotateVolumeCredentials(context.Context, *connect.Request[v1.RotateVolumeCredentialsRequest]) (*connect.Response[v1.RotateVolumeCredentialsResponse], error)
 }
 
 // NewVolumeServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -695,6 +1184,108 @@ func NewVolumeServiceHandler(svc VolumeServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(volumeServiceMethods.ByName("SyncVolumes")),
 		connect.WithHandlerOptions(opts...),
 	)
+	volumeServiceGetSnapshotHandler := connect.NewUnaryHandler(
+		VolumeServiceGetSnapshotProcedure,
+		svc.GetSnapshot,
+		connect.WithSchema(volumeServiceMethods.ByName("GetSnapshot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceListSnapshotsHandler := connect.NewUnaryHandler(
+		VolumeServiceListSnapshotsProcedure,
+		svc.ListSnapshots,
+		connect.WithSchema(volumeServiceMethods.ByName("ListSnapshots")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceCreateSnapshotHandler := connect.NewUnaryHandler(
+		VolumeServiceCreateSnapshotProcedure,
+		svc.CreateSnapshot,
+		connect.WithSchema(volumeServiceMethods.ByName("CreateSnapshot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceDeleteSnapshotHandler := connect.NewUnaryHandler(
+		VolumeServiceDeleteSnapshotProcedure,
+		svc.DeleteSnapshot,
+		connect.WithSchema(volumeServiceMethods.ByName("DeleteSnapshot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceGetSnapshotGroupHandler := connect.NewUnaryHandler(
+		VolumeServiceGetSnapshotGroupProcedure,
+		svc.GetSnapshotGroup,
+		connect.WithSchema(volumeServiceMethods.ByName("GetSnapshotGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceCreateSnapshotGroupHandler := connect.NewUnaryHandler(
+		VolumeServiceCreateSnapshotGroupProcedure,
+		svc.CreateSnapshotGroup,
+		connect.WithSchema(volumeServiceMethods.ByName("CreateSnapshotGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceDeleteSnapshotGroupHandler := connect.NewUnaryHandler(
+		VolumeServiceDeleteSnapshotGroupProcedure,
+		svc.DeleteSnapshotGroup,
+		connect.WithSchema(volumeServiceMethods.ByName("DeleteSnapshotGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceRollbackVolumeHandler := connect.NewUnaryHandler(
+		VolumeServiceRollbackVolumeProcedure,
+		svc.RollbackVolume,
+		connect.WithSchema(volumeServiceMethods.ByName("RollbackVolume")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceMigrateVolumeHandler := connect.NewUnaryHandler(
+		VolumeServiceMigrateVolumeProcedure,
+		svc.MigrateVolume,
+		connect.WithSchema(volumeServiceMethods.ByName("MigrateVolume")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceFailoverVolumeHandler := connect.NewUnaryHandler(
+		VolumeServiceFailoverVolumeProcedure,
+		svc.FailoverVolume,
+		connect.WithSchema(volumeServiceMethods.ByName("FailoverVolume")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceExportVolumeHandler := connect.NewUnaryHandler(
+		VolumeServiceExportVolumeProcedure,
+		svc.ExportVolume,
+		connect.WithSchema(volumeServiceMethods.ByName("ExportVolume")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceImportVolumeHandler := connect.NewUnaryHandler(
+		VolumeServiceImportVolumeProcedure,
+		svc.ImportVolume,
+		connect.WithSchema(volumeServiceMethods.ByName("ImportVolume")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceListVolumeExportsHandler := connect.NewUnaryHandler(
+		VolumeServiceListVolumeExportsProcedure,
+		svc.ListVolumeExports,
+		connect.WithSchema(volumeServiceMethods.ByName("ListVolumeExports")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceRotateVolumeKeyHandler := connect.NewUnaryHandler(
+		VolumeServiceRotateVolumeKeyProcedure,
+		svc.RotateVolumeKey,
+		connect.WithSchema(volumeServiceMethods.ByName("RotateVolumeKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceGetVolumePropertiesHandler := connect.NewUnaryHandler(
+		VolumeServiceGetVolumePropertiesProcedure,
+		svc.GetVolumeProperties,
+		connect.WithSchema(volumeServiceMethods.ByName("GetVolumeProperties")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceExpandVolumeHandler := connect.NewUnaryHandler(
+		VolumeServiceExpandVolumeProcedure,
+		svc.ExpandVolume,
+		connect.WithSchema(volumeServiceMethods.ByName("ExpandVolume")),
+		connect.WithHandlerOptions(opts...),
+	)
+	volumeServiceRotateVolumeCredentialsHandler := connect.NewUnaryHandler(
+		VolumeServiceRotateVolumeCredentialsProcedure,
+		svc.RotateVolumeCredentials,
+		connect.WithSchema(volumeServiceMethods.ByName("RotateVolumeCredentials")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/zfsilo.v1.VolumeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case VolumeServiceGetVolumeProcedure:
@@ -729,6 +1320,40 @@ func NewVolumeServiceHandler(svc VolumeServiceHandler, opts ...connect.HandlerOp
 			volumeServiceSyncVolumeHandler.ServeHTTP(w, r)
 		case VolumeServiceSyncVolumesProcedure:
 			volumeServiceSyncVolumesHandler.ServeHTTP(w, r)
+		case VolumeServiceGetSnapshotProcedure:
+			volumeServiceGetSnapshotHandler.ServeHTTP(w, r)
+		case VolumeServiceListSnapshotsProcedure:
+			volumeServiceListSnapshotsHandler.ServeHTTP(w, r)
+		case VolumeServiceCreateSnapshotProcedure:
+			volumeServiceCreateSnapshotHandler.ServeHTTP(w, r)
+		case VolumeServiceDeleteSnapshotProcedure:
+			volumeServiceDeleteSnapshotHandler.ServeHTTP(w, r)
+		case VolumeServiceGetSnapshotGroupProcedure:
+			volumeServiceGetSnapshotGroupHandler.ServeHTTP(w, r)
+		case VolumeServiceCreateSnapshotGroupProcedure:
+			volumeServiceCreateSnapshotGroupHandler.ServeHTTP(w, r)
+		case VolumeServiceDeleteSnapshotGroupProcedure:
+			volumeServiceDeleteSnapshotGroupHandler.ServeHTTP(w, r)
+		case VolumeServiceRollbackVolumeProcedure:
+			volumeServiceRollbackVolumeHandler.ServeHTTP(w, r)
+		case VolumeServiceMigrateVolumeProcedure:
+			volumeServiceMigrateVolumeHandler.ServeHTTP(w, r)
+		case VolumeServiceFailoverVolumeProcedure:
+			volumeServiceFailoverVolumeHandler.ServeHTTP(w, r)
+		case VolumeServiceExportVolumeProcedure:
+			volumeServiceExportVolumeHandler.ServeHTTP(w, r)
+		case VolumeServiceImportVolumeProcedure:
+			volumeServiceImportVolumeHandler.ServeHTTP(w, r)
+		case VolumeServiceListVolumeExportsProcedure:
+			volumeServiceListVolumeExportsHandler.ServeHTTP(w, r)
+		case VolumeServiceRotateVolumeKeyProcedure:
+			volumeServiceRotateVolumeKeyHandler.ServeHTTP(w, r)
+		case VolumeServiceGetVolumePropertiesProcedure:
+			volumeServiceGetVolumePropertiesHandler.ServeHTTP(w, r)
+		case VolumeServiceExpandVolumeProcedure:
+			volumeServiceExpandVolumeHandler.ServeHTTP(w, r)
+		case VolumeServiceRotateVolumeCredentialsProcedure:
+			volumeServiceRotateVolumeCredentialsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -801,3 +1426,471 @@ func (UnimplementedVolumeServiceHandler) SyncVolume(context.Context, *connect.Re
 func (UnimplementedVolumeServiceHandler) SyncVolumes(context.Context, *connect.Request[v1.SyncVolumesRequest]) (*connect.Response[v1.SyncVolumesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.SyncVolumes is not implemented"))
 }
+
+func (UnimplementedVolumeServiceHandler) GetSnapshot(context.Context, *connect.Request[v1.GetSnapshotRequest]) (*connect.Response[v1.GetSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.GetSnapshot is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) ListSnapshots(context.Context, *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ListSnapshotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.ListSnapshots is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) CreateSnapshot(context.Context, *connect.Request[v1.CreateSnapshotRequest]) (*connect.Response[v1.CreateSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.CreateSnapshot is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) DeleteSnapshot(context.Context, *connect.Request[v1.DeleteSnapshotRequest]) (*connect.Response[v1.DeleteSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.DeleteSnapshot is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) GetSnapshotGroup(context.Context, *connect.Request[v1.GetSnapshotGroupRequest]) (*connect.Response[v1.GetSnapshotGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.GetSnapshotGroup is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) CreateSnapshotGroup(context.Context, *connect.Request[v1.CreateSnapshotGroupRequest]) (*connect.Response[v1.CreateSnapshotGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.CreateSnapshotGroup is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) DeleteSnapshotGroup(context.Context, *connect.Request[v1.DeleteSnapshotGroupRequest]) (*connect.Response[v1.DeleteSnapshotGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.DeleteSnapshotGroup is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) RollbackVolume(context.Context, *connect.Request[v1.RollbackVolumeRequest]) (*connect.Response[v1.RollbackVolumeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.RollbackVolume is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) MigrateVolume(context.Context, *connect.Request[v1.MigrateVolumeRequest]) (*connect.Response[v1.MigrateVolumeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.MigrateVolume is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) FailoverVolume(context.Context, *connect.Request[v1.FailoverVolumeRequest]) (*connect.Response[v1.FailoverVolumeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.FailoverVolume is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) ExportVolume(context.Context, *connect.Request[v1.ExportVolumeRequest]) (*connect.Response[v1.ExportVolumeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.ExportVolume is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) ImportVolume(context.Context, *connect.Request[v1.ImportVolumeRequest]) (*connect.Response[v1.ImportVolumeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.ImportVolume is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) ListVolumeExports(context.Context, *connect.Request[v1.ListVolumeExportsRequest]) (*connect.Response[v1.ListVolumeExportsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.ListVolumeExports is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) RotateVolumeKey(context.Context, *connect.Request[v1.RotateVolumeKeyRequest]) (*connect.Response[v1.RotateVolumeKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.RotateVolumeKey is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) GetVolumeProperties(context.Context, *connect.Request[v1.GetVolumePropertiesRequest]) (*connect.Response[v1.GetVolumePropertiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.GetVolumeProperties is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) ExpandVolume(context.Context, *connect.Request[v1.ExpandVolumeRequest]) (*connect.Response[v1.ExpandVolumeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.ExpandVolume is not implemented"))
+}
+
+func (UnimplementedVolumeServiceHandler) RotateVolumeCredentials(context.Context, *connect.Request[v1.RotateVolumeCredentialsRequest]) (*connect.Response[v1.RotateVolumeCredentialsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.VolumeService.RotateVolumeCredentials is not implemented"))
+}
+
+// SnapshotPolicyServiceClient is a client for the zfsilo.v1.SnapshotPolicyService service.
+type SnapshotPolicyServiceClient interface {
+	GetSnapshotPolicy(context.Context, *connect.Request[v1.GetSnapshotPolicyRequest]) (*connect.Response[v1.GetSnapshotPolicyResponse], error)
+	ListSnapshotPolicies(context.Context, *connect.Request[v1.ListSnapshotPoliciesRequest]) (*connect.Response[v1.ListSnapshotPoliciesResponse], error)
+	CreateSnapshotPolicy(context.Context, *connect.Request[v1.CreateSnapshotPolicyRequest]) (*connect.Response[v1.CreateSnapshotPolicyResponse], error)
+	UpdateSnapshotPolicy(context.Context, *connect.Request[v1.UpdateSnapshotPolicyRequest]) (*connect.Response[v1.UpdateSnapshotPolicyResponse], error)
+	DeleteSnapshotPolicy(context.Context, *connect.Request[v1.DeleteSnapshotPolicyRequest]) (*connect.Response[v1.DeleteSnapshotPolicyResponse], error)
+	ListSnapshotPolicyRuns(context.Context, *connect.Request[v1.ListSnapshotPolicyRunsRequest]) (*connect.Response[v1.ListSnapshotPolicyRunsResponse], error)
+}
+
+// NewSnapshotPolicyServiceClient constructs a client for the zfsilo.v1.SnapshotPolicyService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSnapshotPolicyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SnapshotPolicyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	snapshotPolicyServiceMethods := v1.File_zfsilo_v1_zfsilo_proto.Services().ByName("SnapshotPolicyService").Methods()
+	return &snapshotPolicyServiceClient{
+		getSnapshotPolicy: connect.NewClient[v1.GetSnapshotPolicyRequest, v1.GetSnapshotPolicyResponse](
+			httpClient,
+			baseURL+SnapshotPolicyServiceGetSnapshotPolicyProcedure,
+			connect.WithSchema(snapshotPolicyServiceMethods.ByName("GetSnapshotPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		listSnapshotPolicies: connect.NewClient[v1.ListSnapshotPoliciesRequest, v1.ListSnapshotPoliciesResponse](
+			httpClient,
+			baseURL+SnapshotPolicyServiceListSnapshotPoliciesProcedure,
+			connect.WithSchema(snapshotPolicyServiceMethods.ByName("ListSnapshotPolicies")),
+			connect.WithClientOptions(opts...),
+		),
+		createSnapshotPolicy: connect.NewClient[v1.CreateSnapshotPolicyRequest, v1.CreateSnapshotPolicyResponse](
+			httpClient,
+			baseURL+SnapshotPolicyServiceCreateSnapshotPolicyProcedure,
+			connect.WithSchema(snapshotPolicyServiceMethods.ByName("CreateSnapshotPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSnapshotPolicy: connect.NewClient[v1.UpdateSnapshotPolicyRequest, v1.UpdateSnapshotPolicyResponse](
+			httpClient,
+			baseURL+SnapshotPolicyServiceUpdateSnapshotPolicyProcedure,
+			connect.WithSchema(snapshotPolicyServiceMethods.ByName("UpdateSnapshotPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSnapshotPolicy: connect.NewClient[v1.DeleteSnapshotPolicyRequest, v1.DeleteSnapshotPolicyResponse](
+			httpClient,
+			baseURL+SnapshotPolicyServiceDeleteSnapshotPolicyProcedure,
+			connect.WithSchema(snapshotPolicyServiceMethods.ByName("DeleteSnapshotPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		listSnapshotPolicyRuns: connect.NewClient[v1.ListSnapshotPolicyRunsRequest, v1.ListSnapshotPolicyRunsResponse](
+			httpClient,
+			baseURL+SnapshotPolicyServiceListSnapshotPolicyRunsProcedure,
+			connect.WithSchema(snapshotPolicyServiceMethods.ByName("ListSnapshotPolicyRuns")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// snapshotPolicyServiceClient implements SnapshotPolicyServiceClient.
+type snapshotPolicyServiceClient struct {
+	getSnapshotPolicy      *connect.Client[v1.GetSnapshotPolicyRequest, v1.GetSnapshotPolicyResponse]
+	listSnapshotPolicies   *connect.Client[v1.ListSnapshotPoliciesRequest, v1.ListSnapshotPoliciesResponse]
+	createSnapshotPolicy   *connect.Client[v1.CreateSnapshotPolicyRequest, v1.CreateSnapshotPolicyResponse]
+	updateSnapshotPolicy   *connect.Client[v1.UpdateSnapshotPolicyRequest, v1.UpdateSnapshotPolicyResponse]
+	deleteSnapshotPolicy   *connect.Client[v1.DeleteSnapshotPolicyRequest, v1.DeleteSnapshotPolicyResponse]
+	listSnapshotPolicyRuns *connect.Client[v1.ListSnapshotPolicyRunsRequest, v1.ListSnapshotPolicyRunsResponse]
+}
+
+// GetSnapshotPolicy calls zfsilo.v1.SnapshotPolicyService.GetSnapshotPolicy.
+func (c *snapshotPolicyServiceClient) GetSnapshotPolicy(ctx context.Context, req *connect.Request[v1.GetSnapshotPolicyRequest]) (*connect.Response[v1.GetSnapshotPolicyResponse], error) {
+	return c.getSnapshotPolicy.CallUnary(ctx, req)
+}
+
+// ListSnapshotPolicies calls zfsilo.v1.SnapshotPolicyService.ListSnapshotPolicies.
+func (c *snapshotPolicyServiceClient) ListSnapshotPolicies(ctx context.Context, req *connect.Request[v1.ListSnapshotPoliciesRequest]) (*connect.Response[v1.ListSnapshotPoliciesResponse], error) {
+	return c.listSnapshotPolicies.CallUnary(ctx, req)
+}
+
+// CreateSnapshotPolicy calls zfsilo.v1.SnapshotPolicyService.CreateSnapshotPolicy.
+func (c *snapshotPolicyServiceClient) CreateSnapshotPolicy(ctx context.Context, req *connect.Request[v1.CreateSnapshotPolicyRequest]) (*connect.Response[v1.CreateSnapshotPolicyResponse], error) {
+	return c.createSnapshotPolicy.CallUnary(ctx, req)
+}
+
+// UpdateSnapshotPolicy calls zfsilo.v1.SnapshotPolicyService.UpdateSnapshotPolicy.
+func (c *snapshotPolicyServiceClient) UpdateSnapshotPolicy(ctx context.Context, req *connect.Request[v1.UpdateSnapshotPolicyRequest]) (*connect.Response[v1.UpdateSnapshotPolicyResponse], error) {
+	return c.updateSnapshotPolicy.CallUnary(ctx, req)
+}
+
+// DeleteSnapshotPolicy calls zfsilo.v1.SnapshotPolicyService.DeleteSnapshotPolicy.
+func (c *snapshotPolicyServiceClient) DeleteSnapshotPolicy(ctx context.Context, req *connect.Request[v1.DeleteSnapshotPolicyRequest]) (*connect.Response[v1.DeleteSnapshotPolicyResponse], error) {
+	return c.deleteSnapshotPolicy.CallUnary(ctx, req)
+}
+
+// ListSnapshotPolicyRuns calls zfsilo.v1.SnapshotPolicyService.ListSnapshotPolicyRuns.
+func (c *snapshotPolicyServiceClient) ListSnapshotPolicyRuns(ctx context.Context, req *connect.Request[v1.ListSnapshotPolicyRunsRequest]) (*connect.Response[v1.ListSnapshotPolicyRunsResponse], error) {
+	return c.listSnapshotPolicyRuns.CallUnary(ctx, req)
+}
+
+// SnapshotPolicyServiceHandler is an implementation of the zfsilo.v1.SnapshotPolicyService service.
+type SnapshotPolicyServiceHandler interface {
+	GetSnapshotPolicy(context.Context, *connect.Request[v1.GetSnapshotPolicyRequest]) (*connect.Response[v1.GetSnapshotPolicyResponse], error)
+	ListSnapshotPolicies(context.Context, *connect.Request[v1.ListSnapshotPoliciesRequest]) (*connect.Response[v1.ListSnapshotPoliciesResponse], error)
+	CreateSnapshotPolicy(context.Context, *connect.Request[v1.CreateSnapshotPolicyRequest]) (*connect.Response[v1.CreateSnapshotPolicyResponse], error)
+	UpdateSnapshotPolicy(context.Context, *connect.Request[v1.UpdateSnapshotPolicyRequest]) (*connect.Response[v1.UpdateSnapshotPolicyResponse], error)
+	DeleteSnapshotPolicy(context.Context, *connect.Request[v1.DeleteSnapshotPolicyRequest]) (*connect.Response[v1.DeleteSnapshotPolicyResponse], error)
+	ListSnapshotPolicyRuns(context.Context, *connect.Request[v1.ListSnapshotPolicyRunsRequest]) (*connect.Response[v1.ListSnapshotPolicyRunsResponse], error)
+}
+
+// NewSnapshotPolicyServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSnapshotPolicyServiceHandler(svc SnapshotPolicyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	snapshotPolicyServiceMethods := v1.File_zfsilo_v1_zfsilo_proto.Services().ByName("SnapshotPolicyService").Methods()
+	snapshotPolicyServiceGetSnapshotPolicyHandler := connect.NewUnaryHandler(
+		SnapshotPolicyServiceGetSnapshotPolicyProcedure,
+		svc.GetSnapshotPolicy,
+		connect.WithSchema(snapshotPolicyServiceMethods.ByName("GetSnapshotPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	snapshotPolicyServiceListSnapshotPoliciesHandler := connect.NewUnaryHandler(
+		SnapshotPolicyServiceListSnapshotPoliciesProcedure,
+		svc.ListSnapshotPolicies,
+		connect.WithSchema(snapshotPolicyServiceMethods.ByName("ListSnapshotPolicies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	snapshotPolicyServiceCreateSnapshotPolicyHandler := connect.NewUnaryHandler(
+		SnapshotPolicyServiceCreateSnapshotPolicyProcedure,
+		svc.CreateSnapshotPolicy,
+		connect.WithSchema(snapshotPolicyServiceMethods.ByName("CreateSnapshotPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	snapshotPolicyServiceUpdateSnapshotPolicyHandler := connect.NewUnaryHandler(
+		SnapshotPolicyServiceUpdateSnapshotPolicyProcedure,
+		svc.UpdateSnapshotPolicy,
+		connect.WithSchema(snapshotPolicyServiceMethods.ByName("UpdateSnapshotPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	snapshotPolicyServiceDeleteSnapshotPolicyHandler := connect.NewUnaryHandler(
+		SnapshotPolicyServiceDeleteSnapshotPolicyProcedure,
+		svc.DeleteSnapshotPolicy,
+		connect.WithSchema(snapshotPolicyServiceMethods.ByName("DeleteSnapshotPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	snapshotPolicyServiceListSnapshotPolicyRunsHandler := connect.NewUnaryHandler(
+		SnapshotPolicyServiceListSnapshotPolicyRunsProcedure,
+		svc.ListSnapshotPolicyRuns,
+		connect.WithSchema(snapshotPolicyServiceMethods.ByName("ListSnapshotPolicyRuns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/zfsilo.v1.SnapshotPolicyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SnapshotPolicyServiceGetSnapshotPolicyProcedure:
+			snapshotPolicyServiceGetSnapshotPolicyHandler.ServeHTTP(w, r)
+		case SnapshotPolicyServiceListSnapshotPoliciesProcedure:
+			snapshotPolicyServiceListSnapshotPoliciesHandler.ServeHTTP(w, r)
+		case SnapshotPolicyServiceCreateSnapshotPolicyProcedure:
+			snapshotPolicyServiceCreateSnapshotPolicyHandler.ServeHTTP(w, r)
+		case SnapshotPolicyServiceUpdateSnapshotPolicyProcedure:
+			snapshotPolicyServiceUpdateSnapshotPolicyHandler.ServeHTTP(w, r)
+		case SnapshotPolicyServiceDeleteSnapshotPolicyProcedure:
+			snapshotPolicyServiceDeleteSnapshotPolicyHandler.ServeHTTP(w, r)
+		case SnapshotPolicyServiceListSnapshotPolicyRunsProcedure:
+			snapshotPolicyServiceListSnapshotPolicyRunsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSnapshotPolicyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSnapshotPolicyServiceHandler struct{}
+
+func (UnimplementedSnapshotPolicyServiceHandler) GetSnapshotPolicy(context.Context, *connect.Request[v1.GetSnapshotPolicyRequest]) (*connect.Response[v1.GetSnapshotPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.SnapshotPolicyService.GetSnapshotPolicy is not implemented"))
+}
+
+func (UnimplementedSnapshotPolicyServiceHandler) ListSnapshotPolicies(context.Context, *connect.Request[v1.ListSnapshotPoliciesRequest]) (*connect.Response[v1.ListSnapshotPoliciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.SnapshotPolicyService.ListSnapshotPolicies is not implemented"))
+}
+
+func (UnimplementedSnapshotPolicyServiceHandler) CreateSnapshotPolicy(context.Context, *connect.Request[v1.CreateSnapshotPolicyRequest]) (*connect.Response[v1.CreateSnapshotPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.SnapshotPolicyService.CreateSnapshotPolicy is not implemented"))
+}
+
+func (UnimplementedSnapshotPolicyServiceHandler) UpdateSnapshotPolicy(context.Context, *connect.Request[v1.UpdateSnapshotPolicyRequest]) (*connect.Response[v1.UpdateSnapshotPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.SnapshotPolicyService.UpdateSnapshotPolicy is not implemented"))
+}
+
+func (UnimplementedSnapshotPolicyServiceHandler) DeleteSnapshotPolicy(context.Context, *connect.Request[v1.DeleteSnapshotPolicyRequest]) (*connect.Response[v1.DeleteSnapshotPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.SnapshotPolicyService.DeleteSnapshotPolicy is not implemented"))
+}
+
+func (UnimplementedSnapshotPolicyServiceHandler) ListSnapshotPolicyRuns(context.Context, *connect.Request[v1.ListSnapshotPolicyRunsRequest]) (*connect.Response[v1.ListSnapshotPolicyRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.SnapshotPolicyService.ListSnapshotPolicyRuns is not implemented"))
+}
+
+// ReplicationServiceClient is a client for the zfsilo.v1.ReplicationService service.
+type ReplicationServiceClient interface {
+	GetReplication(context.Context, *connect.Request[v1.GetReplicationRequest]) (*connect.Response[v1.GetReplicationResponse], error)
+	ListReplications(context.Context, *connect.Request[v1.ListReplicationsRequest]) (*connect.Response[v1.ListReplicationsResponse], error)
+	CreateReplication(context.Context, *connect.Request[v1.CreateReplicationRequest]) (*connect.Response[v1.CreateReplicationResponse], error)
+	UpdateReplication(context.Context, *connect.Request[v1.UpdateReplicationRequest]) (*connect.Response[v1.UpdateReplicationResponse], error)
+	DeleteReplication(context.Context, *connect.Request[v1.DeleteReplicationRequest]) (*connect.Response[v1.DeleteReplicationResponse], error)
+	SyncReplication(context.Context, *connect.Request[v1.SyncReplicationRequest]) (*connect.Response[v1.SyncReplicationResponse], error)
+}
+
+// NewReplicationServiceClient constructs a client for the zfsilo.v1.ReplicationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewReplicationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ReplicationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	replicationServiceMethods := v1.File_zfsilo_v1_zfsilo_proto.Services().ByName("ReplicationService").Methods()
+	return &replicationServiceClient{
+		getReplication: connect.NewClient[v1.GetReplicationRequest, v1.GetReplicationResponse](
+			httpClient,
+			baseURL+ReplicationServiceGetReplicationProcedure,
+			connect.WithSchema(replicationServiceMethods.ByName("GetReplication")),
+			connect.WithClientOptions(opts...),
+		),
+		listReplications: connect.NewClient[v1.ListReplicationsRequest, v1.ListReplicationsResponse](
+			httpClient,
+			baseURL+ReplicationServiceListReplicationsProcedure,
+			connect.WithSchema(replicationServiceMethods.ByName("ListReplications")),
+			connect.WithClientOptions(opts...),
+		),
+		createReplication: connect.NewClient[v1.CreateReplicationRequest, v1.CreateReplicationResponse](
+			httpClient,
+			baseURL+ReplicationServiceCreateReplicationProcedure,
+			connect.WithSchema(replicationServiceMethods.ByName("CreateReplication")),
+			connect.WithClientOptions(opts...),
+		),
+		updateReplication: connect.NewClient[v1.UpdateReplicationRequest, v1.UpdateReplicationResponse](
+			httpClient,
+			baseURL+ReplicationServiceUpdateReplicationProcedure,
+			connect.WithSchema(replicationServiceMethods.ByName("UpdateReplication")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteReplication: connect.NewClient[v1.DeleteReplicationRequest, v1.DeleteReplicationResponse](
+			httpClient,
+			baseURL+ReplicationServiceDeleteReplicationProcedure,
+			connect.WithSchema(replicationServiceMethods.ByName("DeleteReplication")),
+			connect.WithClientOptions(opts...),
+		),
+		syncReplication: connect.NewClient[v1.SyncReplicationRequest, v1.SyncReplicationResponse](
+			httpClient,
+			baseURL+ReplicationServiceSyncReplicationProcedure,
+			connect.WithSchema(replicationServiceMethods.ByName("SyncReplication")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// replicationServiceClient implements ReplicationServiceClient.
+type replicationServiceClient struct {
+	getReplication    *connect.Client[v1.GetReplicationRequest, v1.GetReplicationResponse]
+	listReplications  *connect.Client[v1.ListReplicationsRequest, v1.ListReplicationsResponse]
+	createReplication *connect.Client[v1.CreateReplicationRequest, v1.CreateReplicationResponse]
+	updateReplication *connect.Client[v1.UpdateReplicationRequest, v1.UpdateReplicationResponse]
+	deleteReplication *connect.Client[v1.DeleteReplicationRequest, v1.DeleteReplicationResponse]
+	syncReplication   *connect.Client[v1.SyncReplicationRequest, v1.SyncReplicationResponse]
+}
+
+// GetReplication calls zfsilo.v1.ReplicationService.GetReplication.
+func (c *replicationServiceClient) GetReplication(ctx context.Context, req *connect.Request[v1.GetReplicationRequest]) (*connect.Response[v1.GetReplicationResponse], error) {
+	return c.getReplication.CallUnary(ctx, req)
+}
+
+// ListReplications calls zfsilo.v1.ReplicationService.ListReplications.
+func (c *replicationServiceClient) ListReplications(ctx context.Context, req *connect.Request[v1.ListReplicationsRequest]) (*connect.Response[v1.ListReplicationsResponse], error) {
+	return c.listReplications.CallUnary(ctx, req)
+}
+
+// CreateReplication calls zfsilo.v1.ReplicationService.CreateReplication.
+func (c *replicationServiceClient) CreateReplication(ctx context.Context, req *connect.Request[v1.CreateReplicationRequest]) (*connect.Response[v1.CreateReplicationResponse], error) {
+	return c.createReplication.CallUnary(ctx, req)
+}
+
+// UpdateReplication calls zfsilo.v1.ReplicationService.UpdateReplication.
+func (c *replicationServiceClient) UpdateReplication(ctx context.Context, req *connect.Request[v1.UpdateReplicationRequest]) (*connect.Response[v1.UpdateReplicationResponse], error) {
+	return c.updateReplication.CallUnary(ctx, req)
+}
+
+// DeleteReplication calls zfsilo.v1.ReplicationService.DeleteReplication.
+func (c *replicationServiceClient) DeleteReplication(ctx context.Context, req *connect.Request[v1.DeleteReplicationRequest]) (*connect.Response[v1.DeleteReplicationResponse], error) {
+	return c.deleteReplication.CallUnary(ctx, req)
+}
+
+// SyncReplication calls zfsilo.v1.ReplicationService.SyncReplication.
+func (c *replicationServiceClient) SyncReplication(ctx context.Context, req *connect.Request[v1.SyncReplicationRequest]) (*connect.Response[v1.SyncReplicationResponse], error) {
+	return c.syncReplication.CallUnary(ctx, req)
+}
+
+// ReplicationServiceHandler is an implementation of the zfsilo.v1.ReplicationService service.
+type ReplicationServiceHandler interface {
+	GetReplication(context.Context, *connect.Request[v1.GetReplicationRequest]) (*connect.Response[v1.GetReplicationResponse], error)
+	ListReplications(context.Context, *connect.Request[v1.ListReplicationsRequest]) (*connect.Response[v1.ListReplicationsResponse], error)
+	CreateReplication(context.Context, *connect.Request[v1.CreateReplicationRequest]) (*connect.Response[v1.CreateReplicationResponse], error)
+	UpdateReplication(context.Context, *connect.Request[v1.UpdateReplicationRequest]) (*connect.Response[v1.UpdateReplicationResponse], error)
+	DeleteReplication(context.Context, *connect.Request[v1.DeleteReplicationRequest]) (*connect.Response[v1.DeleteReplicationResponse], error)
+	SyncReplication(context.Context, *connect.Request[v1.SyncReplicationRequest]) (*connect.Response[v1.SyncReplicationResponse], error)
+}
+
+// NewReplicationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewReplicationServiceHandler(svc ReplicationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	replicationServiceMethods := v1.File_zfsilo_v1_zfsilo_proto.Services().ByName("ReplicationService").Methods()
+	replicationServiceGetReplicationHandler := connect.NewUnaryHandler(
+		ReplicationServiceGetReplicationProcedure,
+		svc.GetReplication,
+		connect.WithSchema(replicationServiceMethods.ByName("GetReplication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	replicationServiceListReplicationsHandler := connect.NewUnaryHandler(
+		ReplicationServiceListReplicationsProcedure,
+		svc.ListReplications,
+		connect.WithSchema(replicationServiceMethods.ByName("ListReplications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	replicationServiceCreateReplicationHandler := connect.NewUnaryHandler(
+		ReplicationServiceCreateReplicationProcedure,
+		svc.CreateReplication,
+		connect.WithSchema(replicationServiceMethods.ByName("CreateReplication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	replicationServiceUpdateReplicationHandler := connect.NewUnaryHandler(
+		ReplicationServiceUpdateReplicationProcedure,
+		svc.UpdateReplication,
+		connect.WithSchema(replicationServiceMethods.ByName("UpdateReplication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	replicationServiceDeleteReplicationHandler := connect.NewUnaryHandler(
+		ReplicationServiceDeleteReplicationProcedure,
+		svc.DeleteReplication,
+		connect.WithSchema(replicationServiceMethods.ByName("DeleteReplication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	replicationServiceSyncReplicationHandler := connect.NewUnaryHandler(
+		ReplicationServiceSyncReplicationProcedure,
+		svc.SyncReplication,
+		connect.WithSchema(replicationServiceMethods.ByName("SyncReplication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/zfsilo.v1.ReplicationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ReplicationServiceGetReplicationProcedure:
+			replicationServiceGetReplicationHandler.ServeHTTP(w, r)
+		case ReplicationServiceListReplicationsProcedure:
+			replicationServiceListReplicationsHandler.ServeHTTP(w, r)
+		case ReplicationServiceCreateReplicationProcedure:
+			replicationServiceCreateReplicationHandler.ServeHTTP(w, r)
+		case ReplicationServiceUpdateReplicationProcedure:
+			replicationServiceUpdateReplicationHandler.ServeHTTP(w, r)
+		case ReplicationServiceDeleteReplicationProcedure:
+			replicationServiceDeleteReplicationHandler.ServeHTTP(w, r)
+		case ReplicationServiceSyncReplicationProcedure:
+			replicationServiceSyncReplicationHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedReplicationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedReplicationServiceHandler struct{}
+
+func (UnimplementedReplicationServiceHandler) GetReplication(context.Context, *connect.Request[v1.GetReplicationRequest]) (*connect.Response[v1.GetReplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.ReplicationService.GetReplication is not implemented"))
+}
+
+func (UnimplementedReplicationServiceHandler) ListReplications(context.Context, *connect.Request[v1.ListReplicationsRequest]) (*connect.Response[v1.ListReplicationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.ReplicationService.ListReplications is not implemented"))
+}
+
+func (UnimplementedReplicationServiceHandler) CreateReplication(context.Context, *connect.Request[v1.CreateReplicationRequest]) (*connect.Response[v1.CreateReplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.ReplicationService.CreateReplication is not implemented"))
+}
+
+func (UnimplementedReplicationServiceHandler) UpdateReplication(context.Context, *connect.Request[v1.UpdateReplicationRequest]) (*connect.Response[v1.UpdateReplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.ReplicationService.UpdateReplication is not implemented"))
+}
+
+func (UnimplementedReplicationServiceHandler) DeleteReplication(context.Context, *connect.Request[v1.DeleteReplicationRequest]) (*connect.Response[v1.DeleteReplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.ReplicationService.DeleteReplication is not implemented"))
+}
+
+func (UnimplementedReplicationServiceHandler) SyncReplication(context.Context, *connect.Request[v1.SyncReplicationRequest]) (*connect.Response[v1.SyncReplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zfsilo.v1.ReplicationService.SyncReplication is not implemented"))
+}
@@ -5,6 +5,7 @@ package fs
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -82,11 +83,16 @@ func (m FS) ResolveDevice(ctx context.Context, pattern string) (string, error) {
 type FormatArguments struct {
 	Device        string
 	WaitForDevice bool
+	// Type is the filesystem type to format the device with. An empty type is
+	// taken to be ext4.
+	Type string
+	// Options are passed on to mkfs after the options it is always run with.
+	Options []string
 }
 
-// Format executes mkfs.ext4 to format a device.
-// The -F option forces overwrite of any existing filesystem.
-// The -m 0 option reserves 0% of the blocks for the super-user.
+// Format executes mkfs to format a device with a filesystem of the type.
+// The -F and -f options force overwrite of any existing filesystem.
+// The -m 0 option reserves 0% of the blocks of ext4 for the super-user.
 func (m FS) Format(ctx context.Context, args FormatArguments) error {
 	device := args.Device
 	if args.WaitForDevice {
@@ -97,8 +103,23 @@ func (m FS) Format(ctx context.Context, args FormatArguments) error {
 		device = resolved
 	}
 
-	cmd := fmt.Sprintf("mkfs.ext4 -F -m0 '%s'", device)
-	result, err := m.executor.Exec(ctx, cmd)
+	var cmd strings.Builder
+	switch args.Type {
+	case "", "ext4":
+		cmd.WriteString("mkfs.ext4 -F -m0")
+	case "xfs":
+		cmd.WriteString("mkfs.xfs -f")
+	case "btrfs":
+		cmd.WriteString("mkfs.btrfs -f")
+	default:
+		return fmt.Errorf("failed to format device '%s': unsupported filesystem type '%s'", device, args.Type)
+	}
+	for _, option := range args.Options {
+		cmd.WriteString(fmt.Sprintf(" '%s'", option))
+	}
+	cmd.WriteString(fmt.Sprintf(" '%s'", device))
+
+	result, err := m.executor.Exec(ctx, cmd.String())
 	if err != nil {
 		stderr := ""
 		if result != nil {
@@ -125,7 +146,7 @@ func (m FS) GetFSType(ctx context.Context, device string) (string, error) {
 		}
 		return "", fmt.Errorf("failed to get filesystem type for device '%s': %w, stderr: %s", device, err, stderr)
 	}
-	return result.Stdout, nil
+	return strings.TrimSpace(result.Stdout), nil
 }
 
 // ClearArguments represents the arguments for clearing filesystem signatures from a device.
@@ -154,14 +175,113 @@ func (m FS) Clear(ctx context.Context, args ClearArguments) error {
 	return nil
 }
 
+// GetDeviceSize returns the size of a block device in bytes.
+// It uses `blockdev --getsize64`.
+func (m FS) GetDeviceSize(ctx context.Context, device string) (int64, error) {
+	cmd := fmt.Sprintf("blockdev --getsize64 '%s'", device)
+	result, err := m.executor.Exec(ctx, cmd)
+	if err != nil {
+		stderr := ""
+		if result != nil {
+			stderr = result.Stderr
+		}
+		return 0, fmt.Errorf("failed to get size of device '%s': %w, stderr: %s", device, err, stderr)
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(result.Stdout), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse size of device '%s': %w", device, err)
+	}
+	return size, nil
+}
+
+// SetReadOnly marks a block device read-only so that the kernel refuses
+// writes to it, including those of filesystems mounted from it.
+// It uses `blockdev --setro`.
+func (m FS) SetReadOnly(ctx context.Context, device string) error {
+	cmd := fmt.Sprintf("blockdev --setro '%s'", device)
+	result, err := m.executor.Exec(ctx, cmd)
+	if err != nil {
+		stderr := ""
+		if result != nil {
+			stderr = result.Stderr
+		}
+		return fmt.Errorf("failed to set device '%s' read-only: %w, stderr: %s", device, err, stderr)
+	}
+	return nil
+}
+
+// WaitForDeviceSizeArguments represents the arguments for waiting for a device
+// to grow.
+type WaitForDeviceSizeArguments struct {
+	Device       string
+	Size         int64
+	Timeout      time.Duration
+	PollInterval time.Duration
+}
+
+// WaitForDeviceSize waits for a block device to be at least the given size,
+// polling until a timeout is reached. A rescanned device takes a moment to
+// report its new size. It returns the size of the device.
+func (m FS) WaitForDeviceSize(ctx context.Context, args WaitForDeviceSizeArguments) (int64, error) {
+	timeout := args.Timeout
+	if timeout == 0 {
+		timeout = 30 * time.Second // default timeout
+	}
+
+	pollInterval := args.PollInterval
+	if pollInterval == 0 {
+		pollInterval = 500 * time.Millisecond // default interval
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		size, err := m.GetDeviceSize(ctx, args.Device)
+		if err == nil && size >= args.Size {
+			return size, nil
+		}
+		select {
+		case <-ctx.Done():
+			return 0, fmt.Errorf("timed out waiting for device %s to reach %d bytes, last size %d: %w", args.Device, args.Size, size, ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
 // ResizeArguments represents the arguments for resizing a filesystem.
 type ResizeArguments struct {
 	Device string
+	// Type is the filesystem type, as returned by GetFSType. An empty type is
+	// taken to be ext4.
+	Type string
+	// MountPath is where the filesystem is mounted, which is required by
+	// filesystems that can only be grown while mounted.
+	MountPath string
 }
 
-// Resize executes resize2fs to resize a filesystem on a device.
+// Resize grows a filesystem on a device to the size of the device. It executes
+// resize2fs for ext filesystems, and xfs_growfs and btrfs filesystem resize on
+// the mount path for xfs and btrfs.
 func (m FS) Resize(ctx context.Context, args ResizeArguments) error {
-	cmd := fmt.Sprintf("resize2fs '%s'", args.Device)
+	var cmd string
+	switch args.Type {
+	case "", "ext2", "ext3", "ext4":
+		cmd = fmt.Sprintf("resize2fs '%s'", args.Device)
+	case "xfs":
+		if args.MountPath == "" {
+			return fmt.Errorf("failed to resize filesystem on device '%s': xfs must be mounted to be resized", args.Device)
+		}
+		cmd = fmt.Sprintf("xfs_growfs '%s'", args.MountPath)
+	case "btrfs":
+		if args.MountPath == "" {
+			return fmt.Errorf("failed to resize filesystem on device '%s': btrfs must be mounted to be resized", args.Device)
+		}
+		cmd = fmt.Sprintf("btrfs filesystem resize max '%s'", args.MountPath)
+	default:
+		return fmt.Errorf("failed to resize filesystem on device '%s': unsupported filesystem type '%s'", args.Device, args.Type)
+	}
+
 	result, err := m.executor.Exec(ctx, cmd)
 	if err != nil {
 		stderr := ""
@@ -81,3 +81,51 @@ func TestFormat(t *testing.T) {
 	fsType := strings.TrimSpace(result.Stdout)
 	require.Equal(t, "ext4", fsType, "filesystem type should be ext4 after formatting")
 }
+
+func TestResize(t *testing.T) {
+	ctx := context.Background()
+	executor := newTestExecutor(t, giveHostConfig)
+
+	zfsClient := zfs.With(executor)
+	fsClient := fs.With(executor)
+
+	volName := fmt.Sprintf("tank/test-resize-%d", time.Now().UnixNano())
+
+	err := zfsClient.CreateVolume(ctx, zfs.CreateVolumeArguments{Name: volName, Size: 16 * mb})
+	require.NoError(t, err, "failed to create zfs volume for resize test")
+
+	devicePath := fmt.Sprintf("/dev/zvol/%s", volName)
+	defer func() {
+		_ = fsClient.Clear(ctx, fs.ClearArguments{Device: devicePath})
+
+		err := zfsClient.DestroyVolume(ctx, zfs.DestroyVolumeArguments{Name: volName})
+		require.NoError(t, err, "failed to destroy zfs volume")
+	}()
+
+	err = fsClient.Format(ctx, fs.FormatArguments{
+		Device:        devicePath,
+		WaitForDevice: true,
+	})
+	require.NoError(t, err)
+
+	err = zfsClient.SetProperty(ctx, zfs.SetPropertyArguments{
+		Name:          volName,
+		PropertyKey:   "volsize",
+		PropertyValue: fmt.Sprintf("%d", 32*mb),
+	})
+	require.NoError(t, err, "failed to grow zfs volume")
+
+	size, err := fsClient.WaitForDeviceSize(ctx, fs.WaitForDeviceSizeArguments{
+		Device: devicePath,
+		Size:   32 * mb,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(32*mb), size)
+
+	fsType, err := fsClient.GetFSType(ctx, devicePath)
+	require.NoError(t, err)
+	require.Equal(t, "ext4", fsType)
+
+	err = fsClient.Resize(ctx, fs.ResizeArguments{Device: devicePath, Type: fsType})
+	require.NoError(t, err)
+}
@@ -4,7 +4,9 @@ package iscsi
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"net"
 	"strings"
 	"text/template"
 
@@ -31,10 +33,32 @@ func With(executor command.Executor) ISCSI {
 	}
 }
 
+// Serial returns the unit serial of the backstore of a volume. LIO derives
+// the NAA identifier of the LUN from it, which gives the LUN the same stable
+// identity through every portal so that dm-multipath can assemble the paths.
+// The serial is the SHA-256 hash of the VolumeID in the form of a UUID.
+func Serial(volumeID string) string {
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(volumeID)))
+	return fmt.Sprintf("%s-%s-%s-%s-%s", hash[0:8], hash[8:12], hash[12:16], hash[16:20], hash[20:32])
+}
+
+// portal returns the address with the default iSCSI port when it has none, in
+// the form iscsiadm reports sessions with.
+func portal(address string) (string, string) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		host, port = strings.Trim(address, "[]"), "3260"
+	}
+	return host, port
+}
+
 type PublishVolumeArguments struct {
 	VolumeID   string
 	DevicePath string
 	TargetIQN  IQN
+	// Portals are the addresses the target is served on, optionally with a
+	// port. The target is served on every address when empty.
+	Portals []string
 }
 
 var publishVolumeTmpl = genericutil.Must(
@@ -42,10 +66,18 @@ var publishVolumeTmpl = genericutil.Must(
 		stringutil.Multiline(`
 			# Create a backstore with the block device.
 			cd /backstores/block
-			create {{.VolumeID}} {{.DevicePath}}
+			create {{.VolumeID}} {{.DevicePath}} wwn={{.Serial}}
 			# Create the iSCSI target.
 			cd /iscsi
 			create {{.TargetIQN}}
+			{{- if .Portals }}
+			# Replace the default portal with the given ones.
+			cd /iscsi/{{.TargetIQN}}/tpg1/portals
+			delete 0.0.0.0 3260
+			{{- range .Portals }}
+			create {{.Host}} {{.Port}}
+			{{- end }}
+			{{- end }}
 			# Add LUN to the iSCSI target.
 			cd /iscsi/{{.TargetIQN}}/tpg1/luns
 			create /backstores/block/{{.VolumeID}}
@@ -62,8 +94,32 @@ var publishVolumeTmpl = genericutil.Must(
 )
 
 func (i ISCSI) PublishVolume(ctx context.Context, args PublishVolumeArguments) error {
+	type portalTmpl struct {
+		Host string
+		Port string
+	}
+	portals := make([]portalTmpl, 0, len(args.Portals))
+	for _, address := range args.Portals {
+		host, port := portal(address)
+		portals = append(portals, portalTmpl{Host: host, Port: port})
+	}
+
+	argsTmpl := struct {
+		VolumeID   string
+		DevicePath string
+		TargetIQN  IQN
+		Serial     string
+		Portals    []portalTmpl
+	}{
+		VolumeID:   args.VolumeID,
+		DevicePath: args.DevicePath,
+		TargetIQN:  args.TargetIQN,
+		Serial:     Serial(args.VolumeID),
+		Portals:    portals,
+	}
+
 	var buf bytes.Buffer
-	if err := publishVolumeTmpl.Execute(&buf, args); err != nil {
+	if err := publishVolumeTmpl.Execute(&buf, argsTmpl); err != nil {
 		return fmt.Errorf("failed to render publish volume template: %w", err)
 	}
 
@@ -86,6 +142,8 @@ type AuthorizeArguments struct {
 	InitiatorIQN      IQN
 	InitiatorPassword string
 	TargetPassword    string
+	// ReadOnly write protects the LUN for the initiator.
+	ReadOnly bool
 }
 
 var authorizeTmpl = genericutil.Must(
@@ -104,6 +162,12 @@ var authorizeTmpl = genericutil.Must(
 			set auth mutual_userid={{.TargetIQN}}
 			set auth mutual_password={{.TargetPassword}}
 			{{- end }}
+			{{- if .ReadOnly }}
+			# Replace the LUN mapped when the ACL was created with a write
+			# protected one.
+			delete 0
+			create mapped_lun=0 tpg_lun_or_backstore=lun0 write_protect=1
+			{{- end }}
 			# Navigate back to root.
 			cd /
 		`),
@@ -130,6 +194,51 @@ func (i ISCSI) Authorize(ctx context.Context, args AuthorizeArguments) error {
 	return nil
 }
 
+type UpdateAuthorizationArguments struct {
+	TargetIQN         IQN
+	InitiatorIQN      IQN
+	InitiatorPassword string
+	TargetPassword    string
+}
+
+var updateAuthorizationTmpl = genericutil.Must(
+	template.New("update_authorization").Parse(
+		stringutil.Multiline(`
+			# Replace the ACL authentication.
+			cd /iscsi/{{.TargetIQN}}/tpg1/acls/{{.InitiatorIQN}}
+			set auth userid={{.InitiatorIQN}}
+			set auth password={{.InitiatorPassword}}
+			set auth mutual_userid={{.TargetIQN}}
+			set auth mutual_password={{.TargetPassword}}
+			# Navigate back to root.
+			cd /
+		`),
+	),
+)
+
+// UpdateAuthorization replaces the CHAP credentials of the ACL of an
+// authorized initiator. Sessions that are logged in are not affected, as CHAP
+// only applies when the initiator logs in.
+func (i ISCSI) UpdateAuthorization(ctx context.Context, args UpdateAuthorizationArguments) error {
+	var buf bytes.Buffer
+	if err := updateAuthorizationTmpl.Execute(&buf, args); err != nil {
+		return fmt.Errorf("failed to render update authorization template: %w", err)
+	}
+
+	cmd := fmt.Sprintf("echo \"%s\" | targetcli", buf.String())
+
+	result, err := i.executor.Exec(ctx, cmd)
+	if err != nil {
+		stderr := ""
+		if result != nil {
+			stderr = result.Stderr
+		}
+		return fmt.Errorf("failed to update authorization of initiator '%s' for target '%s': %w, stderr: %s", args.InitiatorIQN, args.TargetIQN, err, stderr)
+	}
+
+	return nil
+}
+
 type UnauthorizeArguments struct {
 	TargetIQN    IQN
 	InitiatorIQN IQN
@@ -208,7 +317,9 @@ func (i ISCSI) UnpublishVolume(ctx context.Context, args UnpublishVolumeArgument
 }
 
 type ConnectTargetArguments struct {
-	TargetAddress     string
+	// TargetAddresses are the portals to log in through, each of which adds a
+	// path to the device. Portals that already have a session are skipped.
+	TargetAddresses   []string
 	TargetIQN         IQN
 	TargetPassword    string
 	InitiatorIQN      IQN
@@ -218,26 +329,47 @@ type ConnectTargetArguments struct {
 var connectTargetTmpl = genericutil.Must(
 	template.New("connect_target").Parse(
 		stringutil.Multiline(`
-			( iscsiadm --mode node --targetname '{{.TargetIQN}}' --portal "{{.TargetAddress}}" --op new )
-			{{- if or .InitiatorPassword .TargetPassword }}
-			&& ( iscsiadm --mode node --targetname '{{.TargetIQN}}' --portal "{{.TargetAddress}}" --op update --name node.session.auth.authmethod --value CHAP )
+			{{- range $index, $portal := .Portals }}
+			{{ if $index }}&& {{ end }}( ( iscsiadm --mode session 2>/dev/null | grep -qF ' {{$portal}},1 {{$.TargetIQN}} ' ) || (
+			( iscsiadm --mode node --targetname '{{$.TargetIQN}}' --portal "{{$portal}}" --op new )
+			{{- if or $.InitiatorPassword $.TargetPassword }}
+			&& ( iscsiadm --mode node --targetname '{{$.TargetIQN}}' --portal "{{$portal}}" --op update --name node.session.auth.authmethod --value CHAP )
 			{{- end }}
-			{{- if .InitiatorPassword }}
-			&& ( iscsiadm --mode node --targetname '{{.TargetIQN}}' --portal "{{.TargetAddress}}" --op update --name node.session.auth.username --value '{{.InitiatorIQN}}' )
-			&& ( iscsiadm --mode node --targetname '{{.TargetIQN}}' --portal "{{.TargetAddress}}" --op update --name node.session.auth.password --value '{{.InitiatorPassword}}' )
+			{{- if $.InitiatorPassword }}
+			&& ( iscsiadm --mode node --targetname '{{$.TargetIQN}}' --portal "{{$portal}}" --op update --name node.session.auth.username --value '{{$.InitiatorIQN}}' )
+			&& ( iscsiadm --mode node --targetname '{{$.TargetIQN}}' --portal "{{$portal}}" --op update --name node.session.auth.password --value '{{$.InitiatorPassword}}' )
 			{{- end }}
-			{{- if .TargetPassword }}
-			&& ( iscsiadm --mode node --targetname '{{.TargetIQN}}' --portal "{{.TargetAddress}}" --op update --name node.session.auth.username_in --value '{{.TargetIQN}}' )
-			&& ( iscsiadm --mode node --targetname '{{.TargetIQN}}' --portal "{{.TargetAddress}}" --op update --name node.session.auth.password_in --value '{{.TargetPassword}}' )
+			{{- if $.TargetPassword }}
+			&& ( iscsiadm --mode node --targetname '{{$.TargetIQN}}' --portal "{{$portal}}" --op update --name node.session.auth.username_in --value '{{$.TargetIQN}}' )
+			&& ( iscsiadm --mode node --targetname '{{$.TargetIQN}}' --portal "{{$portal}}" --op update --name node.session.auth.password_in --value '{{$.TargetPassword}}' )
+			{{- end }}
+			&& ( iscsiadm --mode node --targetname '{{$.TargetIQN}}' --portal "{{$portal}}" --login ) ) )
 			{{- end }}
-			&& ( iscsiadm --mode node --targetname '{{.TargetIQN}}' --portal "{{.TargetAddress}}" --login )
 		`),
 	),
 )
 
 func (i ISCSI) ConnectTarget(ctx context.Context, args ConnectTargetArguments) error {
+	if len(args.TargetAddresses) == 0 {
+		return fmt.Errorf("failed to connect target '%s': no target addresses", args.TargetIQN)
+	}
+
+	portals := make([]string, 0, len(args.TargetAddresses))
+	for _, address := range args.TargetAddresses {
+		portals = append(portals, net.JoinHostPort(portal(address)))
+	}
+
+	argsTmpl := struct {
+		ConnectTargetArguments
+
+		Portals []string
+	}{
+		ConnectTargetArguments: args,
+		Portals:                portals,
+	}
+
 	var buf bytes.Buffer
-	if err := connectTargetTmpl.Execute(&buf, args); err != nil {
+	if err := connectTargetTmpl.Execute(&buf, argsTmpl); err != nil {
 		return fmt.Errorf("failed to render connect target template: %w", err)
 	}
 
@@ -255,16 +387,57 @@ func (i ISCSI) ConnectTarget(ctx context.Context, args ConnectTargetArguments) e
 	return nil
 }
 
+type UpdateTargetCredentialsArguments struct {
+	TargetIQN         IQN
+	TargetPassword    string
+	InitiatorIQN      IQN
+	InitiatorPassword string
+}
+
+var updateTargetCredentialsTmpl = genericutil.Must(
+	template.New("update_target_credentials").Parse(
+		stringutil.Multiline(`
+			( iscsiadm --mode node --targetname '{{.TargetIQN}}' --op update --name node.session.auth.authmethod --value CHAP )
+			&& ( iscsiadm --mode node --targetname '{{.TargetIQN}}' --op update --name node.session.auth.username --value '{{.InitiatorIQN}}' )
+			&& ( iscsiadm --mode node --targetname '{{.TargetIQN}}' --op update --name node.session.auth.password --value '{{.InitiatorPassword}}' )
+			&& ( iscsiadm --mode node --targetname '{{.TargetIQN}}' --op update --name node.session.auth.username_in --value '{{.TargetIQN}}' )
+			&& ( iscsiadm --mode node --targetname '{{.TargetIQN}}' --op update --name node.session.auth.password_in --value '{{.TargetPassword}}' )
+		`),
+	),
+)
+
+// UpdateTargetCredentials replaces the CHAP credentials of the node records
+// of every portal of the target. Sessions that are logged in are kept, and
+// log in with the new credentials when they are reestablished.
+func (i ISCSI) UpdateTargetCredentials(ctx context.Context, args UpdateTargetCredentialsArguments) error {
+	var buf bytes.Buffer
+	if err := updateTargetCredentialsTmpl.Execute(&buf, args); err != nil {
+		return fmt.Errorf("failed to render update target credentials template: %w", err)
+	}
+
+	cmd := strings.ReplaceAll(buf.String(), "\n", " ")
+
+	result, err := i.executor.Exec(ctx, cmd)
+	if err != nil {
+		stderr := ""
+		if result != nil {
+			stderr = result.Stderr
+		}
+		return fmt.Errorf("failed to update credentials of target '%s': %w, stderr: %s", args.TargetIQN, err, stderr)
+	}
+
+	return nil
+}
+
 type DisconnectTargetArguments struct {
-	TargetIQN     IQN
-	TargetAddress string
+	TargetIQN IQN
 }
 
 var disconnectTargetTmpl = genericutil.Must(
 	template.New("disconnect_target").Parse(
 		stringutil.Multiline(`
-			( iscsiadm --mode node --targetname '{{.TargetIQN}}' --portal "{{.TargetAddress}}" --logout ) &&
-			( iscsiadm --mode node --targetname '{{.TargetIQN}}' --portal "{{.TargetAddress}}" --op delete )
+			( iscsiadm --mode node --targetname '{{.TargetIQN}}' --logout ) &&
+			( iscsiadm --mode node --targetname '{{.TargetIQN}}' --op delete )
 		`),
 	),
 )
@@ -290,14 +463,20 @@ func (i ISCSI) DisconnectTarget(ctx context.Context, args DisconnectTargetArgume
 }
 
 type RescanTargetArguments struct {
-	TargetIQN     IQN
-	TargetAddress string
+	TargetIQN IQN
+	// MultipathDevice is the optional dm-multipath device the sessions of the
+	// target are assembled into. The map is not grown along with its paths,
+	// so it is resized once they have been rescanned.
+	MultipathDevice string
 }
 
 var rescanTargetTmpl = genericutil.Must(
 	template.New("rescan_target").Parse(
 		stringutil.Multiline(`
-			( iscsiadm --mode node --targetname '{{.TargetIQN}}' --portal "{{.TargetAddress}}" --rescan )
+			( iscsiadm --mode node --targetname '{{.TargetIQN}}' --rescan )
+			{{- if .MultipathDevice }}
+			&& ( multipathd resize map "$(basename "$(readlink -f '{{.MultipathDevice}}')")" )
+			{{- end }}
 		`),
 	),
 )
@@ -241,7 +241,41 @@ func TestConnectAndDisconnectTarget(t *testing.T) {
 	// Connect to target.
 	err = clients.takeIscsi.ConnectTarget(ctx, iscsi.ConnectTargetArguments{
 		TargetIQN:         targetIQN,
-		TargetAddress:     targetEndpoint,
+		TargetAddresses:   []string{targetEndpoint},
+		InitiatorIQN:      initiatorIQN,
+		InitiatorPassword: initiatorPassword,
+		TargetPassword:    targetPassword,
+	})
+	require.NoError(t, err)
+
+	// Rotate the credentials on both sides.
+	initiatorPassword = "rotatedpassword"
+	targetPassword = "rotatedmutualpassword"
+	err = clients.giveIscsi.UpdateAuthorization(ctx, iscsi.UpdateAuthorizationArguments{
+		TargetIQN:         targetIQN,
+		InitiatorIQN:      initiatorIQN,
+		InitiatorPassword: initiatorPassword,
+		TargetPassword:    targetPassword,
+	})
+	require.NoError(t, err)
+	err = clients.takeIscsi.UpdateTargetCredentials(ctx, iscsi.UpdateTargetCredentialsArguments{
+		TargetIQN:         targetIQN,
+		TargetPassword:    targetPassword,
+		InitiatorIQN:      initiatorIQN,
+		InitiatorPassword: initiatorPassword,
+	})
+	require.NoError(t, err)
+
+	// Disconnect from target.
+	err = clients.takeIscsi.DisconnectTarget(ctx, iscsi.DisconnectTargetArguments{
+		TargetIQN: targetIQN,
+	})
+	require.NoError(t, err)
+
+	// Connect to target with the rotated credentials.
+	err = clients.takeIscsi.ConnectTarget(ctx, iscsi.ConnectTargetArguments{
+		TargetIQN:         targetIQN,
+		TargetAddresses:   []string{targetEndpoint},
 		InitiatorIQN:      initiatorIQN,
 		InitiatorPassword: initiatorPassword,
 		TargetPassword:    targetPassword,
@@ -250,8 +284,7 @@ func TestConnectAndDisconnectTarget(t *testing.T) {
 
 	// Disconnect from target.
 	err = clients.takeIscsi.DisconnectTarget(ctx, iscsi.DisconnectTargetArguments{
-		TargetIQN:     targetIQN,
-		TargetAddress: targetEndpoint,
+		TargetIQN: targetIQN,
 	})
 	require.NoError(t, err)
 }
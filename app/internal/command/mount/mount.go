@@ -58,11 +58,17 @@ func (m Mount) Mount(ctx context.Context, args MountArguments) error {
 // UmountArguments represents the arguments for an umount operation.
 type UmountArguments struct {
 	Path string
+	// Lazy detaches the mount right away and cleans up once it is no longer
+	// busy, which lets a mount backed by an unreachable device be removed.
+	Lazy bool
 }
 
 // Umount executes the umount command.
 func (m Mount) Umount(ctx context.Context, args UmountArguments) error {
 	cmd := fmt.Sprintf("umount '%s'", args.Path)
+	if args.Lazy {
+		cmd = fmt.Sprintf("umount -l '%s'", args.Path)
+	}
 	result, err := m.executor.Exec(ctx, cmd)
 	if err != nil {
 		stderr := ""
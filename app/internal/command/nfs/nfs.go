@@ -0,0 +1,164 @@
+// Package nfs contains lib/command wrappers for executing and working with NFS.
+package nfs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jovulic/zfsilo/lib/command"
+)
+
+// exportsDir is the directory exportfs reads additional exports from. Only
+// files with the .exports extension are read.
+const exportsDir = "/etc/exports.d"
+
+// defaultExportOptions are the options a path is exported with when none are
+// given. Root is not squashed so that containers running as root keep
+// ownership of what they write.
+var defaultExportOptions = []string{"rw", "sync", "no_subtree_check", "no_root_squash"}
+
+// ExportsFile returns the path of the file holding the export of a volume.
+func ExportsFile(volumeID string) string {
+	return fmt.Sprintf("%s/zfsilo-%s.exports", exportsDir, volumeID)
+}
+
+// BuildExport returns the exports(5) entry exporting the path to each of the
+// clients with the options. Clients that are also read-only clients are
+// exported with writes refused.
+func BuildExport(path string, clients []string, readOnlyClients []string, options []string) string {
+	if len(options) == 0 {
+		options = defaultExportOptions
+	}
+	readOnlyOptions := readOnly(options)
+
+	var entry strings.Builder
+	entry.WriteString(fmt.Sprintf("\"%s\"", path))
+	for _, client := range clients {
+		clientOptions := options
+		if slices.Contains(readOnlyClients, client) {
+			clientOptions = readOnlyOptions
+		}
+		entry.WriteString(fmt.Sprintf(" %s(%s)", client, strings.Join(clientOptions, ",")))
+	}
+	return entry.String()
+}
+
+// readOnly returns the export options with rw replaced by ro.
+func readOnly(options []string) []string {
+	readOnlyOptions := []string{"ro"}
+	for _, option := range options {
+		if option == "rw" || option == "ro" {
+			continue
+		}
+		readOnlyOptions = append(readOnlyOptions, option)
+	}
+	return readOnlyOptions
+}
+
+// MountSource returns the source of a mount of the path exported by the
+// server at the address.
+func MountSource(address string, path string) string {
+	if strings.Contains(address, ":") {
+		// IPv6 addresses are bracketed to separate them from the path.
+		return fmt.Sprintf("[%s]:%s", address, path)
+	}
+	return fmt.Sprintf("%s:%s", address, path)
+}
+
+// NFS provides an interface for interacting with NFS.
+type NFS struct {
+	executor command.Executor
+}
+
+// With creates a new NFS instance.
+func With(executor command.Executor) NFS {
+	return NFS{
+		executor: executor,
+	}
+}
+
+// ExportArguments represents the arguments for exporting a path.
+type ExportArguments struct {
+	VolumeID string
+	Path     string
+	Clients  []string
+	// ReadOnlyClients are the clients, among the clients, that are refused
+	// writes.
+	ReadOnlyClients []string
+	Options         []string
+}
+
+// Export exports the path to the clients, replacing any previous export of the
+// volume. An export without clients is open to any host, so exporting to no
+// clients removes the export instead.
+//
+// exportfs -r.
+func (n NFS) Export(ctx context.Context, args ExportArguments) error {
+	if len(args.Clients) == 0 {
+		return n.Unexport(ctx, UnexportArguments{VolumeID: args.VolumeID})
+	}
+
+	cmd := fmt.Sprintf(
+		"mkdir -p '%s' && echo '%s' > '%s' && exportfs -r",
+		exportsDir,
+		BuildExport(args.Path, args.Clients, args.ReadOnlyClients, args.Options),
+		ExportsFile(args.VolumeID),
+	)
+
+	result, err := n.executor.Exec(ctx, cmd)
+	if err != nil {
+		stderr := ""
+		if result != nil {
+			stderr = result.Stderr
+		}
+		return fmt.Errorf("failed to export '%s': %w, stderr: %s", args.Path, err, stderr)
+	}
+	return nil
+}
+
+// UnexportArguments represents the arguments for removing an export.
+type UnexportArguments struct {
+	VolumeID string
+}
+
+// Unexport removes the export of the volume. It succeeds if the volume is not
+// exported.
+//
+// exportfs -r.
+func (n NFS) Unexport(ctx context.Context, args UnexportArguments) error {
+	cmd := fmt.Sprintf("rm -f '%s' && exportfs -r", ExportsFile(args.VolumeID))
+
+	result, err := n.executor.Exec(ctx, cmd)
+	if err != nil {
+		stderr := ""
+		if result != nil {
+			stderr = result.Stderr
+		}
+		return fmt.Errorf("failed to unexport volume '%s': %w, stderr: %s", args.VolumeID, err, stderr)
+	}
+	return nil
+}
+
+// GetExportArguments represents the arguments for getting an export.
+type GetExportArguments struct {
+	VolumeID string
+}
+
+// GetExport returns the exports entry of the volume, or an empty string when
+// the volume is not exported.
+func (n NFS) GetExport(ctx context.Context, args GetExportArguments) (string, error) {
+	file := ExportsFile(args.VolumeID)
+	cmd := fmt.Sprintf("if [ -f '%s' ]; then cat '%s'; fi", file, file)
+
+	result, err := n.executor.Exec(ctx, cmd)
+	if err != nil {
+		stderr := ""
+		if result != nil {
+			stderr = result.Stderr
+		}
+		return "", fmt.Errorf("failed to get export of volume '%s': %w, stderr: %s", args.VolumeID, err, stderr)
+	}
+	return strings.TrimSpace(result.Stdout), nil
+}
@@ -0,0 +1,122 @@
+package nfs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jovulic/zfsilo/app/internal/command/nfs"
+	"github.com/jovulic/zfsilo/lib/command"
+	"github.com/stretchr/testify/require"
+)
+
+var giveHostConfig = command.RemoteExecutorConfig{
+	Address:  "127.0.0.1",
+	Port:     9000,
+	Username: "root",
+	Password: "",
+}
+
+func newTestExecutor(t *testing.T, config command.RemoteExecutorConfig) command.Executor {
+	if testing.Short() {
+		t.Skip("skipping test that requires remote executor in short mode")
+	}
+
+	executor := command.NewRemoteExecutor(config)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := executor.Startup(ctx); err != nil {
+		t.Fatalf("failed to start remote executor for %s:%d: %v", config.Address, config.Port, err)
+	}
+
+	t.Cleanup(func() {
+		executor.Shutdown(context.Background())
+	})
+
+	return executor
+}
+
+func TestBuildExport(t *testing.T) {
+	tests := []struct {
+		name            string
+		path            string
+		clients         []string
+		readOnlyClients []string
+		options         []string
+		want            string
+	}{
+		{
+			name:    "default options",
+			path:    "/tank/vol",
+			clients: []string{"10.0.0.1"},
+			want:    `"/tank/vol" 10.0.0.1(rw,sync,no_subtree_check,no_root_squash)`,
+		},
+		{
+			name:    "multiple clients",
+			path:    "/tank/vol",
+			clients: []string{"10.0.0.1", "take"},
+			options: []string{"ro"},
+			want:    `"/tank/vol" 10.0.0.1(ro) take(ro)`,
+		},
+		{
+			name:            "read-only clients",
+			path:            "/tank/vol",
+			clients:         []string{"10.0.0.1", "take"},
+			readOnlyClients: []string{"take"},
+			want:            `"/tank/vol" 10.0.0.1(rw,sync,no_subtree_check,no_root_squash) take(ro,sync,no_subtree_check,no_root_squash)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, nfs.BuildExport(tt.path, tt.clients, tt.readOnlyClients, tt.options))
+		})
+	}
+}
+
+func TestMountSource(t *testing.T) {
+	require.Equal(t, "give:/tank/vol", nfs.MountSource("give", "/tank/vol"))
+	require.Equal(t, "10.0.0.1:/tank/vol", nfs.MountSource("10.0.0.1", "/tank/vol"))
+	require.Equal(t, "[fd00::1]:/tank/vol", nfs.MountSource("fd00::1", "/tank/vol"))
+}
+
+func TestExportAndUnexport(t *testing.T) {
+	ctx := context.Background()
+	executor := newTestExecutor(t, giveHostConfig)
+	client := nfs.With(executor)
+
+	volumeID := fmt.Sprintf("vol_test-%d", time.Now().UnixNano())
+	path := fmt.Sprintf("/tmp/nfs-test-%d", time.Now().UnixNano())
+
+	_, err := executor.Exec(ctx, fmt.Sprintf("mkdir -p %s", path))
+	require.NoError(t, err)
+
+	// Cleanup.
+	defer func() {
+		_ = client.Unexport(ctx, nfs.UnexportArguments{VolumeID: volumeID})
+		_, _ = executor.Exec(ctx, fmt.Sprintf("rm -rf %s", path))
+	}()
+
+	err = client.Export(ctx, nfs.ExportArguments{
+		VolumeID: volumeID,
+		Path:     path,
+		Clients:  []string{"127.0.0.1"},
+	})
+	require.NoError(t, err)
+
+	entry, err := client.GetExport(ctx, nfs.GetExportArguments{VolumeID: volumeID})
+	require.NoError(t, err)
+	require.Equal(t, nfs.BuildExport(path, []string{"127.0.0.1"}, nil, nil), entry)
+
+	// Exporting to no clients removes the export.
+	err = client.Export(ctx, nfs.ExportArguments{
+		VolumeID: volumeID,
+		Path:     path,
+	})
+	require.NoError(t, err)
+
+	entry, err = client.GetExport(ctx, nfs.GetExportArguments{VolumeID: volumeID})
+	require.NoError(t, err)
+	require.Empty(t, entry)
+}
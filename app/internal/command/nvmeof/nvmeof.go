@@ -4,11 +4,14 @@ package nvmeof
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/binary"
 	"fmt"
 	"hash/crc32"
+	"net"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -26,17 +29,33 @@ func GenerateDHCHAPKey(password string) string {
 		return ""
 	}
 	hash := sha256.Sum256([]byte(password))
+	return fmt.Sprintf("DHHC-1:00:%s:", encodeSecret(hash[:]))
+}
+
+// GenerateTLSKey generates a new NVMe/TCP TLS pre-shared key in the PSK
+// interchange format. The key is a configured PSK of 32 random bytes, from
+// which the retained PSK for a host and subsystem is derived when the key is
+// inserted into a keyring.
+func GenerateTLSKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("failed to generate tls key: %w", err)
+	}
+	// The hash identifier 01 selects SHA-256 to derive the retained PSK.
+	return fmt.Sprintf("NVMeTLSkey-1:01:%s:", encodeSecret(key)), nil
+}
 
-	// The DHHC-1 format requires a 4-byte CRC32 (IEEE) appended to the key. The
-	// CRC is calculated over the key bytes and stored in little-endian.
-	crc := crc32.ChecksumIEEE(hash[:])
+// encodeSecret encodes a 32 byte secret as in the DHHC-1 and PSK interchange
+// formats. Both require a 4-byte CRC32 (IEEE) appended to the secret. The CRC
+// is calculated over the secret bytes and stored in little-endian.
+func encodeSecret(secret []byte) string {
+	crc := crc32.ChecksumIEEE(secret)
 
-	data := make([]byte, 36)
-	copy(data, hash[:])
-	binary.LittleEndian.PutUint32(data[32:], crc)
+	data := make([]byte, len(secret)+4)
+	copy(data, secret)
+	binary.LittleEndian.PutUint32(data[len(secret):], crc)
 
-	b64 := base64.StdEncoding.EncodeToString(data)
-	return fmt.Sprintf("DHHC-1:00:%s:", b64)
+	return base64.StdEncoding.EncodeToString(data)
 }
 
 type NQN string
@@ -45,6 +64,56 @@ func (val NQN) String() string {
 	return string(val)
 }
 
+// HostID returns the host identifier an initiator connects with under the
+// NQN. The kernel refuses to connect under two NQNs with the same host
+// identifier, so an initiator that connects under an NQN other than that of
+// its host is given an identifier of its own. It is derived from the NQN, as
+// a UUID in the name-based SHA-256 form, so that it stays the same across
+// connections.
+func HostID(nqn NQN) string {
+	hash := sha256.Sum256([]byte(nqn))
+	id := hash[:16]
+	id[6] = (id[6] & 0x0f) | 0x80 // version 8
+	id[8] = (id[8] & 0x3f) | 0x80 // variant 10
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:16])
+}
+
+// splitAddress returns the host and port of the address, with the default
+// NVMe/TCP port when it has none.
+func splitAddress(address string) (string, string) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		host, port = strings.Trim(address, "[]"), "4420"
+	}
+	return host, port
+}
+
+// Port is an nvmet port subsystems are served on over TCP.
+type Port struct {
+	ID        int
+	Address   string // an unspecified address listens on every address
+	ServiceID int
+	// TLS requires hosts to connect over TLS 1.3 with a pre-shared key.
+	TLS bool
+}
+
+// secureChannel returns the secure channel requirement of the port in the
+// form nvmet reports it.
+func (p Port) secureChannel() string {
+	if p.TLS {
+		return " tls1.3"
+	}
+	return ""
+}
+
+// Family returns the address family of the port.
+func (p Port) Family() string {
+	if ip := net.ParseIP(p.Address); ip != nil && ip.To4() == nil {
+		return "ipv6"
+	}
+	return "ipv4"
+}
+
 // NVMeOF provides an interface for interacting with NVMe-oF.
 type NVMeOF struct {
 	executor command.Executor
@@ -61,6 +130,8 @@ type PublishVolumeArguments struct {
 	VolumeID   string
 	DevicePath string
 	TargetNQN  NQN
+	// Ports are the ports the subsystem is bound to.
+	Ports []Port
 }
 
 var publishVolumeTmpl = genericutil.Must(
@@ -79,18 +150,6 @@ var publishVolumeTmpl = genericutil.Must(
 			cd 1
 			set device path={{.DevicePath}}
 			enable
-			# Create a port if it doesn't exist, and configure it
-			cd /ports
-			# Using create will navigate into the port if it exists or create it.
-			create 1
-			cd /ports/1
-			set addr trtype=tcp
-			set addr traddr=0.0.0.0
-			set addr trsvcid=4420
-			set addr adrfam=ipv4
-			# Bind the subsystem to the port
-			cd /ports/1/subsystems
-			create {{.TargetNQN}}
 			# Navigate back to root
 			cd /
 		`),
@@ -129,9 +188,133 @@ func (n NVMeOF) PublishVolume(ctx context.Context, args PublishVolumeArguments)
 		return fmt.Errorf("failed to publish volume '%s': %w, stderr: %s", args.VolumeID, err, stderr)
 	}
 
+	for _, port := range args.Ports {
+		err := n.BindPort(ctx, BindPortArguments{
+			TargetNQN: args.TargetNQN,
+			Port:      port,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type BindPortArguments struct {
+	TargetNQN NQN
+	Port      Port
+}
+
+// BindPort serves the subsystem on the port, creating the port when it does
+// not exist. A port that exists with another address belongs to something
+// else on the host, so it is left as is and the binding fails.
+func (n NVMeOF) BindPort(ctx context.Context, args BindPortArguments) error {
+	// The port is set up using direct configfs (sysfs) commands, as nvmetcli
+	// cannot create a port only when it does not exist.
+	cmd := fmt.Sprintf(
+		stringutil.Multiline(`
+			P=/sys/kernel/config/nvmet/ports/%[1]d &&
+			if [ ! -d $P ]; then
+			mkdir $P &&
+			echo tcp > $P/addr_trtype &&
+			echo %[2]s > $P/addr_adrfam &&
+			echo '%[3]s' > $P/addr_traddr &&
+			echo %[4]d > $P/addr_trsvcid &&
+			if [ -n '%[6]s' ]; then
+			echo tls1.3 > $P/addr_tsas &&
+			echo required > $P/addr_treq;
+			fi;
+			fi &&
+			if [ "$(cat $P/addr_trtype) $(cat $P/addr_adrfam) $(cat $P/addr_traddr) $(cat $P/addr_trsvcid)$(grep -qx tls1.3 $P/addr_tsas 2>/dev/null && echo ' tls1.3')" != 'tcp %[2]s %[3]s %[4]d%[6]s' ]; then
+			echo 'port %[1]d exists with another address' >&2; false;
+			fi &&
+			if [ ! -e $P/subsystems/%[5]s ]; then
+			ln -s /sys/kernel/config/nvmet/subsystems/%[5]s $P/subsystems/%[5]s;
+			fi
+		`),
+		args.Port.ID, args.Port.Family(), args.Port.Address, args.Port.ServiceID, args.TargetNQN, args.Port.secureChannel(),
+	)
+
+	result, err := n.executor.Exec(ctx, cmd)
+	if err != nil {
+		stderr := ""
+		if result != nil {
+			stderr = result.Stderr
+		}
+		return fmt.Errorf("failed to bind target '%s' to port %d: %w, stderr: %s", args.TargetNQN, args.Port.ID, err, stderr)
+	}
+
+	return nil
+}
+
+type UnbindPortArguments struct {
+	TargetNQN NQN
+	PortID    int
+}
+
+// UnbindPort stops serving the subsystem on the port. The port itself is left
+// in place, as other subsystems or tooling on the host may use it.
+func (n NVMeOF) UnbindPort(ctx context.Context, args UnbindPortArguments) error {
+	cmd := fmt.Sprintf("rm -f /sys/kernel/config/nvmet/ports/%d/subsystems/%s", args.PortID, args.TargetNQN)
+
+	result, err := n.executor.Exec(ctx, cmd)
+	if err != nil {
+		stderr := ""
+		if result != nil {
+			stderr = result.Stderr
+		}
+		return fmt.Errorf("failed to unbind target '%s' from port %d: %w, stderr: %s", args.TargetNQN, args.PortID, err, stderr)
+	}
+
 	return nil
 }
 
+type ListPortsArguments struct {
+	TargetNQN NQN
+}
+
+// ListPorts returns the ports the subsystem is bound to.
+func (n NVMeOF) ListPorts(ctx context.Context, args ListPortsArguments) ([]Port, error) {
+	cmd := fmt.Sprintf(
+		stringutil.Multiline(`
+			for P in /sys/kernel/config/nvmet/ports/*; do
+			if [ -e $P/subsystems/%s ]; then
+			echo "$(basename $P) $(cat $P/addr_traddr) $(cat $P/addr_trsvcid)$(grep -qx tls1.3 $P/addr_tsas 2>/dev/null && echo ' tls1.3')";
+			fi;
+			done
+		`),
+		args.TargetNQN,
+	)
+
+	result, err := n.executor.Exec(ctx, cmd)
+	if err != nil {
+		stderr := ""
+		if result != nil {
+			stderr = result.Stderr
+		}
+		return nil, fmt.Errorf("failed to list ports of target '%s': %w, stderr: %s", args.TargetNQN, err, stderr)
+	}
+
+	var ports []Port
+	for _, line := range strings.Split(strings.TrimSpace(result.Stdout), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			continue
+		}
+		id, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse port id '%s': %w", fields[0], err)
+		}
+		serviceID, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse service id '%s' of port %d: %w", fields[2], id, err)
+		}
+		ports = append(ports, Port{ID: id, Address: fields[1], ServiceID: serviceID, TLS: len(fields) > 3})
+	}
+	return ports, nil
+}
+
 type UnpublishVolumeArguments struct {
 	TargetNQN NQN
 }
@@ -139,9 +322,6 @@ type UnpublishVolumeArguments struct {
 var unpublishVolumeTmpl = genericutil.Must(
 	template.New("unpublish_volume").Parse(
 		stringutil.Multiline(`
-			# Unbind the subsystem from the port
-			cd /ports/1/subsystems
-			delete {{.TargetNQN}}
 			# Delete the subsystem (which will also delete its namespaces)
 			cd /subsystems
 			delete {{.TargetNQN}}
@@ -157,7 +337,12 @@ func (n NVMeOF) UnpublishVolume(ctx context.Context, args UnpublishVolumeArgumen
 		return fmt.Errorf("failed to render unpublish volume template: %w", err)
 	}
 
-	cmd := fmt.Sprintf("echo \"%s\" | nvmetcli", buf.String())
+	// The subsystem is unbound from every port it is served on before it is
+	// deleted.
+	cmd := fmt.Sprintf(
+		"rm -f /sys/kernel/config/nvmet/ports/*/subsystems/%s && echo \"%s\" | nvmetcli",
+		args.TargetNQN, buf.String(),
+	)
 
 	result, err := n.executor.Exec(ctx, cmd)
 	if err != nil {
@@ -176,6 +361,8 @@ type AuthorizeArguments struct {
 	InitiatorNQN      NQN
 	InitiatorPassword string // DH-HMAC-CHAP key
 	TargetPassword    string // Optional mutual auth DH-HMAC-CHAP key
+	// TLSKey is the optional TLS pre-shared key the initiator connects with.
+	TLSKey string
 }
 
 func (n NVMeOF) Authorize(ctx context.Context, args AuthorizeArguments) error {
@@ -198,6 +385,12 @@ func (n NVMeOF) Authorize(ctx context.Context, args AuthorizeArguments) error {
 		cmd += fmt.Sprintf(" && echo '%s' > /sys/kernel/config/nvmet/hosts/%s/dhchap_ctrl_key", targetPass, args.InitiatorNQN)
 	}
 
+	// The retained PSK derived for the host and subsystem is inserted into the
+	// .nvme keyring, where the TLS handshake looks it up.
+	if args.TLSKey != "" {
+		cmd += fmt.Sprintf(" && nvme check-tls-key --keydata='%s' --hostnqn='%s' --subsysnqn='%s' --insert > /dev/null", args.TLSKey, args.InitiatorNQN, args.TargetNQN)
+	}
+
 	result, err := n.executor.Exec(ctx, cmd)
 	if err != nil {
 		stderr := ""
@@ -238,41 +431,67 @@ func (n NVMeOF) Unauthorize(ctx context.Context, args UnauthorizeArguments) erro
 }
 
 type ConnectTargetArguments struct {
-	TargetNQN         NQN
-	TargetAddress     string
-	InitiatorNQN      NQN
+	TargetNQN NQN
+	// TargetAddresses are the addresses to connect through, each of which adds
+	// a path to the namespace. Addresses that are already connected are
+	// skipped.
+	TargetAddresses []string
+	InitiatorNQN    NQN
+	// HostID is the optional host identifier to connect with, which must be
+	// given when the initiator NQN is not that of the host.
+	HostID            string
 	InitiatorPassword string // optional
 	TargetPassword    string // optional
+	// TLSKey is the optional TLS pre-shared key to connect over TLS with. It
+	// is inserted into the keyring before connecting.
+	TLSKey string
 }
 
 var connectTargetTmpl = genericutil.Must(
 	template.New("connect_target").Parse(
 		stringutil.Multiline(`
-			( nvme connect -t tcp -n '{{.TargetNQN}}' -a "{{.Host}}" -s '{{.Port}}' -q '{{.InitiatorNQN}}' {{if .InitiatorPassword}}-S '{{.InitiatorPassword}}'{{end}} {{if .TargetPassword}}-C '{{.TargetPassword}}'{{end}} )
+			{{- if .TLSKey }}
+			( nvme check-tls-key --keydata='{{.TLSKey}}' --hostnqn='{{.InitiatorNQN}}' --subsysnqn='{{.TargetNQN}}' --insert > /dev/null ) &&
+			{{- end }}
+			{{- range $index, $address := .Addresses }}
+			{{ if $index }}&& {{ end }}( ( nvme list-subsys -n '{{$.TargetNQN}}' 2>/dev/null | grep -qE 'traddr={{$address.Host}}[ ,]trsvcid={{$address.Port}}' ) ||
+			( nvme connect -t tcp -n '{{$.TargetNQN}}' -a "{{$address.Host}}" -s '{{$address.Port}}' -q '{{$.InitiatorNQN}}' {{if $.HostID}}-I '{{$.HostID}}'{{end}} {{if $.InitiatorPassword}}-S '{{$.InitiatorPassword}}'{{end}} {{if $.TargetPassword}}-C '{{$.TargetPassword}}'{{end}} {{if $.TLSKey}}--tls{{end}} ) )
+			{{- end }}
 		`),
 	),
 )
 
 func (n NVMeOF) ConnectTarget(ctx context.Context, args ConnectTargetArguments) error {
-	host, port, _ := strings.Cut(args.TargetAddress, ":")
-	if port == "" {
-		port = "4420"
+	if len(args.TargetAddresses) == 0 {
+		return fmt.Errorf("failed to connect target '%s': no target addresses", args.TargetNQN)
+	}
+
+	type addressTmpl struct {
+		Host string
+		Port string
+	}
+	addresses := make([]addressTmpl, 0, len(args.TargetAddresses))
+	for _, address := range args.TargetAddresses {
+		host, port := splitAddress(address)
+		addresses = append(addresses, addressTmpl{Host: host, Port: port})
 	}
 
 	argsTmpl := struct {
 		TargetNQN         NQN
-		Host              string
-		Port              string
+		Addresses         []addressTmpl
 		InitiatorNQN      NQN
+		HostID            string
 		InitiatorPassword string
 		TargetPassword    string
+		TLSKey            string
 	}{
 		TargetNQN:         args.TargetNQN,
-		Host:              host,
-		Port:              port,
+		Addresses:         addresses,
 		InitiatorNQN:      args.InitiatorNQN,
+		HostID:            args.HostID,
 		InitiatorPassword: GenerateDHCHAPKey(args.InitiatorPassword),
 		TargetPassword:    GenerateDHCHAPKey(args.TargetPassword),
+		TLSKey:            args.TLSKey,
 	}
 
 	var buf bytes.Buffer
@@ -294,6 +513,43 @@ func (n NVMeOF) ConnectTarget(ctx context.Context, args ConnectTargetArguments)
 	return nil
 }
 
+type UpdateTargetCredentialsArguments struct {
+	TargetNQN         NQN
+	InitiatorPassword string
+	TargetPassword    string // optional
+}
+
+// UpdateTargetCredentials replaces the DH-HMAC-CHAP keys of the controllers
+// connected to the target. A controller reauthenticates with its new keys,
+// and reconnects with them should that fail, so it stays connected.
+func (n NVMeOF) UpdateTargetCredentials(ctx context.Context, args UpdateTargetCredentialsArguments) error {
+	initiatorPass := GenerateDHCHAPKey(args.InitiatorPassword)
+	targetPass := GenerateDHCHAPKey(args.TargetPassword)
+
+	cmd := fmt.Sprintf(
+		stringutil.Multiline(`
+			for C in /sys/class/nvme/nvme*; do
+			if [ "$(cat $C/subsysnqn 2>/dev/null)" = '%[1]s' ]; then
+			{ [ -z '%[3]s' ] || echo '%[3]s' > $C/dhchap_ctrl_secret; } &&
+			echo '%[2]s' > $C/dhchap_secret || exit 1;
+			fi;
+			done
+		`),
+		args.TargetNQN, initiatorPass, targetPass,
+	)
+
+	result, err := n.executor.Exec(ctx, cmd)
+	if err != nil {
+		stderr := ""
+		if result != nil {
+			stderr = result.Stderr
+		}
+		return fmt.Errorf("failed to update credentials of target '%s' via sysfs: %w, stderr: %s", args.TargetNQN, err, stderr)
+	}
+
+	return nil
+}
+
 type DisconnectTargetArguments struct {
 	TargetNQN NQN
 }
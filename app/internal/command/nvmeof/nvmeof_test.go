@@ -174,6 +174,7 @@ func TestPublishAndUnpublishVolume(t *testing.T) {
 		VolumeID:   "test-nvme-pub-unpub",
 		DevicePath: devPath,
 		TargetNQN:  targetNQN,
+		Ports:      []nvmeof.Port{{ID: 1, Address: "0.0.0.0", ServiceID: 4420}},
 	})
 	require.NoError(t, err)
 
@@ -184,6 +185,66 @@ func TestPublishAndUnpublishVolume(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestBindAndUnbindPort(t *testing.T) {
+	ctx := context.Background()
+
+	clients := newTestClients(t)
+
+	volName := "tank/test-nvme-bind-unbind"
+	devPath := fmt.Sprintf("/dev/zvol/%s", volName)
+	targetNQN := nvmeof.NQN("nqn.2014-08.org.nvmexpress:give:test-nvme-bind-unbind")
+	port := nvmeof.Port{ID: 1, Address: "0.0.0.0", ServiceID: 4420}
+
+	// Create ZFS volume.
+	err := clients.giveZfs.CreateVolume(ctx, zfs.CreateVolumeArguments{Name: volName, Size: mb})
+	require.NoError(t, err)
+
+	defer func() {
+		// Cleanup ZFS volume.
+		err := clients.giveZfs.DestroyVolume(ctx, zfs.DestroyVolumeArguments{Name: volName})
+		require.NoError(t, err, "zfs volume cleanup failed")
+	}()
+
+	// Publish volume.
+	err = clients.giveNVMeOF.PublishVolume(ctx, nvmeof.PublishVolumeArguments{
+		VolumeID:   "test-nvme-bind-unbind",
+		DevicePath: devPath,
+		TargetNQN:  targetNQN,
+		Ports:      []nvmeof.Port{port},
+	})
+	require.NoError(t, err)
+
+	defer func() {
+		// Cleanup NVMe publish.
+		err := clients.giveNVMeOF.UnpublishVolume(ctx, nvmeof.UnpublishVolumeArguments{
+			TargetNQN: targetNQN,
+		})
+		require.NoError(t, err, "nvmeof unpublish cleanup failed")
+	}()
+
+	ports, err := clients.giveNVMeOF.ListPorts(ctx, nvmeof.ListPortsArguments{TargetNQN: targetNQN})
+	require.NoError(t, err)
+	require.Equal(t, []nvmeof.Port{port}, ports)
+
+	// Binding to an existing port with another address fails.
+	err = clients.giveNVMeOF.BindPort(ctx, nvmeof.BindPortArguments{
+		TargetNQN: targetNQN,
+		Port:      nvmeof.Port{ID: 1, Address: "0.0.0.0", ServiceID: 4421},
+	})
+	require.Error(t, err)
+
+	// Unbind port.
+	err = clients.giveNVMeOF.UnbindPort(ctx, nvmeof.UnbindPortArguments{
+		TargetNQN: targetNQN,
+		PortID:    port.ID,
+	})
+	require.NoError(t, err)
+
+	ports, err = clients.giveNVMeOF.ListPorts(ctx, nvmeof.ListPortsArguments{TargetNQN: targetNQN})
+	require.NoError(t, err)
+	require.Empty(t, ports)
+}
+
 func TestConnectAndDisconnectTarget(t *testing.T) {
 	ctx := context.Background()
 
@@ -213,6 +274,7 @@ func TestConnectAndDisconnectTarget(t *testing.T) {
 		VolumeID:   volIdentifier,
 		DevicePath: devPath,
 		TargetNQN:  targetNQN,
+		Ports:      []nvmeof.Port{{ID: 1, Address: "0.0.0.0", ServiceID: 4420}},
 	})
 	require.NoError(t, err)
 
@@ -245,12 +307,29 @@ func TestConnectAndDisconnectTarget(t *testing.T) {
 	// Connect to target.
 	err = clients.takeNVMeOF.ConnectTarget(ctx, nvmeof.ConnectTargetArguments{
 		TargetNQN:         targetNQN,
-		TargetAddress:     targetEndpoint,
+		TargetAddresses:   []string{targetEndpoint},
+		InitiatorNQN:      initiatorNQN,
+		InitiatorPassword: initiatorPassword,
+		TargetPassword:    targetPassword,
+	})
+	require.NoError(t, err)
+
+	// Rotate the keys on both sides while connected.
+	initiatorPassword = "rotated-initiator-pass"
+	targetPassword = "rotated-target-pass"
+	err = clients.giveNVMeOF.Authorize(ctx, nvmeof.AuthorizeArguments{
+		TargetNQN:         targetNQN,
 		InitiatorNQN:      initiatorNQN,
 		InitiatorPassword: initiatorPassword,
 		TargetPassword:    targetPassword,
 	})
 	require.NoError(t, err)
+	err = clients.takeNVMeOF.UpdateTargetCredentials(ctx, nvmeof.UpdateTargetCredentialsArguments{
+		TargetNQN:         targetNQN,
+		InitiatorPassword: initiatorPassword,
+		TargetPassword:    targetPassword,
+	})
+	require.NoError(t, err)
 
 	// Disconnect from target.
 	err = clients.takeNVMeOF.DisconnectTarget(ctx, nvmeof.DisconnectTargetArguments{
@@ -283,3 +362,40 @@ func TestGenerateDHCHAPKey(t *testing.T) {
 		})
 	}
 }
+
+func TestHostID(t *testing.T) {
+	id := nvmeof.HostID("nqn.2014-08.org.nvmexpress:uuid:1b4e28ba-2fa1-11d2-883f-0016d3cca427:vol-1")
+	require.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-8[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, id)
+	require.Equal(t, id, nvmeof.HostID("nqn.2014-08.org.nvmexpress:uuid:1b4e28ba-2fa1-11d2-883f-0016d3cca427:vol-1"))
+	require.NotEqual(t, id, nvmeof.HostID("nqn.2014-08.org.nvmexpress:uuid:1b4e28ba-2fa1-11d2-883f-0016d3cca427:vol-2"))
+}
+
+func TestGenerateTLSKey(t *testing.T) {
+	key, err := nvmeof.GenerateTLSKey()
+	require.NoError(t, err)
+	require.Regexp(t, `^NVMeTLSkey-1:01:[A-Za-z0-9+/]{48}:$`, key)
+
+	other, err := nvmeof.GenerateTLSKey()
+	require.NoError(t, err)
+	require.NotEqual(t, key, other)
+}
+
+func TestPortFamily(t *testing.T) {
+	tests := []struct {
+		address string
+		want    string
+	}{
+		{address: "0.0.0.0", want: "ipv4"},
+		{address: "10.0.0.1", want: "ipv4"},
+		{address: "::", want: "ipv6"},
+		{address: "fd00::1", want: "ipv6"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			got := nvmeof.Port{Address: tt.address}.Family()
+			if got != tt.want {
+				t.Errorf("Port.Family() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
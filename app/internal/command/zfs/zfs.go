@@ -2,8 +2,11 @@
 package zfs
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,6 +31,9 @@ type CreateVolumeArguments struct {
 	Size    uint64
 	Options map[string]string
 	Sparse  bool
+	// Key encrypts the volume with the raw 32 byte key when set, in which case
+	// the executor must be a command.StreamExecutor.
+	Key []byte
 }
 
 // CreateVolume creates a new ZFS volume.
@@ -47,10 +53,14 @@ func (z ZFS) CreateVolume(ctx context.Context, args CreateVolumeArguments) error
 		}
 	}
 
+	if args.Key != nil {
+		cmd.WriteString(encryptionOptions)
+	}
+
 	cmd.WriteString(fmt.Sprintf(" -V %d %s", args.Size, args.Name))
 
 	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
-		result, err := z.executor.Exec(ctx, cmd.String())
+		result, err := z.execWithKey(ctx, cmd.String(), args.Key)
 		if err != nil {
 			return result, fmt.Errorf("failed to create volume '%s': %w, stderr: %s", args.Name, err, result.Stderr)
 		}
@@ -60,9 +70,136 @@ func (z ZFS) CreateVolume(ctx context.Context, args CreateVolumeArguments) error
 	return err
 }
 
+// CreateFilesystemArguments represents the arguments for creating a ZFS
+// filesystem.
+type CreateFilesystemArguments struct {
+	Name    string
+	Options map[string]string
+	// Key encrypts the filesystem with the raw 32 byte key when set, in which
+	// case the executor must be a command.StreamExecutor.
+	Key []byte
+}
+
+// CreateFilesystem creates a new ZFS filesystem dataset. It is mounted at its
+// mountpoint once created.
+//
+// zfs create [-o property=value]... <filesystem>.
+func (z ZFS) CreateFilesystem(ctx context.Context, args CreateFilesystemArguments) error {
+	var cmd strings.Builder
+	cmd.WriteString("zfs create")
+
+	if len(args.Options) > 0 {
+		for key, value := range args.Options {
+			cmd.WriteString(fmt.Sprintf(" -o %s=%s", key, value))
+		}
+	}
+
+	if args.Key != nil {
+		cmd.WriteString(encryptionOptions)
+	}
+
+	cmd.WriteString(fmt.Sprintf(" %s", args.Name))
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.execWithKey(ctx, cmd.String(), args.Key)
+		if err != nil {
+			return result, fmt.Errorf("failed to create filesystem '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
+// LoadKeyArguments represents the arguments for loading the key of an
+// encrypted ZFS dataset.
+type LoadKeyArguments struct {
+	Name string
+	Key  []byte
+}
+
+// LoadKey loads the raw key of an encrypted dataset so that it can be accessed.
+// It succeeds if the key is already loaded. The executor must be a
+// command.StreamExecutor.
+//
+// zfs load-key -L prompt <dataset>.
+func (z ZFS) LoadKey(ctx context.Context, args LoadKeyArguments) error {
+	cmd := fmt.Sprintf("zfs load-key -L prompt '%s'", args.Name)
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.execWithKey(ctx, cmd, args.Key)
+		if err != nil {
+			if result != nil && strings.Contains(result.Stderr, "Key already loaded") {
+				return result, nil
+			}
+			return result, fmt.Errorf("failed to load key of '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
+// ChangeKeyArguments represents the arguments for changing the key of an
+// encrypted ZFS dataset.
+type ChangeKeyArguments struct {
+	Name string
+	// Key is the new raw 32 byte key.
+	Key []byte
+}
+
+// ChangeKey changes the key of an encrypted dataset, which must be its own
+// encryption root and have its current key loaded. Only the wrapping key is
+// changed, so the data is not re-encrypted. The executor must be a
+// command.StreamExecutor.
+//
+// zfs change-key -o keyformat=raw -o keylocation=prompt <dataset>.
+func (z ZFS) ChangeKey(ctx context.Context, args ChangeKeyArguments) error {
+	cmd := fmt.Sprintf("zfs change-key -o keyformat=raw -o keylocation=prompt '%s'", args.Name)
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.execWithKey(ctx, cmd, args.Key)
+		if err != nil {
+			return result, fmt.Errorf("failed to change key of '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
+// MountFilesystemArguments represents the arguments for mounting a ZFS
+// filesystem.
+type MountFilesystemArguments struct {
+	Name string
+}
+
+// MountFilesystem mounts a ZFS filesystem at its mountpoint. It succeeds if the
+// filesystem is already mounted.
+//
+// zfs mount <filesystem>.
+func (z ZFS) MountFilesystem(ctx context.Context, args MountFilesystemArguments) error {
+	cmd := fmt.Sprintf("zfs mount '%s'", args.Name)
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.executor.Exec(ctx, cmd)
+		if err != nil {
+			if result != nil && strings.Contains(result.Stderr, "filesystem already mounted") {
+				return result, nil
+			}
+			return result, fmt.Errorf("failed to mount filesystem '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
 // DestroyVolumeArguments represents the arguments for destroying a ZFS volume.
 type DestroyVolumeArguments struct {
 	Name string
+	// Recursive also destroys the snapshots of the volume.
+	Recursive bool
 }
 
 // DestroyVolume destroys a ZFS volume.
@@ -72,6 +209,10 @@ func (z ZFS) DestroyVolume(ctx context.Context, args DestroyVolumeArguments) err
 	var cmd strings.Builder
 	cmd.WriteString("zfs destroy")
 
+	if args.Recursive {
+		cmd.WriteString(" -r")
+	}
+
 	cmd.WriteString(fmt.Sprintf(" %s", args.Name))
 
 	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
@@ -88,6 +229,29 @@ func (z ZFS) DestroyVolume(ctx context.Context, args DestroyVolumeArguments) err
 	return err
 }
 
+// RenameVolumeArguments represents the arguments for renaming a ZFS volume.
+type RenameVolumeArguments struct {
+	Name    string
+	NewName string
+}
+
+// RenameVolume renames a ZFS volume within its pool.
+//
+// zfs rename <volume> <new-volume>.
+func (z ZFS) RenameVolume(ctx context.Context, args RenameVolumeArguments) error {
+	cmd := fmt.Sprintf("zfs rename '%s' '%s'", args.Name, args.NewName)
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.executor.Exec(ctx, cmd)
+		if err != nil {
+			return result, fmt.Errorf("failed to rename volume '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
 // VolumeExistsArguments represents the arguments for checking if a ZFS volume exists.
 type VolumeExistsArguments struct {
 	Name string
@@ -174,6 +338,515 @@ func (z ZFS) GetProperty(ctx context.Context, args GetPropertyArguments) (string
 	return valueString, nil
 }
 
+// PropertySource is where the value of a ZFS property comes from.
+type PropertySource string
+
+const (
+	PropertySourceLocal     PropertySource = "local"
+	PropertySourceDefault   PropertySource = "default"
+	PropertySourceInherited PropertySource = "inherited"
+	PropertySourceTemporary PropertySource = "temporary"
+	PropertySourceReceived  PropertySource = "received"
+	// PropertySourceNone is the source of read-only properties.
+	PropertySourceNone PropertySource = "none"
+)
+
+// Property is the value of a ZFS property of a dataset.
+type Property struct {
+	Value  string
+	Source PropertySource
+	// InheritedFrom is the dataset an inherited value comes from.
+	InheritedFrom string
+}
+
+// Properties are the properties of a dataset by name.
+type Properties map[string]Property
+
+// Int returns the value of the property as an integer, as parsable values are
+// output when getting properties.
+func (p Properties) Int(name string) (int64, error) {
+	property, ok := p[name]
+	if !ok {
+		return 0, fmt.Errorf("property '%s' is not present", name)
+	}
+	value, err := strconv.ParseInt(property.Value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse property '%s': %w", name, err)
+	}
+	return value, nil
+}
+
+// ParseProperties parses the output of `zfs get -Hp -o property,value,source`.
+// Properties that do not apply to the dataset, which have a value and source
+// of "-", are left out.
+func ParseProperties(output string) (Properties, error) {
+	properties := make(Properties)
+	for line := range strings.SplitSeq(strings.TrimRight(output, "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("failed to parse property line '%s'", line)
+		}
+		name, value, source := fields[0], fields[1], fields[2]
+
+		property := Property{Value: value}
+		switch {
+		case source == "-":
+			if value == "-" {
+				continue
+			}
+			property.Source = PropertySourceNone
+		case strings.HasPrefix(source, "inherited from "):
+			property.Source = PropertySourceInherited
+			property.InheritedFrom = strings.TrimPrefix(source, "inherited from ")
+		default:
+			property.Source = PropertySource(source)
+		}
+		properties[name] = property
+	}
+	return properties, nil
+}
+
+// GetPropertiesArguments represents the arguments for getting the properties
+// of a ZFS dataset.
+type GetPropertiesArguments struct {
+	Name string
+	// Properties are the names of the properties to get. All properties are
+	// returned when it is empty.
+	Properties []string
+}
+
+// GetProperties gets the properties of a ZFS dataset in a single call.
+//
+// zfs get -Hp -o property,value,source <all|property[,property]...> <dataset>.
+func (z ZFS) GetProperties(ctx context.Context, args GetPropertiesArguments) (Properties, error) {
+	names := "all"
+	if len(args.Properties) > 0 {
+		names = strings.Join(args.Properties, ",")
+	}
+	cmd := fmt.Sprintf("zfs get -Hp -o property,value,source '%s' '%s'", names, args.Name)
+
+	result, err := z.executor.Exec(ctx, cmd)
+	if err != nil {
+		if result != nil {
+			stderr := strings.ReplaceAll(result.Stderr, "\n", "")
+			if strings.Contains(stderr, "dataset does not exist") {
+				return nil, fmt.Errorf("dataset does not exist: %s", stderr)
+			}
+			if strings.Contains(stderr, "bad property list") {
+				return nil, fmt.Errorf("invalid property: %s", stderr)
+			}
+			return nil, fmt.Errorf("failed to get properties on '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return nil, fmt.Errorf("failed to execute command: %w", err)
+	}
+
+	return ParseProperties(result.Stdout)
+}
+
+// CreateSnapshotArguments represents the arguments for creating a ZFS snapshot.
+type CreateSnapshotArguments struct {
+	Name string
+}
+
+// CreateSnapshot creates a new ZFS snapshot. The name must be in the form
+// dataset@snapshot.
+//
+// zfs snapshot <dataset>@<snapshot>.
+func (z ZFS) CreateSnapshot(ctx context.Context, args CreateSnapshotArguments) error {
+	cmd := fmt.Sprintf("zfs snapshot '%s'", args.Name)
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.executor.Exec(ctx, cmd)
+		if err != nil {
+			return result, fmt.Errorf("failed to create snapshot '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
+// CreateSnapshotsArguments represents the arguments for creating several ZFS
+// snapshots at once.
+type CreateSnapshotsArguments struct {
+	Names []string
+}
+
+// CreateSnapshots creates several ZFS snapshots in a single operation. The
+// names must be in the form dataset@snapshot. Snapshots of datasets within the
+// same pool are taken atomically, so they are consistent with each other.
+//
+// zfs snapshot <dataset>@<snapshot> <dataset>@<snapshot>...
+func (z ZFS) CreateSnapshots(ctx context.Context, args CreateSnapshotsArguments) error {
+	if len(args.Names) == 0 {
+		return fmt.Errorf("no snapshots to create")
+	}
+
+	quoted := make([]string, 0, len(args.Names))
+	for _, name := range args.Names {
+		if !strings.Contains(name, "@") {
+			return fmt.Errorf("refusing to create '%s' as it is not a snapshot", name)
+		}
+		quoted = append(quoted, fmt.Sprintf("'%s'", name))
+	}
+	cmd := fmt.Sprintf("zfs snapshot %s", strings.Join(quoted, " "))
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.executor.Exec(ctx, cmd)
+		if err != nil {
+			return result, fmt.Errorf("failed to create snapshots '%s': %w, stderr: %s", strings.Join(args.Names, "', '"), err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
+// DestroySnapshotArguments represents the arguments for destroying a ZFS
+// snapshot.
+type DestroySnapshotArguments struct {
+	Name string
+}
+
+// DestroySnapshot destroys a ZFS snapshot. The name must be in the form
+// dataset@snapshot.
+//
+// zfs destroy <dataset>@<snapshot>.
+func (z ZFS) DestroySnapshot(ctx context.Context, args DestroySnapshotArguments) error {
+	if !strings.Contains(args.Name, "@") {
+		return fmt.Errorf("refusing to destroy '%s' as it is not a snapshot", args.Name)
+	}
+
+	cmd := fmt.Sprintf("zfs destroy '%s'", args.Name)
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.executor.Exec(ctx, cmd)
+		if err != nil {
+			if result != nil && (strings.Contains(result.Stderr, "dataset does not exist") || strings.Contains(result.Stderr, "could not find any snapshots to destroy")) {
+				return result, nil
+			}
+			return result, fmt.Errorf("failed to destroy snapshot '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
+// ListSnapshotsArguments represents the arguments for listing the snapshots of
+// a ZFS dataset.
+type ListSnapshotsArguments struct {
+	Name string
+}
+
+// ListSnapshots lists the snapshots of a ZFS dataset in creation order. The
+// returned names are in the form dataset@snapshot.
+//
+// zfs list -H -t snapshot -o name -s createtxg -d 1 <dataset>.
+func (z ZFS) ListSnapshots(ctx context.Context, args ListSnapshotsArguments) ([]string, error) {
+	cmd := fmt.Sprintf("zfs list -H -t snapshot -o name -s createtxg -d 1 '%s'", args.Name)
+
+	result, err := z.executor.Exec(ctx, cmd)
+	if err != nil {
+		if result != nil {
+			return nil, fmt.Errorf("failed to list snapshots of '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return nil, fmt.Errorf("failed to execute command: %w", err)
+	}
+
+	var names []string
+	for _, line := range strings.Split(strings.TrimSpace(result.Stdout), "\n") {
+		if line == "" {
+			continue
+		}
+		names = append(names, line)
+	}
+	return names, nil
+}
+
+// CloneSnapshotArguments represents the arguments for cloning a ZFS snapshot.
+type CloneSnapshotArguments struct {
+	Snapshot string
+	Name     string
+	Options  map[string]string
+}
+
+// CloneSnapshot creates a new ZFS dataset from a snapshot. The snapshot must
+// be in the form dataset@snapshot.
+//
+// zfs clone [-o property=value]... <snapshot> <volume>.
+func (z ZFS) CloneSnapshot(ctx context.Context, args CloneSnapshotArguments) error {
+	var cmd strings.Builder
+	cmd.WriteString("zfs clone")
+
+	if len(args.Options) > 0 {
+		for key, value := range args.Options {
+			cmd.WriteString(fmt.Sprintf(" -o %s=%s", key, value))
+		}
+	}
+
+	cmd.WriteString(fmt.Sprintf(" '%s' '%s'", args.Snapshot, args.Name))
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.executor.Exec(ctx, cmd.String())
+		if err != nil {
+			return result, fmt.Errorf("failed to clone snapshot '%s' to '%s': %w, stderr: %s", args.Snapshot, args.Name, err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
+// PromoteCloneArguments represents the arguments for promoting a ZFS clone.
+type PromoteCloneArguments struct {
+	Name string
+}
+
+// PromoteClone promotes a ZFS clone so that it no longer depends on its origin
+// snapshot. The origin snapshot, and any snapshots preceding it, are moved to
+// the promoted clone.
+//
+// zfs promote <clone>.
+func (z ZFS) PromoteClone(ctx context.Context, args PromoteCloneArguments) error {
+	cmd := fmt.Sprintf("zfs promote '%s'", args.Name)
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.executor.Exec(ctx, cmd)
+		if err != nil {
+			return result, fmt.Errorf("failed to promote clone '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
+// RollbackSnapshotArguments represents the arguments for rolling a ZFS dataset
+// back to a snapshot.
+type RollbackSnapshotArguments struct {
+	Name         string
+	DestroyNewer bool
+}
+
+// RollbackSnapshot rolls the dataset of a snapshot back to it. The name must be
+// in the form dataset@snapshot. Unless DestroyNewer is set, the rollback fails
+// when more recent snapshots exist.
+//
+// zfs rollback [-r] <dataset>@<snapshot>.
+func (z ZFS) RollbackSnapshot(ctx context.Context, args RollbackSnapshotArguments) error {
+	if !strings.Contains(args.Name, "@") {
+		return fmt.Errorf("refusing to roll back to '%s' as it is not a snapshot", args.Name)
+	}
+
+	var cmd strings.Builder
+	cmd.WriteString("zfs rollback")
+
+	if args.DestroyNewer {
+		cmd.WriteString(" -r")
+	}
+
+	cmd.WriteString(fmt.Sprintf(" '%s'", args.Name))
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.executor.Exec(ctx, cmd.String())
+		if err != nil {
+			return result, fmt.Errorf("failed to roll back to snapshot '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
+// CreateBookmarkArguments represents the arguments for creating a ZFS
+// bookmark.
+type CreateBookmarkArguments struct {
+	Snapshot string
+	Bookmark string
+}
+
+// CreateBookmark creates a bookmark of a snapshot. The snapshot must be in the
+// form dataset@snapshot and the bookmark in the form dataset#bookmark. A
+// bookmark can be the source of an incremental send after its snapshot has
+// been destroyed.
+//
+// zfs bookmark <dataset>@<snapshot> <dataset>#<bookmark>.
+func (z ZFS) CreateBookmark(ctx context.Context, args CreateBookmarkArguments) error {
+	if !strings.Contains(args.Snapshot, "@") {
+		return fmt.Errorf("refusing to bookmark '%s' as it is not a snapshot", args.Snapshot)
+	}
+	if !strings.Contains(args.Bookmark, "#") {
+		return fmt.Errorf("refusing to create '%s' as it is not a bookmark", args.Bookmark)
+	}
+
+	cmd := fmt.Sprintf("zfs bookmark '%s' '%s'", args.Snapshot, args.Bookmark)
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.executor.Exec(ctx, cmd)
+		if err != nil {
+			return result, fmt.Errorf("failed to create bookmark '%s': %w, stderr: %s", args.Bookmark, err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
+// DestroyBookmarkArguments represents the arguments for destroying a ZFS
+// bookmark.
+type DestroyBookmarkArguments struct {
+	Name string
+}
+
+// DestroyBookmark destroys a bookmark. The name must be in the form
+// dataset#bookmark. It is not an error if the bookmark does not exist.
+//
+// zfs destroy <dataset>#<bookmark>.
+func (z ZFS) DestroyBookmark(ctx context.Context, args DestroyBookmarkArguments) error {
+	if !strings.Contains(args.Name, "#") {
+		return fmt.Errorf("refusing to destroy '%s' as it is not a bookmark", args.Name)
+	}
+
+	cmd := fmt.Sprintf("zfs destroy '%s'", args.Name)
+
+	_, err := z.retryOnBusy(ctx, func() (*command.CommandResult, error) {
+		result, err := z.executor.Exec(ctx, cmd)
+		if err != nil {
+			if result != nil && (strings.Contains(result.Stderr, "dataset does not exist") || strings.Contains(result.Stderr, "bookmark does not exist")) {
+				return result, nil
+			}
+			return result, fmt.Errorf("failed to destroy bookmark '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return result, nil
+	})
+
+	return err
+}
+
+// SendArguments represents the arguments for sending a ZFS snapshot.
+type SendArguments struct {
+	Snapshot string
+	// From is the snapshot or bookmark the stream is incremental from. A full
+	// stream is sent when it is empty.
+	From string
+	// Intermediary includes the snapshots between From and Snapshot in an
+	// incremental stream.
+	Intermediary bool
+	// Replicate sends the properties and snapshots of the dataset along with
+	// it.
+	Replicate bool
+	// Raw sends the blocks of an encrypted dataset as they are on disk, so the
+	// received dataset is encrypted with the same key and the key does not
+	// have to be loaded to send.
+	Raw bool
+}
+
+// Send writes the send stream of a snapshot to the writer. The executor must
+// be a command.StreamExecutor.
+//
+// zfs send [-R] [-w] [-i|-I <snapshot|bookmark>] <dataset>@<snapshot>.
+func (z ZFS) Send(ctx context.Context, args SendArguments, w io.Writer) error {
+	executor, ok := z.executor.(command.StreamExecutor)
+	if !ok {
+		return fmt.Errorf("executor does not support streaming")
+	}
+	if !strings.Contains(args.Snapshot, "@") {
+		return fmt.Errorf("refusing to send '%s' as it is not a snapshot", args.Snapshot)
+	}
+
+	var cmd strings.Builder
+	cmd.WriteString("zfs send")
+
+	if args.Replicate {
+		cmd.WriteString(" -R")
+	}
+
+	if args.Raw {
+		cmd.WriteString(" -w")
+	}
+
+	if args.From != "" {
+		flag := "-i"
+		if args.Intermediary {
+			flag = "-I"
+		}
+		cmd.WriteString(fmt.Sprintf(" %s '%s'", flag, args.From))
+	}
+
+	cmd.WriteString(fmt.Sprintf(" '%s'", args.Snapshot))
+
+	result, err := executor.ExecStream(ctx, cmd.String(), nil, w)
+	if err != nil {
+		if result != nil {
+			return fmt.Errorf("failed to send snapshot '%s': %w, stderr: %s", args.Snapshot, err, result.Stderr)
+		}
+		return fmt.Errorf("failed to execute command: %w", err)
+	}
+	return nil
+}
+
+// ReceiveArguments represents the arguments for receiving a ZFS send stream.
+type ReceiveArguments struct {
+	Name string
+	// Force rolls the dataset back to its most recent snapshot before
+	// receiving an incremental stream.
+	Force bool
+}
+
+// Receive reads a send stream from the reader into the dataset. The received
+// dataset is not mounted. The executor must be a command.StreamExecutor.
+//
+// zfs receive -u [-F] <dataset>.
+func (z ZFS) Receive(ctx context.Context, args ReceiveArguments, r io.Reader) error {
+	executor, ok := z.executor.(command.StreamExecutor)
+	if !ok {
+		return fmt.Errorf("executor does not support streaming")
+	}
+
+	var cmd strings.Builder
+	cmd.WriteString("zfs receive -u")
+
+	if args.Force {
+		cmd.WriteString(" -F")
+	}
+
+	cmd.WriteString(fmt.Sprintf(" '%s'", args.Name))
+
+	result, err := executor.ExecStream(ctx, cmd.String(), r, io.Discard)
+	if err != nil {
+		if result != nil {
+			return fmt.Errorf("failed to receive into '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return fmt.Errorf("failed to execute command: %w", err)
+	}
+	return nil
+}
+
+// encryptionOptions are the options a dataset is created with to be encrypted
+// by a raw key read from stdin.
+const encryptionOptions = " -o encryption=aes-256-gcm -o keyformat=raw -o keylocation=prompt"
+
+// execWithKey executes the command, writing the key to its stdin when set.
+func (z ZFS) execWithKey(ctx context.Context, cmd string, key []byte) (*command.CommandResult, error) {
+	if key == nil {
+		return z.executor.Exec(ctx, cmd)
+	}
+	executor, ok := z.executor.(command.StreamExecutor)
+	if !ok {
+		return &command.CommandResult{}, fmt.Errorf("executor does not support streaming")
+	}
+	var stdout bytes.Buffer
+	result, err := executor.ExecStream(ctx, cmd, bytes.NewReader(key), &stdout)
+	if result != nil {
+		result.Stdout = stdout.String()
+	}
+	return result, err
+}
+
 func (z ZFS) retryOnBusy(ctx context.Context, fn func() (*command.CommandResult, error)) (*command.CommandResult, error) {
 	var res *command.CommandResult
 	var err error
@@ -1,8 +1,10 @@
 package zfs_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
@@ -75,6 +77,65 @@ func TestCreateAndDestroyVolume(t *testing.T) {
 	assert.False(t, exists, "volume should not exist after destruction")
 }
 
+func TestCreateFilesystem(t *testing.T) {
+	client := getTestZFSClient(t)
+
+	fsName := "tank/testfs-" + fmt.Sprintf("%d", time.Now().UnixNano())
+
+	// Create the filesystem.
+	err := client.CreateFilesystem(context.Background(), zfs.CreateFilesystemArguments{
+		Name:    fsName,
+		Options: map[string]string{"refquota": fmt.Sprintf("%d", 1024*1024*10)},
+	})
+	require.NoError(t, err, "failed to create filesystem")
+
+	// Clean up
+	defer func() {
+		_ = client.DestroyVolume(context.Background(), zfs.DestroyVolumeArguments{Name: fsName})
+	}()
+
+	// Verify the filesystem is mounted.
+	mounted, err := client.GetProperty(context.Background(), zfs.GetPropertyArguments{
+		Name:        fsName,
+		PropertyKey: "mounted",
+	})
+	require.NoError(t, err, "failed to get mounted property")
+	assert.Equal(t, "yes", mounted)
+}
+
+func TestRenameVolume(t *testing.T) {
+	client := getTestZFSClient(t)
+
+	volName := "tank/testvol-rename-" + fmt.Sprintf("%d", time.Now().UnixNano())
+	newVolName := volName + "-fenced"
+
+	err := client.CreateVolume(context.Background(), zfs.CreateVolumeArguments{
+		Name: volName,
+		Size: uint64(1024 * 1024 * 10), // 10MB
+	})
+	require.NoError(t, err, "failed to create volume")
+
+	// Clean up
+	defer func() {
+		_ = client.DestroyVolume(context.Background(), zfs.DestroyVolumeArguments{Name: volName})
+		_ = client.DestroyVolume(context.Background(), zfs.DestroyVolumeArguments{Name: newVolName})
+	}()
+
+	err = client.RenameVolume(context.Background(), zfs.RenameVolumeArguments{
+		Name:    volName,
+		NewName: newVolName,
+	})
+	require.NoError(t, err, "failed to rename volume")
+
+	exists, err := client.VolumeExists(context.Background(), zfs.VolumeExistsArguments{Name: volName})
+	require.NoError(t, err, "failed to check if volume exists after rename")
+	assert.False(t, exists, "volume should not exist under its old name")
+
+	exists, err = client.VolumeExists(context.Background(), zfs.VolumeExistsArguments{Name: newVolName})
+	require.NoError(t, err, "failed to check if renamed volume exists")
+	assert.True(t, exists, "volume should exist under its new name")
+}
+
 func TestSetProperty(t *testing.T) {
 	client := getTestZFSClient(t)
 
@@ -103,3 +164,271 @@ func TestSetProperty(t *testing.T) {
 	err = client.SetProperty(context.Background(), setArgs)
 	require.NoError(t, err, "failed to set property")
 }
+
+func TestParseProperties(t *testing.T) {
+	output := "type\tvolume\t-\n" +
+		"used\t10485760\t-\n" +
+		"volblocksize\t16384\tdefault\n" +
+		"compression\tlz4\tinherited from tank\n" +
+		"refreservation\t10502144\tlocal\n" +
+		"recordsize\t-\t-\n" +
+		"zfsilo:comment\thello world\treceived\n"
+
+	properties, err := zfs.ParseProperties(output)
+	require.NoError(t, err)
+	require.Equal(t, zfs.Properties{
+		"type":           {Value: "volume", Source: zfs.PropertySourceNone},
+		"used":           {Value: "10485760", Source: zfs.PropertySourceNone},
+		"volblocksize":   {Value: "16384", Source: zfs.PropertySourceDefault},
+		"compression":    {Value: "lz4", Source: zfs.PropertySourceInherited, InheritedFrom: "tank"},
+		"refreservation": {Value: "10502144", Source: zfs.PropertySourceLocal},
+		"zfsilo:comment": {Value: "hello world", Source: zfs.PropertySourceReceived},
+	}, properties)
+
+	used, err := properties.Int("used")
+	require.NoError(t, err)
+	assert.Equal(t, int64(10485760), used)
+	_, err = properties.Int("recordsize")
+	assert.Error(t, err)
+
+	_, err = zfs.ParseProperties("used\t1\n")
+	require.Error(t, err)
+}
+
+func TestGetProperties(t *testing.T) {
+	client := getTestZFSClient(t)
+
+	volName := "tank/testvol-props-" + fmt.Sprintf("%d", time.Now().UnixNano())
+	err := client.CreateVolume(context.Background(), zfs.CreateVolumeArguments{
+		Name: volName,
+		Size: 1024 * 1024 * 10,
+	})
+	require.NoError(t, err, "failed to create volume")
+	defer func() {
+		_ = client.DestroyVolume(context.Background(), zfs.DestroyVolumeArguments{Name: volName})
+	}()
+
+	properties, err := client.GetProperties(context.Background(), zfs.GetPropertiesArguments{Name: volName})
+	require.NoError(t, err, "failed to get properties")
+	assert.Equal(t, zfs.Property{Value: "10485760", Source: zfs.PropertySourceLocal}, properties["volsize"])
+	assert.Contains(t, properties, "volblocksize")
+
+	properties, err = client.GetProperties(context.Background(), zfs.GetPropertiesArguments{
+		Name:       volName,
+		Properties: []string{"used", "usedbydataset"},
+	})
+	require.NoError(t, err, "failed to get named properties")
+	assert.Len(t, properties, 2)
+}
+
+func TestEncryptedVolumeKeys(t *testing.T) {
+	client := getTestZFSClient(t)
+	ctx := context.Background()
+
+	volName := "tank/testvol-crypt-" + fmt.Sprintf("%d", time.Now().UnixNano())
+	key := bytes.Repeat([]byte{0x01}, 32)
+	newKey := bytes.Repeat([]byte{0x02}, 32)
+
+	// Create the encrypted volume.
+	err := client.CreateVolume(ctx, zfs.CreateVolumeArguments{
+		Name: volName,
+		Size: uint64(1024 * 1024 * 10), // 10MB
+		Key:  key,
+	})
+	require.NoError(t, err, "failed to create encrypted volume")
+
+	// Clean up
+	defer func() {
+		_ = client.DestroyVolume(ctx, zfs.DestroyVolumeArguments{Name: volName})
+	}()
+
+	encryption, err := client.GetProperty(ctx, zfs.GetPropertyArguments{Name: volName, PropertyKey: "encryption"})
+	require.NoError(t, err, "failed to get encryption property")
+	assert.Equal(t, "aes-256-gcm", encryption)
+
+	// Loading a loaded key succeeds.
+	err = client.LoadKey(ctx, zfs.LoadKeyArguments{Name: volName, Key: key})
+	require.NoError(t, err, "failed to load key")
+
+	// Change the key.
+	err = client.ChangeKey(ctx, zfs.ChangeKeyArguments{Name: volName, Key: newKey})
+	require.NoError(t, err, "failed to change key")
+
+	keyStatus, err := client.GetProperty(ctx, zfs.GetPropertyArguments{Name: volName, PropertyKey: "keystatus"})
+	require.NoError(t, err, "failed to get keystatus property")
+	assert.Equal(t, "available", keyStatus)
+}
+
+func TestCreateListAndDestroySnapshot(t *testing.T) {
+	client := getTestZFSClient(t)
+
+	volName := "tank/testvol-snap-" + fmt.Sprintf("%d", time.Now().UnixNano())
+	volSize := uint64(1024 * 1024 * 10) // 10MB
+	snapName := volName + "@testsnap"
+
+	// Create the volume.
+	err := client.CreateVolume(context.Background(), zfs.CreateVolumeArguments{
+		Name: volName,
+		Size: volSize,
+	})
+	require.NoError(t, err, "failed to create volume")
+
+	// Clean up
+	defer func() {
+		_ = client.DestroySnapshot(context.Background(), zfs.DestroySnapshotArguments{Name: snapName})
+		_ = client.DestroyVolume(context.Background(), zfs.DestroyVolumeArguments{Name: volName})
+	}()
+
+	// Create the snapshot.
+	err = client.CreateSnapshot(context.Background(), zfs.CreateSnapshotArguments{Name: snapName})
+	require.NoError(t, err, "failed to create snapshot")
+
+	// Verify the snapshot is listed.
+	snapshots, err := client.ListSnapshots(context.Background(), zfs.ListSnapshotsArguments{Name: volName})
+	require.NoError(t, err, "failed to list snapshots after creation")
+	assert.Equal(t, []string{snapName}, snapshots, "snapshot should be listed after creation")
+
+	// Destroy the snapshot.
+	err = client.DestroySnapshot(context.Background(), zfs.DestroySnapshotArguments{Name: snapName})
+	require.NoError(t, err, "failed to destroy snapshot")
+
+	// Verify the snapshot is gone.
+	snapshots, err = client.ListSnapshots(context.Background(), zfs.ListSnapshotsArguments{Name: volName})
+	require.NoError(t, err, "failed to list snapshots after destruction")
+	assert.Empty(t, snapshots, "snapshot should not be listed after destruction")
+}
+
+func TestCreateSnapshots(t *testing.T) {
+	client := getTestZFSClient(t)
+
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	volNames := []string{"tank/testvol-group-a-" + suffix, "tank/testvol-group-b-" + suffix}
+	volSize := uint64(1024 * 1024 * 10) // 10MB
+
+	// Create the volumes.
+	for _, volName := range volNames {
+		err := client.CreateVolume(context.Background(), zfs.CreateVolumeArguments{
+			Name: volName,
+			Size: volSize,
+		})
+		require.NoError(t, err, "failed to create volume")
+	}
+
+	// Clean up
+	defer func() {
+		for _, volName := range volNames {
+			_ = client.DestroyVolume(context.Background(), zfs.DestroyVolumeArguments{Name: volName, Recursive: true})
+		}
+	}()
+
+	// Create the snapshots in a single operation.
+	snapNames := []string{volNames[0] + "@group", volNames[1] + "@group"}
+	err := client.CreateSnapshots(context.Background(), zfs.CreateSnapshotsArguments{Names: snapNames})
+	require.NoError(t, err, "failed to create snapshots")
+
+	// Verify each volume has its snapshot.
+	for i, volName := range volNames {
+		snapshots, err := client.ListSnapshots(context.Background(), zfs.ListSnapshotsArguments{Name: volName})
+		require.NoError(t, err, "failed to list snapshots")
+		assert.Equal(t, []string{snapNames[i]}, snapshots, "snapshot should be listed after creation")
+	}
+}
+
+func TestRollbackSnapshot(t *testing.T) {
+	client := getTestZFSClient(t)
+
+	volName := "tank/testvol-rollback-" + fmt.Sprintf("%d", time.Now().UnixNano())
+	volSize := uint64(1024 * 1024 * 10) // 10MB
+	firstSnapName := volName + "@first"
+	secondSnapName := volName + "@second"
+
+	// Create the volume.
+	err := client.CreateVolume(context.Background(), zfs.CreateVolumeArguments{
+		Name: volName,
+		Size: volSize,
+	})
+	require.NoError(t, err, "failed to create volume")
+
+	// Clean up
+	defer func() {
+		_ = client.DestroySnapshot(context.Background(), zfs.DestroySnapshotArguments{Name: secondSnapName})
+		_ = client.DestroySnapshot(context.Background(), zfs.DestroySnapshotArguments{Name: firstSnapName})
+		_ = client.DestroyVolume(context.Background(), zfs.DestroyVolumeArguments{Name: volName})
+	}()
+
+	// Create the snapshots.
+	err = client.CreateSnapshot(context.Background(), zfs.CreateSnapshotArguments{Name: firstSnapName})
+	require.NoError(t, err, "failed to create first snapshot")
+	err = client.CreateSnapshot(context.Background(), zfs.CreateSnapshotArguments{Name: secondSnapName})
+	require.NoError(t, err, "failed to create second snapshot")
+
+	// Rolling back past a newer snapshot fails unless asked to destroy it.
+	err = client.RollbackSnapshot(context.Background(), zfs.RollbackSnapshotArguments{Name: firstSnapName})
+	require.Error(t, err, "rollback should fail when newer snapshots exist")
+
+	err = client.RollbackSnapshot(context.Background(), zfs.RollbackSnapshotArguments{Name: firstSnapName, DestroyNewer: true})
+	require.NoError(t, err, "failed to roll back to first snapshot")
+
+	// Verify the newer snapshot is gone.
+	snapshots, err := client.ListSnapshots(context.Background(), zfs.ListSnapshotsArguments{Name: volName})
+	require.NoError(t, err, "failed to list snapshots after rollback")
+	assert.Equal(t, []string{firstSnapName}, snapshots, "only the first snapshot should remain after rollback")
+}
+
+func TestSendAndReceiveFromBookmark(t *testing.T) {
+	client := getTestZFSClient(t)
+
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	srcName := "tank/testvol-send-" + suffix
+	dstName := "tank/testvol-recv-" + suffix
+	volSize := uint64(1024 * 1024 * 10) // 10MB
+
+	// Create the volume.
+	err := client.CreateVolume(context.Background(), zfs.CreateVolumeArguments{
+		Name: srcName,
+		Size: volSize,
+	})
+	require.NoError(t, err, "failed to create volume")
+
+	// Clean up
+	defer func() {
+		_ = client.DestroyBookmark(context.Background(), zfs.DestroyBookmarkArguments{Name: srcName + "#first"})
+		_ = client.DestroySnapshot(context.Background(), zfs.DestroySnapshotArguments{Name: srcName + "@second"})
+		_ = client.DestroySnapshot(context.Background(), zfs.DestroySnapshotArguments{Name: dstName + "@first"})
+		_ = client.DestroySnapshot(context.Background(), zfs.DestroySnapshotArguments{Name: dstName + "@second"})
+		_ = client.DestroyVolume(context.Background(), zfs.DestroyVolumeArguments{Name: dstName})
+		_ = client.DestroyVolume(context.Background(), zfs.DestroyVolumeArguments{Name: srcName})
+	}()
+
+	sendReceive := func(args zfs.SendArguments) {
+		pr, pw := io.Pipe()
+		sendErr := make(chan error, 1)
+		go func() {
+			err := client.Send(context.Background(), args, pw)
+			pw.CloseWithError(err)
+			sendErr <- err
+		}()
+		err := client.Receive(context.Background(), zfs.ReceiveArguments{Name: dstName, Force: true}, pr)
+		pr.CloseWithError(err)
+		require.NoError(t, err, "failed to receive")
+		require.NoError(t, <-sendErr, "failed to send")
+	}
+
+	// Send the first snapshot in full and then bookmark it.
+	err = client.CreateSnapshot(context.Background(), zfs.CreateSnapshotArguments{Name: srcName + "@first"})
+	require.NoError(t, err, "failed to create first snapshot")
+	sendReceive(zfs.SendArguments{Snapshot: srcName + "@first"})
+	err = client.CreateBookmark(context.Background(), zfs.CreateBookmarkArguments{Snapshot: srcName + "@first", Bookmark: srcName + "#first"})
+	require.NoError(t, err, "failed to create bookmark")
+	err = client.DestroySnapshot(context.Background(), zfs.DestroySnapshotArguments{Name: srcName + "@first"})
+	require.NoError(t, err, "failed to destroy first snapshot")
+
+	// Send the second snapshot incrementally from the bookmark.
+	err = client.CreateSnapshot(context.Background(), zfs.CreateSnapshotArguments{Name: srcName + "@second"})
+	require.NoError(t, err, "failed to create second snapshot")
+	sendReceive(zfs.SendArguments{Snapshot: srcName + "@second", From: srcName + "#first"})
+
+	snapshots, err := client.ListSnapshots(context.Background(), zfs.ListSnapshotsArguments{Name: dstName})
+	require.NoError(t, err, "failed to list received snapshots")
+	assert.Equal(t, []string{dstName + "@first", dstName + "@second"}, snapshots, "both snapshots should be received")
+}
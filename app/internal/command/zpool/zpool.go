@@ -0,0 +1,422 @@
+// Package zpool contains lib/command wrappers for executing and working with
+// ZFS pools.
+package zpool
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jovulic/zfsilo/lib/command"
+)
+
+// Health is the health of a pool as reported by zpool.
+type Health string
+
+const (
+	HealthOnline    Health = "ONLINE"
+	HealthDegraded  Health = "DEGRADED"
+	HealthFaulted   Health = "FAULTED"
+	HealthOffline   Health = "OFFLINE"
+	HealthRemoved   Health = "REMOVED"
+	HealthUnavail   Health = "UNAVAIL"
+	HealthSuspended Health = "SUSPENDED"
+)
+
+// Pool is a pool as listed by zpool list.
+type Pool struct {
+	Name                 string
+	SizeBytes            uint64
+	AllocatedBytes       uint64
+	FreeBytes            uint64
+	FragmentationPercent uint32
+	Health               Health
+}
+
+// Status is the status of a pool as reported by zpool status. Fields that are
+// not reported are left empty.
+type Status struct {
+	Name   string
+	State  Health
+	Status string
+	Action string
+	Scan   string
+	Errors string
+
+	// The error counts are summed over the leaf devices of the pool.
+	ReadErrors     uint64
+	WriteErrors    uint64
+	ChecksumErrors uint64
+}
+
+// ScanFunction is the kind of scan a pool runs.
+type ScanFunction string
+
+const (
+	ScanFunctionScrub    ScanFunction = "scrub"
+	ScanFunctionResilver ScanFunction = "resilver"
+)
+
+// ScanState is the state of the last scan of a pool.
+type ScanState string
+
+const (
+	ScanStateScanning ScanState = "scanning"
+	ScanStateFinished ScanState = "finished"
+	ScanStateCanceled ScanState = "canceled"
+	ScanStatePaused   ScanState = "paused"
+)
+
+// Scan is the last scrub or resilver of a pool, as reported on the scan line
+// of zpool status.
+type Scan struct {
+	Function  ScanFunction
+	State     ScanState
+	StartTime time.Time
+	EndTime   time.Time
+	// RepairedBytes is the amount repaired by a scrub or resilvered by a
+	// resilver.
+	RepairedBytes uint64
+	Errors        uint64
+	PercentDone   float64
+}
+
+// scanTimeLayout is the layout zpool status prints times in. The time zone of
+// the host is not reported, so times are taken to be in UTC.
+const scanTimeLayout = "Mon Jan _2 15:04:05 2006"
+
+const scanTimePattern = `(\w{3} \w{3} +\d{1,2} \d{2}:\d{2}:\d{2} \d{4})`
+
+var (
+	scanFinishedRegexp = regexp.MustCompile(`^(scrub repaired|resilvered) (\S+) in (?:(\d+) days? )?(\d+):(\d+):(\d+) with (\d+) errors on ` + scanTimePattern)
+	scanCanceledRegexp = regexp.MustCompile(`^(scrub|resilver) canceled on ` + scanTimePattern)
+	scanPausedRegexp   = regexp.MustCompile(`^(scrub) paused since ` + scanTimePattern)
+	scanProgressRegexp = regexp.MustCompile(`^(scrub|resilver) in progress since ` + scanTimePattern)
+	scanPercentRegexp  = regexp.MustCompile(`([\d.]+)% done`)
+	scanRepairedRegexp = regexp.MustCompile(`(\S+) (?:repaired|resilvered),`)
+)
+
+// ParsePools parses the output of zpool list -Hp -o
+// name,size,allocated,free,fragmentation,health.
+func ParsePools(output string) ([]Pool, error) {
+	var pools []Pool
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) != 6 {
+			return nil, fmt.Errorf("unexpected pool line '%s'", line)
+		}
+
+		size, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse size of pool '%s': %w", fields[0], err)
+		}
+		allocated, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse allocated of pool '%s': %w", fields[0], err)
+		}
+		free, err := strconv.ParseUint(fields[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse free of pool '%s': %w", fields[0], err)
+		}
+
+		// Fragmentation is not reported for pools without spacemap
+		// histograms.
+		var fragmentation uint64
+		if fields[4] != "-" {
+			fragmentation, err = strconv.ParseUint(strings.TrimSuffix(fields[4], "%"), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse fragmentation of pool '%s': %w", fields[0], err)
+			}
+		}
+
+		pools = append(pools, Pool{
+			Name:                 fields[0],
+			SizeBytes:            size,
+			AllocatedBytes:       allocated,
+			FreeBytes:            free,
+			FragmentationPercent: uint32(fragmentation),
+			Health:               Health(fields[5]),
+		})
+	}
+	return pools, nil
+}
+
+// ParseStatus parses the output of zpool status -p for a single pool. Values
+// spanning multiple lines are joined with a space.
+func ParseStatus(output string) Status {
+	var status Status
+	var value *string
+	var config bool
+	var devices []statusDevice
+	for _, line := range strings.Split(output, "\n") {
+		key, rest, found := strings.Cut(strings.TrimLeft(line, " "), ":")
+		if found && !strings.HasPrefix(line, "\t") {
+			rest = strings.TrimSpace(rest)
+			config = false
+			switch key {
+			case "pool":
+				status.Name, value = rest, nil
+			case "state":
+				status.State, value = Health(rest), nil
+			case "status":
+				status.Status, value = rest, &status.Status
+			case "action":
+				status.Action, value = rest, &status.Action
+			case "scan":
+				status.Scan, value = rest, &status.Scan
+			case "errors":
+				status.Errors, value = rest, &status.Errors
+			case "config":
+				config, value = true, nil
+			default:
+				value = nil
+			}
+			continue
+		}
+
+		if config {
+			if device, ok := parseStatusDevice(line); ok {
+				devices = append(devices, device)
+			}
+			continue
+		}
+
+		// Indented lines continue the value of the previous key.
+		if value != nil && strings.HasPrefix(line, "\t") {
+			*value = strings.TrimSpace(*value + " " + strings.TrimSpace(line))
+		}
+	}
+
+	// A device is a leaf when the device after it is not nested under it.
+	for i, device := range devices {
+		if i+1 < len(devices) && devices[i+1].depth > device.depth {
+			continue
+		}
+		status.ReadErrors += device.read
+		status.WriteErrors += device.write
+		status.ChecksumErrors += device.checksum
+	}
+
+	return status
+}
+
+type statusDevice struct {
+	depth    int
+	read     uint64
+	write    uint64
+	checksum uint64
+}
+
+// parseStatusDevice parses a device row of the config section of zpool status.
+// Rows without error counts, such as the header and the spares, are skipped.
+func parseStatusDevice(line string) (statusDevice, bool) {
+	line, found := strings.CutPrefix(line, "\t")
+	if !found {
+		return statusDevice{}, false
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) < 5 {
+		return statusDevice{}, false
+	}
+	read, err := strconv.ParseUint(fields[2], 10, 64)
+	if err != nil {
+		return statusDevice{}, false
+	}
+	write, err := strconv.ParseUint(fields[3], 10, 64)
+	if err != nil {
+		return statusDevice{}, false
+	}
+	checksum, err := strconv.ParseUint(fields[4], 10, 64)
+	if err != nil {
+		return statusDevice{}, false
+	}
+
+	return statusDevice{
+		depth:    len(line) - len(strings.TrimLeft(line, " ")),
+		read:     read,
+		write:    write,
+		checksum: checksum,
+	}, true
+}
+
+// ParseScan parses the scan line of zpool status. It returns false when the
+// pool has never been scanned.
+func ParseScan(scan string) (Scan, bool) {
+	if match := scanFinishedRegexp.FindStringSubmatch(scan); match != nil {
+		function := ScanFunctionScrub
+		if match[1] == "resilvered" {
+			function = ScanFunctionResilver
+		}
+		days, _ := strconv.Atoi(match[3])
+		hours, _ := strconv.Atoi(match[4])
+		minutes, _ := strconv.Atoi(match[5])
+		seconds, _ := strconv.Atoi(match[6])
+		duration := time.Duration(days)*24*time.Hour +
+			time.Duration(hours)*time.Hour +
+			time.Duration(minutes)*time.Minute +
+			time.Duration(seconds)*time.Second
+		errors, _ := strconv.ParseUint(match[7], 10, 64)
+		endTime := parseScanTime(match[8])
+		return Scan{
+			Function:      function,
+			State:         ScanStateFinished,
+			StartTime:     endTime.Add(-duration),
+			EndTime:       endTime,
+			RepairedBytes: parseSize(match[2]),
+			Errors:        errors,
+			PercentDone:   100,
+		}, true
+	}
+
+	if match := scanCanceledRegexp.FindStringSubmatch(scan); match != nil {
+		return Scan{
+			Function: ScanFunction(match[1]),
+			State:    ScanStateCanceled,
+			EndTime:  parseScanTime(match[2]),
+		}, true
+	}
+
+	state := ScanStateScanning
+	match := scanProgressRegexp.FindStringSubmatch(scan)
+	if match == nil {
+		state = ScanStatePaused
+		match = scanPausedRegexp.FindStringSubmatch(scan)
+	}
+	if match == nil {
+		return Scan{}, false
+	}
+	result := Scan{
+		Function:  ScanFunction(match[1]),
+		State:     state,
+		StartTime: parseScanTime(match[2]),
+	}
+	if percent := scanPercentRegexp.FindStringSubmatch(scan); percent != nil {
+		result.PercentDone, _ = strconv.ParseFloat(percent[1], 64)
+	}
+	if repaired := scanRepairedRegexp.FindStringSubmatch(scan); repaired != nil {
+		result.RepairedBytes = parseSize(repaired[1])
+	}
+	return result, true
+}
+
+func parseScanTime(value string) time.Time {
+	t, err := time.Parse(scanTimeLayout, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// parseSize parses a size as printed by zpool, either exact or with a binary
+// unit suffix (e.g. 1.50G). Sizes that cannot be parsed are zero.
+func parseSize(value string) uint64 {
+	value = strings.TrimSuffix(value, "B")
+	multiplier := 1.0
+	if i := strings.IndexAny(value, "KMGTPE"); i != -1 && i == len(value)-1 {
+		multiplier = float64(uint64(1) << (10 * (strings.Index("KMGTPE", value[i:]) + 1)))
+		value = value[:i]
+	}
+	size, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+	return uint64(size * multiplier)
+}
+
+// ZPool provides an interface for interacting with ZFS pools.
+type ZPool struct {
+	executor command.Executor
+}
+
+// With creates a new ZPool instance.
+func With(executor command.Executor) ZPool {
+	return ZPool{
+		executor: executor,
+	}
+}
+
+// ListArguments represents the arguments for listing pools.
+type ListArguments struct {
+	// Names limits the listing to the named pools. All pools are listed when
+	// empty.
+	Names []string
+}
+
+// List lists the pools of the host.
+//
+// zpool list -Hp -o name,size,allocated,free,fragmentation,health [pool]...
+func (z ZPool) List(ctx context.Context, args ListArguments) ([]Pool, error) {
+	var cmd strings.Builder
+	cmd.WriteString("zpool list -Hp -o name,size,allocated,free,fragmentation,health")
+	for _, name := range args.Names {
+		cmd.WriteString(fmt.Sprintf(" '%s'", name))
+	}
+
+	result, err := z.executor.Exec(ctx, cmd.String())
+	if err != nil {
+		if result != nil {
+			if strings.Contains(result.Stderr, "no such pool") {
+				return nil, fmt.Errorf("pool does not exist: %s", strings.TrimSpace(result.Stderr))
+			}
+			return nil, fmt.Errorf("failed to list pools: %w, stderr: %s", err, result.Stderr)
+		}
+		return nil, fmt.Errorf("failed to execute command: %w", err)
+	}
+
+	return ParsePools(result.Stdout)
+}
+
+// StatusArguments represents the arguments for getting the status of a pool.
+type StatusArguments struct {
+	Name string
+}
+
+// Status gets the status of a pool.
+//
+// zpool status -p <pool>.
+func (z ZPool) Status(ctx context.Context, args StatusArguments) (Status, error) {
+	cmd := fmt.Sprintf("zpool status -p '%s'", args.Name)
+
+	result, err := z.executor.Exec(ctx, cmd)
+	if err != nil {
+		if result != nil {
+			if strings.Contains(result.Stderr, "no such pool") {
+				return Status{}, fmt.Errorf("pool does not exist: %s", strings.TrimSpace(result.Stderr))
+			}
+			return Status{}, fmt.Errorf("failed to get status of pool '%s': %w, stderr: %s", args.Name, err, result.Stderr)
+		}
+		return Status{}, fmt.Errorf("failed to execute command: %w", err)
+	}
+
+	return ParseStatus(result.Stdout), nil
+}
+
+// ScrubArguments represents the arguments for scrubbing a pool.
+type ScrubArguments struct {
+	Name string
+}
+
+// Scrub starts a scrub of a pool. It fails if the pool is already being
+// scrubbed or resilvered.
+//
+// zpool scrub <pool>.
+func (z ZPool) Scrub(ctx context.Context, args ScrubArguments) error {
+	cmd := fmt.Sprintf("zpool scrub '%s'", args.Name)
+
+	result, err := z.executor.Exec(ctx, cmd)
+	if err != nil {
+		if result != nil {
+			return fmt.Errorf("failed to scrub pool '%s': %w, stderr: %s", args.Name, err, strings.TrimSpace(result.Stderr))
+		}
+		return fmt.Errorf("failed to execute command: %w", err)
+	}
+	return nil
+}
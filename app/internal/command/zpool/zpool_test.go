@@ -0,0 +1,195 @@
+package zpool_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jovulic/zfsilo/app/internal/command/zpool"
+	"github.com/jovulic/zfsilo/lib/command"
+	"github.com/stretchr/testify/require"
+)
+
+var giveHostConfig = command.RemoteExecutorConfig{
+	Address:  "127.0.0.1",
+	Port:     9000,
+	Username: "root",
+	Password: "",
+}
+
+func newTestExecutor(t *testing.T, config command.RemoteExecutorConfig) command.Executor {
+	if testing.Short() {
+		t.Skip("skipping test that requires remote executor in short mode")
+	}
+
+	executor := command.NewRemoteExecutor(config)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := executor.Startup(ctx); err != nil {
+		t.Fatalf("failed to start remote executor for %s:%d: %v", config.Address, config.Port, err)
+	}
+
+	t.Cleanup(func() {
+		executor.Shutdown(context.Background())
+	})
+
+	return executor
+}
+
+func TestParsePools(t *testing.T) {
+	output := "tank\t10670309376\t1437696\t10668871680\t0\tONLINE\n" +
+		"backup\t21340618752\t20000000000\t1340618752\t-\tDEGRADED\n"
+
+	pools, err := zpool.ParsePools(output)
+	require.NoError(t, err)
+	require.Equal(t, []zpool.Pool{
+		{
+			Name:                 "tank",
+			SizeBytes:            10670309376,
+			AllocatedBytes:       1437696,
+			FreeBytes:            10668871680,
+			FragmentationPercent: 0,
+			Health:               zpool.HealthOnline,
+		},
+		{
+			Name:                 "backup",
+			SizeBytes:            21340618752,
+			AllocatedBytes:       20000000000,
+			FreeBytes:            1340618752,
+			FragmentationPercent: 0,
+			Health:               zpool.HealthDegraded,
+		},
+	}, pools)
+
+	_, err = zpool.ParsePools("tank\t1\t2\n")
+	require.Error(t, err)
+}
+
+func TestParseStatus(t *testing.T) {
+	output := `  pool: tank
+ state: DEGRADED
+status: One or more devices could not be used because the label is missing or
+	invalid.  Sufficient replicas exist for the pool to continue
+	functioning in a degraded state.
+action: Replace the device using 'zpool replace'.
+   see: https://openzfs.github.io/openzfs-docs/msg/ZFS-8000-4J
+  scan: scrub repaired 0B in 00:00:01 with 0 errors on Sun Oct 11 00:24:01 2026
+config:
+
+	NAME        STATE     READ WRITE CKSUM
+	tank        DEGRADED     0     0     0
+	  mirror-0  DEGRADED     0     0     2
+	    vdb     ONLINE       0     0     3
+	    vdc     UNAVAIL      4     1     0
+	  vdd       ONLINE       0     0     1
+	spares
+	  vde       AVAIL
+
+errors: No known data errors
+`
+
+	require.Equal(t, zpool.Status{
+		Name:   "tank",
+		State:  zpool.HealthDegraded,
+		Status: "One or more devices could not be used because the label is missing or invalid.  Sufficient replicas exist for the pool to continue functioning in a degraded state.",
+		Action: "Replace the device using 'zpool replace'.",
+		Scan:   "scrub repaired 0B in 00:00:01 with 0 errors on Sun Oct 11 00:24:01 2026",
+		Errors: "No known data errors",
+
+		ReadErrors:     4,
+		WriteErrors:    1,
+		ChecksumErrors: 4,
+	}, zpool.ParseStatus(output))
+}
+
+func TestParseScan(t *testing.T) {
+	tests := []struct {
+		name   string
+		scan   string
+		want   zpool.Scan
+		wantOk bool
+	}{
+		{
+			name: "scrub finished",
+			scan: "scrub repaired 1024 in 00:01:05 with 2 errors on Sun Oct 11 00:24:01 2026",
+			want: zpool.Scan{
+				Function:      zpool.ScanFunctionScrub,
+				State:         zpool.ScanStateFinished,
+				StartTime:     time.Date(2026, 10, 11, 0, 22, 56, 0, time.UTC),
+				EndTime:       time.Date(2026, 10, 11, 0, 24, 1, 0, time.UTC),
+				RepairedBytes: 1024,
+				Errors:        2,
+				PercentDone:   100,
+			},
+			wantOk: true,
+		},
+		{
+			name: "resilver finished over days",
+			scan: "resilvered 1.50G in 1 days 02:00:00 with 0 errors on Sun Oct 11 00:24:01 2026",
+			want: zpool.Scan{
+				Function:      zpool.ScanFunctionResilver,
+				State:         zpool.ScanStateFinished,
+				StartTime:     time.Date(2026, 10, 9, 22, 24, 1, 0, time.UTC),
+				EndTime:       time.Date(2026, 10, 11, 0, 24, 1, 0, time.UTC),
+				RepairedBytes: 1610612736,
+				PercentDone:   100,
+			},
+			wantOk: true,
+		},
+		{
+			name: "resilver in progress",
+			scan: "resilver in progress since Sun Oct 11 00:24:01 2026 1073741824 scanned at 104857600/s, 536870912 issued at 52428800/s, 10737418240 total 268435456 resilvered, 45.30% done, 00:01:00 to go",
+			want: zpool.Scan{
+				Function:      zpool.ScanFunctionResilver,
+				State:         zpool.ScanStateScanning,
+				StartTime:     time.Date(2026, 10, 11, 0, 24, 1, 0, time.UTC),
+				RepairedBytes: 268435456,
+				PercentDone:   45.3,
+			},
+			wantOk: true,
+		},
+		{
+			name: "scrub canceled",
+			scan: "scrub canceled on Sun Oct  4 00:24:01 2026",
+			want: zpool.Scan{
+				Function: zpool.ScanFunctionScrub,
+				State:    zpool.ScanStateCanceled,
+				EndTime:  time.Date(2026, 10, 4, 0, 24, 1, 0, time.UTC),
+			},
+			wantOk: true,
+		},
+		{
+			name:   "never scanned",
+			scan:   "none requested",
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := zpool.ParseScan(tt.scan)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestListAndStatus(t *testing.T) {
+	ctx := context.Background()
+	executor := newTestExecutor(t, giveHostConfig)
+	client := zpool.With(executor)
+
+	pools, err := client.List(ctx, zpool.ListArguments{Names: []string{"tank"}})
+	require.NoError(t, err)
+	require.Len(t, pools, 1)
+	require.Equal(t, "tank", pools[0].Name)
+	require.NotZero(t, pools[0].SizeBytes)
+
+	status, err := client.Status(ctx, zpool.StatusArguments{Name: "tank"})
+	require.NoError(t, err)
+	require.Equal(t, "tank", status.Name)
+	require.Equal(t, pools[0].Health, status.State)
+
+	_, err = client.List(ctx, zpool.ListArguments{Names: []string{"zfsilo-missing"}})
+	require.ErrorContains(t, err, "pool does not exist")
+}
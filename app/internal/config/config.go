@@ -79,13 +79,34 @@ type ConfigHostConnection struct {
 	Remote *ConfigHostConnectionRemote `json:"remote" validate:"required_if=Type REMOTE"`
 }
 
+type ConfigHostNVMeOFPort struct {
+	ID        int    `json:"id"        validate:"min=1"`
+	Address   string `json:"address"   validate:"ip"`
+	ServiceID int    `json:"serviceId" mod:"default=4420" validate:"min=1,max=65535"`
+	TLS       bool   `json:"tls"` // require TLS with a pre-shared key
+}
+
 type ConfigHost struct {
-	ID         string               `json:"id"         validate:"required"`
-	Role       string               `json:"role"       mod:"default=CLIENT" validate:"oneof=CLIENT SERVER"`
-	Connection ConfigHostConnection `json:"connection"`
-	IDs        []string             `json:"ids"        validate:"min=1"` // e.g., IQN, NQN
-	Key        SecretValue          `json:"key"`
-	Endpoint   string               `json:"endpoint"`
+	ID             string                 `json:"id"         validate:"required"`
+	Role           string                 `json:"role"       mod:"default=CLIENT" validate:"oneof=CLIENT SERVER"`
+	Connection     ConfigHostConnection   `json:"connection"`
+	IDs            []string               `json:"ids"        validate:"min=1"` // e.g., IQN, NQN
+	Key            SecretValue            `json:"key"`
+	Endpoint       string                 `json:"endpoint"`
+	DataAddresses  []string               `json:"dataAddresses"`  // addresses targets are served on, for multipath
+	Datasets       []string               `json:"datasets"`       // pools or parent datasets volumes may use
+	ScrubSchedules map[string]string      `json:"scrubSchedules"` // pool name to cron schedule
+	NVMeOFPorts    []ConfigHostNVMeOFPort `json:"nvmeofPorts"`    // ports subsystems are served on
+}
+
+type ConfigObjectStore struct {
+	Endpoint        string      `json:"endpoint"        validate:"required,url"`
+	Region          string      `json:"region"          mod:"default=us-east-1"`
+	Bucket          string      `json:"bucket"          validate:"required"`
+	Prefix          string      `json:"prefix"`
+	AccessKeyID     string      `json:"accessKeyId"     validate:"required"`
+	SecretAccessKey SecretValue `json:"secretAccessKey" validate:"required"`
+	PartSize        int         `json:"partSize"        validate:"omitempty,min=5242880,max=5368709120"` // smallest part size in bytes, grown for large exports
 }
 
 type Config struct {
@@ -105,7 +126,8 @@ type Config struct {
 		DSN           string      `json:"dsn"           validate:"required"`
 		EncryptionKey SecretValue `json:"encryptionKey" validate:"required"`
 	} `json:"database"`
-	Hosts []ConfigHost `json:"hosts"`
+	Hosts       []ConfigHost       `json:"hosts"`
+	ObjectStore *ConfigObjectStore `json:"objectStore"`
 }
 
 func BuildConfig(ctx context.Context, configValue string) (Config, error) {
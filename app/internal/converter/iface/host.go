@@ -18,6 +18,7 @@ type HostConverter interface {
 	//goverter:map ID Id
 	//goverter:map Connection | ConvertHostConnectionFromDBToAPI
 	//goverter:map Identifiers Ids
+	//goverter:map . Status | ConvertHostStatusFromDBToAPI
 	FromDBToAPI(source *database.Host) (*zfsilov1.Host, error)
 	FromDBToAPIList(source []*database.Host) ([]*zfsilov1.Host, error)
 
@@ -25,6 +26,7 @@ type HostConverter interface {
 	//goverter:map Id ID
 	//goverter:map Connection | ConvertHostConnectionFromAPIToDB
 	//goverter:map Ids Identifiers
+	//goverter:ignore Status TLSKeys
 	FromAPIToDB(source *zfsilov1.Host) (*database.Host, error)
 	FromAPIToDBList(source []*zfsilov1.Host) ([]*database.Host, error)
 }
@@ -86,11 +88,17 @@ func ConvertHostRoleFromAPIToDB(source *zfsilov1.Host_Role) datatypes.JSONType[d
 	if server := source.GetServer(); server != nil {
 		dest.Type = database.HostRoleTypeServer
 		dest.Server = &database.HostRoleServer{
-			Endpoint: server.Endpoint,
+			Endpoint:       server.Endpoint,
+			DataAddresses:  server.DataAddresses,
+			Datasets:       server.Datasets,
+			ScrubSchedules: server.ScrubSchedules,
+			NVMeOFPorts:    ConvertHostNVMeOFPortsFromAPIToDB(server.NvmeofPorts),
 		}
 	} else if client := source.GetClient(); client != nil {
 		dest.Type = database.HostRoleTypeClient
-		dest.Client = &database.HostRoleClient{}
+		dest.Client = &database.HostRoleClient{
+			Endpoint: client.Endpoint,
+		}
 	}
 	return datatypes.NewJSONType(dest)
 }
@@ -103,16 +111,129 @@ func ConvertHostRoleFromDBToAPI(source datatypes.JSONType[database.HostRole]) *z
 		if data.Server != nil {
 			dest.Type = &zfsilov1.Host_Role_Server_{
 				Server: &zfsilov1.Host_Role_Server{
-					Endpoint: data.Server.Endpoint,
+					Endpoint:       data.Server.Endpoint,
+					Datasets:       data.Server.Datasets,
+					ScrubSchedules: data.Server.ScrubSchedules,
+					DataAddresses:  data.Server.DataAddresses,
+					NvmeofPorts:    ConvertHostNVMeOFPortsFromDBToAPI(data.Server.NVMeOFPorts),
 				},
 			}
 		}
 	case database.HostRoleTypeClient:
 		if data.Client != nil {
 			dest.Type = &zfsilov1.Host_Role_Client_{
-				Client: &zfsilov1.Host_Role_Client{},
+				Client: &zfsilov1.Host_Role_Client{
+					Endpoint: data.Client.Endpoint,
+				},
 			}
 		}
 	}
 	return dest
 }
+
+func ConvertHostNVMeOFPortsFromAPIToDB(source []*zfsilov1.Host_Role_Server_NvmeofPort) []database.HostNVMeOFPort {
+	var dest []database.HostNVMeOFPort
+	for _, port := range source {
+		dest = append(dest, database.HostNVMeOFPort{
+			ID:        int(port.Id),
+			Address:   port.Address,
+			ServiceID: int(port.ServiceId),
+			TLS:       port.Tls,
+		})
+	}
+	return dest
+}
+
+func ConvertHostNVMeOFPortsFromDBToAPI(source []database.HostNVMeOFPort) []*zfsilov1.Host_Role_Server_NvmeofPort {
+	var dest []*zfsilov1.Host_Role_Server_NvmeofPort
+	for _, port := range source {
+		dest = append(dest, &zfsilov1.Host_Role_Server_NvmeofPort{
+			Id:        int32(port.ID),
+			Address:   port.Address,
+			ServiceId: int32(port.ServiceID),
+			Tls:       port.TLS,
+		})
+	}
+	return dest
+}
+
+// ConvertHostStatusFromDBToAPI maps the status of a host. The status is only
+// ever written by polling the host, so there is no inverse mapping.
+func ConvertHostStatusFromDBToAPI(source *database.Host) (*zfsilov1.Host_Status, error) {
+	data := source.Status.Data()
+	dest := &zfsilov1.Host_Status{
+		LastError: data.LastError,
+	}
+	var err error
+	if dest.LastPollTime, err = ConvertTimeToTimestamp(data.LastPollTime); err != nil {
+		return nil, err
+	}
+	for _, pool := range data.Pools {
+		poolapi, err := ConvertPoolStatusFromDBToAPI(source.Name, pool)
+		if err != nil {
+			return nil, err
+		}
+		dest.Pools = append(dest.Pools, poolapi)
+	}
+	return dest, nil
+}
+
+func ConvertPoolStatusFromDBToAPI(serverHost string, source database.HostPoolStatus) (*zfsilov1.PoolStatus, error) {
+	dest := &zfsilov1.PoolStatus{
+		ServerHost:          serverHost,
+		Name:                source.Name,
+		Health:              ConvertPoolHealthToAPI(source.Health),
+		PreviousHealth:      ConvertPoolHealthToAPI(source.PreviousHealth),
+		Status:              source.Status,
+		Action:              source.Action,
+		Errors:              source.Errors,
+		ReadErrors:          int64(source.ReadErrors),
+		WriteErrors:         int64(source.WriteErrors),
+		ChecksumErrors:      int64(source.ChecksumErrors),
+		ResilverPercentDone: source.ResilverPercentDone,
+		ScrubSchedule:       source.ScrubSchedule,
+		ScrubError:          source.ScrubError,
+	}
+	var err error
+	if dest.PollTime, err = ConvertTimeToTimestamp(source.PollTime); err != nil {
+		return nil, err
+	}
+	if dest.HealthChangeTime, err = ConvertTimeToTimestamp(source.HealthChangeTime); err != nil {
+		return nil, err
+	}
+	if dest.NextScrubTime, err = ConvertTimeToTimestamp(source.NextScrubTime); err != nil {
+		return nil, err
+	}
+	if source.LastScrub.State != "" {
+		dest.LastScrub = &zfsilov1.PoolStatus_Scrub{
+			State:         poolScrubStates[source.LastScrub.State],
+			RepairedBytes: int64(source.LastScrub.RepairedBytes),
+			Errors:        int64(source.LastScrub.Errors),
+			PercentDone:   source.LastScrub.PercentDone,
+		}
+		if dest.LastScrub.StartTime, err = ConvertTimeToTimestamp(source.LastScrub.StartTime); err != nil {
+			return nil, err
+		}
+		if dest.LastScrub.EndTime, err = ConvertTimeToTimestamp(source.LastScrub.EndTime); err != nil {
+			return nil, err
+		}
+	}
+	return dest, nil
+}
+
+var poolScrubStates = map[string]zfsilov1.PoolStatus_Scrub_State{
+	"scanning": zfsilov1.PoolStatus_Scrub_STATE_SCANNING,
+	"finished": zfsilov1.PoolStatus_Scrub_STATE_FINISHED,
+	"canceled": zfsilov1.PoolStatus_Scrub_STATE_CANCELED,
+	"paused":   zfsilov1.PoolStatus_Scrub_STATE_PAUSED,
+}
+
+// ConvertPoolHealthToAPI maps the health of a pool as reported by zpool.
+// Unknown values map to unspecified.
+func ConvertPoolHealthToAPI(source string) zfsilov1.Pool_Health {
+	value, ok := zfsilov1.Pool_Health_value["HEALTH_"+source]
+	if !ok {
+		return zfsilov1.Pool_HEALTH_UNSPECIFIED
+	}
+	return zfsilov1.Pool_Health(value)
+}
@@ -0,0 +1,79 @@
+package converteriface
+
+import (
+	"time"
+
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	"gorm.io/datatypes"
+)
+
+//goverter:converter
+//goverter:output:file ../impl/replication.go
+//goverter:output:package converterimpl
+//goverter:extend ConvertFromJSONToStruct
+//goverter:extend ConvertFromStructToJSON
+//goverter:extend ConvertTimeToTimestamp
+//goverter:extend ConvertTimestampToTime
+//goverter:extend ConvertReplicationStatusFromDBToAPI
+//goverter:extend ConvertReplicationStatusFromAPIToDB
+type ReplicationConverter interface {
+	//goverter:ignore state sizeCache unknownFields
+	//goverter:map ID Id
+	//goverter:map VolumeID VolumeId
+	//goverter:map TargetDatasetID TargetDatasetId
+	FromDBToAPI(source *database.Replication) (*zfsilov1.Replication, error)
+	FromDBToAPIList(source []*database.Replication) ([]*zfsilov1.Replication, error)
+
+	//goverter:useZeroValueOnPointerInconsistency
+	//goverter:map Id ID
+	//goverter:map VolumeId VolumeID
+	//goverter:map TargetDatasetId TargetDatasetID
+	FromAPIToDB(source *zfsilov1.Replication) (*database.Replication, error)
+	FromAPIToDBList(source []*zfsilov1.Replication) ([]*database.Replication, error)
+}
+
+func ConvertReplicationStatusFromDBToAPI(source datatypes.JSONType[database.ReplicationStatus]) (*zfsilov1.Replication_Status, error) {
+	data := source.Data()
+	dest := &zfsilov1.Replication_Status{
+		LastBytesTransferred:  data.LastBytesTransferred,
+		TotalBytesTransferred: data.TotalBytesTransferred,
+		LagSeconds:            int64(data.Lag(time.Now()).Seconds()),
+		LastError:             data.LastError,
+		LastSnapshot:          data.LastSnapshot,
+	}
+	var err error
+	if dest.LastSyncTime, err = ConvertTimeToTimestamp(data.LastSyncTime); err != nil {
+		return nil, err
+	}
+	if dest.LastAttemptTime, err = ConvertTimeToTimestamp(data.LastAttemptTime); err != nil {
+		return nil, err
+	}
+	if dest.LastSnapshotTime, err = ConvertTimeToTimestamp(data.LastSnapshotTime); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+
+func ConvertReplicationStatusFromAPIToDB(source *zfsilov1.Replication_Status) (datatypes.JSONType[database.ReplicationStatus], error) {
+	if source == nil {
+		return datatypes.NewJSONType(database.ReplicationStatus{}), nil
+	}
+	dest := database.ReplicationStatus{
+		LastBytesTransferred:  source.LastBytesTransferred,
+		TotalBytesTransferred: source.TotalBytesTransferred,
+		LastError:             source.LastError,
+		LastSnapshot:          source.LastSnapshot,
+	}
+	var err error
+	if dest.LastSyncTime, err = ConvertTimestampToTime(source.LastSyncTime); err != nil {
+		return datatypes.JSONType[database.ReplicationStatus]{}, err
+	}
+	if dest.LastAttemptTime, err = ConvertTimestampToTime(source.LastAttemptTime); err != nil {
+		return datatypes.JSONType[database.ReplicationStatus]{}, err
+	}
+	if dest.LastSnapshotTime, err = ConvertTimestampToTime(source.LastSnapshotTime); err != nil {
+		return datatypes.JSONType[database.ReplicationStatus]{}, err
+	}
+	return datatypes.NewJSONType(dest), nil
+}
@@ -0,0 +1,29 @@
+package converteriface
+
+import (
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/database"
+)
+
+//goverter:converter
+//goverter:output:file ../impl/snapshot.go
+//goverter:output:package converterimpl
+//goverter:extend ConvertFromJSONToStruct
+//goverter:extend ConvertFromStructToJSON
+//goverter:extend ConvertTimeToTimestamp
+//goverter:extend ConvertTimestampToTime
+type SnapshotConverter interface {
+	//goverter:ignore state sizeCache unknownFields
+	//goverter:map ID Id
+	//goverter:map VolumeID VolumeId
+	//goverter:map DatasetID DatasetId
+	FromDBToAPI(source *database.Snapshot) (*zfsilov1.Snapshot, error)
+	FromDBToAPIList(source []*database.Snapshot) ([]*zfsilov1.Snapshot, error)
+
+	//goverter:useZeroValueOnPointerInconsistency
+	//goverter:map Id ID
+	//goverter:map VolumeId VolumeID
+	//goverter:map DatasetId DatasetID
+	FromAPIToDB(source *zfsilov1.Snapshot) (*database.Snapshot, error)
+	FromAPIToDBList(source []*zfsilov1.Snapshot) ([]*database.Snapshot, error)
+}
@@ -0,0 +1,29 @@
+package converteriface
+
+import (
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/database"
+)
+
+//goverter:converter
+//goverter:output:file ../impl/snapshotgroup.go
+//goverter:output:package converterimpl
+//goverter:extend ConvertFromJSONToStruct
+//goverter:extend ConvertFromStructToJSON
+//goverter:extend ConvertTimeToTimestamp
+//goverter:extend ConvertTimestampToTime
+type SnapshotGroupConverter interface {
+	//goverter:ignore state sizeCache unknownFields
+	//goverter:map ID Id
+	//goverter:map VolumeIDs VolumeIds
+	//goverter:map SnapshotIDs SnapshotIds
+	FromDBToAPI(source *database.SnapshotGroup) (*zfsilov1.SnapshotGroup, error)
+	FromDBToAPIList(source []*database.SnapshotGroup) ([]*zfsilov1.SnapshotGroup, error)
+
+	//goverter:useZeroValueOnPointerInconsistency
+	//goverter:map Id ID
+	//goverter:map VolumeIds VolumeIDs
+	//goverter:map SnapshotIds SnapshotIDs
+	FromAPIToDB(source *zfsilov1.SnapshotGroup) (*database.SnapshotGroup, error)
+	FromAPIToDBList(source []*zfsilov1.SnapshotGroup) ([]*database.SnapshotGroup, error)
+}
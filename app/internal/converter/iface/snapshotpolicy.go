@@ -0,0 +1,37 @@
+package converteriface
+
+import (
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/database"
+)
+
+//goverter:converter
+//goverter:output:file ../impl/snapshotpolicy.go
+//goverter:output:package converterimpl
+//goverter:extend ConvertFromJSONToStruct
+//goverter:extend ConvertFromStructToJSON
+//goverter:extend ConvertTimeToTimestamp
+//goverter:extend ConvertTimestampToTime
+//goverter:extend ConvertSnapshotPolicyRunOutcomeFromDBToAPI
+type SnapshotPolicyConverter interface {
+	//goverter:ignore state sizeCache unknownFields
+	//goverter:map ID Id
+	FromDBToAPI(source *database.SnapshotPolicy) (*zfsilov1.SnapshotPolicy, error)
+	FromDBToAPIList(source []*database.SnapshotPolicy) ([]*zfsilov1.SnapshotPolicy, error)
+
+	//goverter:useZeroValueOnPointerInconsistency
+	//goverter:map Id ID
+	FromAPIToDB(source *zfsilov1.SnapshotPolicy) (*database.SnapshotPolicy, error)
+	FromAPIToDBList(source []*zfsilov1.SnapshotPolicy) ([]*database.SnapshotPolicy, error)
+
+	//goverter:ignore state sizeCache unknownFields
+	//goverter:map VolumeID VolumeId
+	//goverter:map SnapshotPolicyID SnapshotPolicyId
+	//goverter:map SnapshotID SnapshotId
+	RunFromDBToAPI(source *database.SnapshotPolicyRun) (*zfsilov1.SnapshotPolicyRun, error)
+	RunFromDBToAPIList(source []*database.SnapshotPolicyRun) ([]*zfsilov1.SnapshotPolicyRun, error)
+}
+
+func ConvertSnapshotPolicyRunOutcomeFromDBToAPI(source database.SnapshotPolicyRunOutcome) zfsilov1.SnapshotPolicyRun_Outcome {
+	return zfsilov1.SnapshotPolicyRun_Outcome(source)
+}
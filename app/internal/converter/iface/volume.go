@@ -1,6 +1,8 @@
 package converteriface
 
 import (
+	"strings"
+
 	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
 	"github.com/jovulic/zfsilo/app/internal/database"
 	"gorm.io/datatypes"
@@ -23,6 +25,9 @@ type VolumeConverter interface {
 	//goverter:map Mode | ConvertVolumeModeFromDBToAPI
 	//goverter:map Status | ConvertVolumeStatusFromDBToAPI
 	//goverter:map Transport | ConvertVolumeTransportFromDBToAPI
+	//goverter:map FSType FsType | ConvertVolumeFSTypeFromDBToAPI
+	//goverter:map Attachments | ConvertVolumeAttachmentsFromDBToAPI
+	//goverter:map Transport Tls | ConvertVolumeTLSFromDBToAPI
 	FromDBToAPI(source *database.Volume) (*zfsilov1.Volume, error)
 	FromDBToAPIList(source []*database.Volume) ([]*zfsilov1.Volume, error)
 
@@ -33,6 +38,9 @@ type VolumeConverter interface {
 	//goverter:map Mode | ConvertVolumeModeFromAPIToDB
 	//goverter:map Status | ConvertVolumeStatusFromAPIToDB
 	//goverter:map Transport | ConvertVolumeTransportFromAPIToDB
+	//goverter:map FsType FSType | ConvertVolumeFSTypeFromAPIToDB
+	//goverter:map Attachments | ConvertVolumeAttachmentsFromAPIToDB
+	//goverter:ignore EncryptionKey
 	FromAPIToDB(source *zfsilov1.Volume) (*database.Volume, error)
 	FromAPIToDBList(source []*zfsilov1.Volume) ([]*database.Volume, error)
 }
@@ -67,6 +75,14 @@ func ConvertVolumeModeFromDBToAPI(source database.VolumeMode) zfsilov1.Volume_Mo
 	return zfsilov1.Volume_Mode(source)
 }
 
+func ConvertVolumeFSTypeFromAPIToDB(source zfsilov1.Volume_FSType) database.VolumeFSType {
+	return database.VolumeFSType(source)
+}
+
+func ConvertVolumeFSTypeFromDBToAPI(source database.VolumeFSType) zfsilov1.Volume_FSType {
+	return zfsilov1.Volume_FSType(source)
+}
+
 func ConvertVolumeStatusFromAPIToDB(source zfsilov1.Volume_Status) database.VolumeStatus {
 	return database.VolumeStatus(source)
 }
@@ -85,6 +101,8 @@ func ConvertVolumeTransportFromAPIToDB(source *zfsilov1.Volume_Transport) dataty
 			transport.Type = database.VolumeTransportTypeISCSI
 		case zfsilov1.Volume_TRANSPORT_NVMEOF_TCP:
 			transport.Type = database.VolumeTransportTypeNVMEOF_TCP
+		case zfsilov1.Volume_TRANSPORT_NFS:
+			transport.Type = database.VolumeTransportTypeNFS
 		case zfsilov1.Volume_TRANSPORT_UNSPECIFIED:
 			transport.Type = database.VolumeTransportTypeUNSPECIFIED
 		}
@@ -100,6 +118,8 @@ func ConvertVolumeTransportFromDBToAPI(source datatypes.JSONType[database.Volume
 		transport = zfsilov1.Volume_TRANSPORT_ISCSI
 	case database.VolumeTransportTypeNVMEOF_TCP:
 		transport = zfsilov1.Volume_TRANSPORT_NVMEOF_TCP
+	case database.VolumeTransportTypeNFS:
+		transport = zfsilov1.Volume_TRANSPORT_NFS
 	case database.VolumeTransportTypeUNSPECIFIED:
 		transport = zfsilov1.Volume_TRANSPORT_UNSPECIFIED
 	default:
@@ -107,3 +127,47 @@ func ConvertVolumeTransportFromDBToAPI(source datatypes.JSONType[database.Volume
 	}
 	return &transport
 }
+
+func ConvertVolumeTLSFromDBToAPI(source datatypes.JSONType[database.VolumeTransport]) *bool {
+	data := source.Data()
+	tls := data.NVMEOF != nil && data.NVMEOF.TLS
+	return &tls
+}
+
+func ConvertVolumeAttachmentsFromAPIToDB(source []*zfsilov1.Volume_Attachment) datatypes.JSONSlice[database.VolumeAttachment] {
+	var destination datatypes.JSONSlice[database.VolumeAttachment]
+	for _, item := range source {
+		destination = append(destination, database.VolumeAttachment{
+			ClientHost:          item.ClientHost,
+			ReadOnly:            item.ReadOnly,
+			StagingPath:         item.StagingPath,
+			TargetPaths:         item.TargetPaths,
+			ReadOnlyTargetPaths: item.ReadOnlyTargetPaths,
+		})
+	}
+	return destination
+}
+
+func ConvertVolumeAttachmentsFromDBToAPI(source datatypes.JSONSlice[database.VolumeAttachment]) []*zfsilov1.Volume_Attachment {
+	var destination []*zfsilov1.Volume_Attachment
+	for _, item := range source {
+		destination = append(destination, &zfsilov1.Volume_Attachment{
+			ClientHost:          item.ClientHost,
+			ReadOnly:            item.ReadOnly,
+			StagingPath:         item.StagingPath,
+			TargetPaths:         item.TargetPaths,
+			ReadOnlyTargetPaths: item.ReadOnlyTargetPaths,
+		})
+	}
+	return destination
+}
+
+// ConvertVolumePropertySourceToAPI maps the source of a property as reported
+// by zfs. Unknown values map to unspecified.
+func ConvertVolumePropertySourceToAPI(source string) zfsilov1.VolumeProperty_Source {
+	value, ok := zfsilov1.VolumeProperty_Source_value["SOURCE_"+strings.ToUpper(source)]
+	if !ok {
+		return zfsilov1.VolumeProperty_SOURCE_UNSPECIFIED
+	}
+	return zfsilov1.VolumeProperty_Source(value)
+}
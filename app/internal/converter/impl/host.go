@@ -72,6 +72,11 @@ func (c *HostConverterImpl) FromDBToAPI(source *database.Host) (*v1.Host, error)
 		zfsilov1Host.Key = (*source).Key
 		zfsilov1Host.Role = iface.ConvertHostRoleFromDBToAPI((*source).Role)
 		zfsilov1Host.ByConfig = (*source).ByConfig
+		pZfsilov1Host_Status, err := iface.ConvertHostStatusFromDBToAPI(source)
+		if err != nil {
+			return nil, err
+		}
+		zfsilov1Host.Status = pZfsilov1Host_Status
 		pZfsilov1Host = &zfsilov1Host
 	}
 	return pZfsilov1Host, nil
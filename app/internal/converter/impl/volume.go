@@ -44,14 +44,30 @@ func (c *VolumeConverterImpl) FromAPIToDB(source *v1.Volume) (*database.Volume,
 		if (*source).ServerHost != nil {
 			databaseVolume.ServerHost = *(*source).ServerHost
 		}
-		if (*source).ClientHost != nil {
-			databaseVolume.ClientHost = *(*source).ClientHost
-		}
 		databaseVolume.Transport = iface.ConvertVolumeTransportFromAPIToDB((*source).Transport)
-		if (*source).StagingPath != nil {
-			databaseVolume.StagingPath = *(*source).StagingPath
+		databaseVolume.Attachments = iface.ConvertVolumeAttachmentsFromAPIToDB((*source).Attachments)
+		if (*source).SourceSnapshot != nil {
+			databaseVolume.SourceSnapshot = *(*source).SourceSnapshot
+		}
+		if (*source).Promote != nil {
+			databaseVolume.Promote = *(*source).Promote
+		}
+		if (*source).SourceVolume != nil {
+			databaseVolume.SourceVolume = *(*source).SourceVolume
+		}
+		if (*source).SnapshotPolicy != nil {
+			databaseVolume.SnapshotPolicy = *(*source).SnapshotPolicy
+		}
+		if (*source).StandbyHost != nil {
+			databaseVolume.StandbyHost = *(*source).StandbyHost
+		}
+		databaseVolume.FencedHosts = c.stringListToDatatypesJSONSlice((*source).FencedHosts)
+		if (*source).Encrypted != nil {
+			databaseVolume.Encrypted = *(*source).Encrypted
 		}
-		databaseVolume.TargetPaths = c.stringListToDatatypesJSONSlice((*source).TargetPaths)
+		databaseVolume.FSType = iface.ConvertVolumeFSTypeFromAPIToDB((*source).FsType)
+		databaseVolume.MkfsOptions = c.stringListToDatatypesJSONSlice((*source).MkfsOptions)
+		databaseVolume.MountOptions = c.stringListToDatatypesJSONSlice((*source).MountOptions)
 		pDatabaseVolume = &databaseVolume
 	}
 	return pDatabaseVolume, nil
@@ -101,11 +117,24 @@ func (c *VolumeConverterImpl) FromDBToAPI(source *database.Volume) (*v1.Volume,
 		zfsilov1Volume.Transport = iface.ConvertVolumeTransportFromDBToAPI((*source).Transport)
 		pString := (*source).ServerHost
 		zfsilov1Volume.ServerHost = &pString
-		pString2 := (*source).ClientHost
-		zfsilov1Volume.ClientHost = &pString2
-		pString3 := (*source).StagingPath
-		zfsilov1Volume.StagingPath = &pString3
-		zfsilov1Volume.TargetPaths = c.datatypesJSONSliceToStringList((*source).TargetPaths)
+		pString2 := (*source).SourceSnapshot
+		zfsilov1Volume.SourceSnapshot = &pString2
+		pBool2 := (*source).Promote
+		zfsilov1Volume.Promote = &pBool2
+		pString3 := (*source).SourceVolume
+		zfsilov1Volume.SourceVolume = &pString3
+		pString4 := (*source).SnapshotPolicy
+		zfsilov1Volume.SnapshotPolicy = &pString4
+		pString5 := (*source).StandbyHost
+		zfsilov1Volume.StandbyHost = &pString5
+		zfsilov1Volume.FencedHosts = c.datatypesJSONSliceToStringList((*source).FencedHosts)
+		pBool3 := (*source).Encrypted
+		zfsilov1Volume.Encrypted = &pBool3
+		zfsilov1Volume.FsType = iface.ConvertVolumeFSTypeFromDBToAPI((*source).FSType)
+		zfsilov1Volume.MkfsOptions = c.datatypesJSONSliceToStringList((*source).MkfsOptions)
+		zfsilov1Volume.MountOptions = c.datatypesJSONSliceToStringList((*source).MountOptions)
+		zfsilov1Volume.Attachments = iface.ConvertVolumeAttachmentsFromDBToAPI((*source).Attachments)
+		zfsilov1Volume.Tls = iface.ConvertVolumeTLSFromDBToAPI((*source).Transport)
 		pZfsilov1Volume = &zfsilov1Volume
 	}
 	return pZfsilov1Volume, nil
@@ -33,10 +33,25 @@ func TestVolumeConversion(t *testing.T) {
 		Transport: datatypes.NewJSONType(database.VolumeTransport{
 			Type: database.VolumeTransportTypeISCSI,
 		}),
-		ServerHost:    "hosts/hst-server",
-		ClientHost:    "hosts/hst-client",
-		StagingPath:   "/mnt/vol",
-		TargetPaths:   datatypes.JSONSlice[string]{"/var/lib/kubelet/pods/pod1/volumes/vol1"},
+		ServerHost: "hosts/hst-server",
+		Attachments: datatypes.JSONSlice[database.VolumeAttachment]{
+			{
+				ClientHost:          "hosts/hst-client",
+				ReadOnly:            true,
+				StagingPath:         "/mnt/vol",
+				TargetPaths:         []string{"/var/lib/kubelet/pods/pod1/volumes/vol1"},
+				ReadOnlyTargetPaths: []string{"/var/lib/kubelet/pods/pod1/volumes/vol1"},
+			},
+		},
+		SourceSnapshot: "snp-12345",
+		Promote:        true,
+		SourceVolume:   "vol-67890",
+		SnapshotPolicy: "spl-12345",
+		StandbyHost:    "hosts/hst-standby",
+		FencedHosts:    datatypes.JSONSlice[string]{"hosts/hst-fenced"},
+		FSType:         database.VolumeFSTypeXFS,
+		MkfsOptions:    datatypes.JSONSlice[string]{"-K"},
+		MountOptions:   datatypes.JSONSlice[string]{"noatime"},
 		Options: datatypes.NewJSONType(database.VolumeOptionList{
 			{Key: "snap", Value: "true"},
 			{Key: "atime", Value: "off"},
@@ -56,9 +71,24 @@ func TestVolumeConversion(t *testing.T) {
 		Status:        zfsilov1.Volume_STATUS_INITIAL,
 		Transport:     zfsilov1.Volume_TRANSPORT_ISCSI.Enum(),
 		ServerHost:    proto.String("hosts/hst-server"),
-		ClientHost:    proto.String("hosts/hst-client"),
-		StagingPath:   proto.String("/mnt/vol"),
-		TargetPaths:   []string{"/var/lib/kubelet/pods/pod1/volumes/vol1"},
+		Attachments: []*zfsilov1.Volume_Attachment{
+			{
+				ClientHost:          "hosts/hst-client",
+				ReadOnly:            true,
+				StagingPath:         "/mnt/vol",
+				TargetPaths:         []string{"/var/lib/kubelet/pods/pod1/volumes/vol1"},
+				ReadOnlyTargetPaths: []string{"/var/lib/kubelet/pods/pod1/volumes/vol1"},
+			},
+		},
+		SourceSnapshot: proto.String("snp-12345"),
+		Promote:        proto.Bool(true),
+		SourceVolume:   proto.String("vol-67890"),
+		SnapshotPolicy: proto.String("spl-12345"),
+		StandbyHost:    proto.String("hosts/hst-standby"),
+		FencedHosts:    []string{"hosts/hst-fenced"},
+		FsType:         zfsilov1.Volume_FS_TYPE_XFS,
+		MkfsOptions:    []string{"-K"},
+		MountOptions:   []string{"noatime"},
 		Options: []*zfsilov1.Volume_Option{
 			{Key: "snap", Value: "true"},
 			{Key: "atime", Value: "off"},
@@ -86,9 +116,16 @@ func TestVolumeConversion(t *testing.T) {
 		require.Equal(t, expectedAPIVolume.Status, actualAPIVolume.Status)
 		require.Equal(t, *expectedAPIVolume.Transport, *actualAPIVolume.Transport)
 		require.Equal(t, *expectedAPIVolume.ServerHost, *actualAPIVolume.ServerHost)
-		require.Equal(t, *expectedAPIVolume.ClientHost, *actualAPIVolume.ClientHost)
-		require.Equal(t, *expectedAPIVolume.StagingPath, *actualAPIVolume.StagingPath)
-		require.Equal(t, expectedAPIVolume.TargetPaths, actualAPIVolume.TargetPaths)
+		require.Equal(t, expectedAPIVolume.Attachments, actualAPIVolume.Attachments)
+		require.Equal(t, *expectedAPIVolume.SourceSnapshot, *actualAPIVolume.SourceSnapshot)
+		require.Equal(t, *expectedAPIVolume.Promote, *actualAPIVolume.Promote)
+		require.Equal(t, *expectedAPIVolume.SourceVolume, *actualAPIVolume.SourceVolume)
+		require.Equal(t, *expectedAPIVolume.SnapshotPolicy, *actualAPIVolume.SnapshotPolicy)
+		require.Equal(t, *expectedAPIVolume.StandbyHost, *actualAPIVolume.StandbyHost)
+		require.Equal(t, expectedAPIVolume.FencedHosts, actualAPIVolume.FencedHosts)
+		require.Equal(t, expectedAPIVolume.FsType, actualAPIVolume.FsType)
+		require.Equal(t, expectedAPIVolume.MkfsOptions, actualAPIVolume.MkfsOptions)
+		require.Equal(t, expectedAPIVolume.MountOptions, actualAPIVolume.MountOptions)
 		require.True(t, expectedAPIVolume.CreateTime.AsTime().Equal(actualAPIVolume.CreateTime.AsTime()))
 		require.True(t, expectedAPIVolume.UpdateTime.AsTime().Equal(actualAPIVolume.UpdateTime.AsTime()))
 		require.ElementsMatch(t, expectedAPIVolume.Options, actualAPIVolume.Options)
@@ -110,9 +147,16 @@ func TestVolumeConversion(t *testing.T) {
 		require.Equal(t, dbVolume.Status, actualDBVolume.Status)
 		require.Equal(t, dbVolume.Transport, actualDBVolume.Transport)
 		require.Equal(t, dbVolume.ServerHost, actualDBVolume.ServerHost)
-		require.Equal(t, dbVolume.ClientHost, actualDBVolume.ClientHost)
-		require.Equal(t, dbVolume.StagingPath, actualDBVolume.StagingPath)
-		require.Equal(t, dbVolume.TargetPaths, actualDBVolume.TargetPaths)
+		require.Equal(t, dbVolume.Attachments, actualDBVolume.Attachments)
+		require.Equal(t, dbVolume.SourceSnapshot, actualDBVolume.SourceSnapshot)
+		require.Equal(t, dbVolume.Promote, actualDBVolume.Promote)
+		require.Equal(t, dbVolume.SourceVolume, actualDBVolume.SourceVolume)
+		require.Equal(t, dbVolume.SnapshotPolicy, actualDBVolume.SnapshotPolicy)
+		require.Equal(t, dbVolume.StandbyHost, actualDBVolume.StandbyHost)
+		require.Equal(t, dbVolume.FencedHosts, actualDBVolume.FencedHosts)
+		require.Equal(t, dbVolume.FSType, actualDBVolume.FSType)
+		require.Equal(t, dbVolume.MkfsOptions, actualDBVolume.MkfsOptions)
+		require.Equal(t, dbVolume.MountOptions, actualDBVolume.MountOptions)
 
 		// Timestamps can have timezone differences, so comparing them with Equal
 		// is best.
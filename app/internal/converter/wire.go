@@ -9,6 +9,10 @@ import (
 var WireSet = wire.NewSet(
 	WireVolumeConverter,
 	WireHostConverter,
+	WireSnapshotConverter,
+	WireSnapshotPolicyConverter,
+	WireReplicationConverter,
+	WireSnapshotGroupConverter,
 )
 
 func WireVolumeConverter() converteriface.VolumeConverter {
@@ -18,3 +22,19 @@ func WireVolumeConverter() converteriface.VolumeConverter {
 func WireHostConverter() converteriface.HostConverter {
 	return &converterimpl.HostConverterImpl{}
 }
+
+func WireSnapshotConverter() converteriface.SnapshotConverter {
+	return &converterimpl.SnapshotConverterImpl{}
+}
+
+func WireSnapshotPolicyConverter() converteriface.SnapshotPolicyConverter {
+	return &converterimpl.SnapshotPolicyConverterImpl{}
+}
+
+func WireReplicationConverter() converteriface.ReplicationConverter {
+	return &converterimpl.ReplicationConverterImpl{}
+}
+
+func WireSnapshotGroupConverter() converteriface.SnapshotGroupConverter {
+	return &converterimpl.SnapshotGroupConverterImpl{}
+}
@@ -64,6 +64,23 @@ func TestEncryption(t *testing.T) {
 		err = db.Raw("SELECT connection FROM hosts WHERE id = ?", "host-1").Scan(&rawConnection).Error
 		assert.NoError(t, err)
 		assert.NotContains(t, rawConnection, plainPassword)
+
+		// Update the TLS keys alone.
+		plainTLSKey := "NVMeTLSkey-1:01:secret:"
+		retrieved.SetTLSKey("client-1", plainTLSKey)
+		err = db.Model(&retrieved).Select("tls_keys").Updates(&retrieved).Error
+		assert.NoError(t, err)
+		assert.Equal(t, plainTLSKey, retrieved.TLSKey("client-1"))
+
+		err = db.First(&retrieved, "id = ?", "host-1").Error
+		assert.NoError(t, err)
+		assert.Equal(t, plainTLSKey, retrieved.TLSKey("client-1"))
+		assert.Equal(t, plainPassword, retrieved.Key)
+
+		var rawTLSKeys string
+		err = db.Raw("SELECT tls_keys FROM hosts WHERE id = ?", "host-1").Scan(&rawTLSKeys).Error
+		assert.NoError(t, err)
+		assert.NotContains(t, rawTLSKeys, plainTLSKey)
 	})
 
 	t.Run("Volume encryption", func(t *testing.T) {
@@ -92,6 +109,7 @@ func TestEncryption(t *testing.T) {
 		err = db.First(&retrieved, "id = ?", "vol-1").Error
 		assert.NoError(t, err)
 		assert.Equal(t, plainPassword, retrieved.Transport.Data().ISCSI.TargetPassword)
+		assert.Equal(t, plainPassword, retrieved.Transport.Data().ISCSI.InitiatorPassword)
 
 		// Check raw database content.
 		var rawTransport string
@@ -99,4 +117,64 @@ func TestEncryption(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotContains(t, rawTransport, plainPassword)
 	})
+
+	t.Run("Volume NVMe-oF encryption", func(t *testing.T) {
+		plainPassword := "nvmeof-secret"
+		volume := &database.Volume{
+			ID:            "vol-3",
+			CapacityBytes: 1024 * 1024,
+			Transport: datatypes.NewJSONType(database.VolumeTransport{
+				Type: database.VolumeTransportTypeNVMEOF_TCP,
+				NVMEOF: &database.VolumeTransportNVMEOF{
+					TargetPassword:    plainPassword,
+					InitiatorPassword: plainPassword,
+				},
+			}),
+		}
+
+		err := db.Create(volume).Error
+		assert.NoError(t, err)
+
+		var retrieved database.Volume
+		err = db.First(&retrieved, "id = ?", "vol-3").Error
+		assert.NoError(t, err)
+		assert.Equal(t, plainPassword, retrieved.Transport.Data().NVMEOF.TargetPassword)
+		assert.Equal(t, plainPassword, retrieved.Transport.Data().NVMEOF.InitiatorPassword)
+
+		var rawTransport string
+		err = db.Raw("SELECT transport FROM volumes WHERE id = ?", "vol-3").Scan(&rawTransport).Error
+		assert.NoError(t, err)
+		assert.NotContains(t, rawTransport, plainPassword)
+	})
+
+	t.Run("Volume encryption key", func(t *testing.T) {
+		plainKey, err := database.GenerateEncryptionKey()
+		assert.NoError(t, err)
+		volume := &database.Volume{
+			ID:            "vol-2",
+			CapacityBytes: 1024 * 1024,
+			Encrypted:     true,
+			EncryptionKey: plainKey,
+		}
+
+		// Create.
+		err = db.Create(volume).Error
+		assert.NoError(t, err)
+		assert.Equal(t, plainKey, volume.EncryptionKey)
+
+		// Read back.
+		var retrieved database.Volume
+		err = db.First(&retrieved, "id = ?", "vol-2").Error
+		assert.NoError(t, err)
+		assert.Equal(t, plainKey, retrieved.EncryptionKey)
+		rawKey, err := retrieved.RawEncryptionKey()
+		assert.NoError(t, err)
+		assert.Len(t, rawKey, 32)
+
+		// Check raw database content.
+		var rawEncryptionKey string
+		err = db.Raw("SELECT encryption_key FROM volumes WHERE id = ?", "vol-2").Scan(&rawEncryptionKey).Error
+		assert.NoError(t, err)
+		assert.NotEqual(t, plainKey, rawEncryptionKey)
+	})
 }
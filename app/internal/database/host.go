@@ -3,6 +3,10 @@ package database
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -42,11 +46,36 @@ const (
 	HostRoleTypeClient HostRoleType = "CLIENT"
 )
 
+// nvmeofServiceID is the TCP port NVMe-oF ports listen on by default.
+const nvmeofServiceID = 4420
+
+// HostNVMeOFPort is an nvmet port of a server host that subsystems are served
+// on. An unspecified address, such as 0.0.0.0 or ::, listens on every address
+// of its family.
+type HostNVMeOFPort struct {
+	ID        int    `json:"id"`
+	Address   string `json:"address"`
+	ServiceID int    `json:"serviceId,omitempty"`
+	// TLS requires hosts to connect to the port over TLS with a pre-shared
+	// key. Volumes published with TLS are served only on such ports.
+	TLS bool `json:"tls,omitempty"`
+}
+
 type HostRoleServer struct {
 	Endpoint string `json:"endpoint"`
+	// DataAddresses are the addresses targets are served on, each reached
+	// over its own network path. The endpoint is used when there are none.
+	DataAddresses  []string          `json:"dataAddresses,omitempty"`
+	Datasets       []string          `json:"datasets,omitempty"`
+	ScrubSchedules map[string]string `json:"scrubSchedules,omitempty"`
+	// NVMeOFPorts are the nvmet ports subsystems are served on. They are
+	// derived from the data addresses when there are none.
+	NVMeOFPorts []HostNVMeOFPort `json:"nvmeofPorts,omitempty"`
 }
 
-type HostRoleClient struct{}
+type HostRoleClient struct {
+	Endpoint string `json:"endpoint,omitempty"`
+}
 
 type HostRole struct {
 	Type   HostRoleType    `json:"type"`
@@ -54,6 +83,50 @@ type HostRole struct {
 	Client *HostRoleClient `json:"client,omitempty"`
 }
 
+type HostPoolScrub struct {
+	State         string    `json:"state,omitempty"`
+	StartTime     time.Time `json:"startTime,omitzero"`
+	EndTime       time.Time `json:"endTime,omitzero"`
+	RepairedBytes uint64    `json:"repairedBytes,omitempty"`
+	Errors        uint64    `json:"errors,omitempty"`
+	PercentDone   float64   `json:"percentDone,omitempty"`
+}
+
+type HostPoolStatus struct {
+	Name                string        `json:"name"`
+	PollTime            time.Time     `json:"pollTime,omitzero"`
+	Health              string        `json:"health"`
+	PreviousHealth      string        `json:"previousHealth,omitempty"`
+	HealthChangeTime    time.Time     `json:"healthChangeTime,omitzero"`
+	Status              string        `json:"status,omitempty"`
+	Action              string        `json:"action,omitempty"`
+	Errors              string        `json:"errors,omitempty"`
+	ReadErrors          uint64        `json:"readErrors,omitempty"`
+	WriteErrors         uint64        `json:"writeErrors,omitempty"`
+	ChecksumErrors      uint64        `json:"checksumErrors,omitempty"`
+	ResilverPercentDone float64       `json:"resilverPercentDone,omitempty"`
+	LastScrub           HostPoolScrub `json:"lastScrub,omitzero"`
+	ScrubSchedule       string        `json:"scrubSchedule,omitempty"`
+	NextScrubTime       time.Time     `json:"nextScrubTime,omitzero"`
+	ScrubError          string        `json:"scrubError,omitempty"`
+}
+
+type HostStatus struct {
+	LastPollTime time.Time        `json:"lastPollTime,omitzero"`
+	LastError    string           `json:"lastError,omitempty"`
+	Pools        []HostPoolStatus `json:"pools,omitempty"`
+}
+
+// Pool returns the status of the named pool, if it has been polled.
+func (s HostStatus) Pool(name string) (HostPoolStatus, bool) {
+	for _, pool := range s.Pools {
+		if pool.Name == name {
+			return pool, true
+		}
+	}
+	return HostPoolStatus{}, false
+}
+
 type Host struct {
 	CreateTime  time.Time `gorm:"autoCreateTime"`
 	UpdateTime  time.Time `gorm:"autoUpdateTime"`
@@ -64,24 +137,22 @@ type Host struct {
 	Identifiers datatypes.JSONSlice[string]
 	Key         string
 	ByConfig    bool
+	Status      datatypes.JSONType[HostStatus]
+	// TLSKeys are the NVMe/TCP TLS pre-shared keys of a server host, keyed by
+	// the name of the client host they are shared with.
+	TLSKeys datatypes.JSONType[map[string]string]
 }
 
+// BeforeSave and AfterSave run on updates as well as on creates, so there
+// are no update hooks, which would process the secrets a second time.
 func (h *Host) BeforeSave(tx *gorm.DB) error {
 	return h.process(true)
 }
 
-func (h *Host) BeforeUpdate(tx *gorm.DB) error {
-	return h.process(true)
-}
-
 func (h *Host) AfterSave(tx *gorm.DB) error {
 	return h.process(false)
 }
 
-func (h *Host) AfterUpdate(tx *gorm.DB) error {
-	return h.process(false)
-}
-
 func (h *Host) AfterFind(tx *gorm.DB) error {
 	return h.process(false)
 }
@@ -104,6 +175,146 @@ func (h *Host) NQN() (string, error) {
 	return "", errors.New("no nqn defined")
 }
 
+// Address returns the data plane address of the host. It is the endpoint of
+// the host role when one is set, and otherwise the address the host is reached
+// at remotely.
+func (h *Host) Address() (string, error) {
+	role := h.Role.Data()
+	switch {
+	case role.Server != nil && role.Server.Endpoint != "":
+		return role.Server.Endpoint, nil
+	case role.Client != nil && role.Client.Endpoint != "":
+		return role.Client.Endpoint, nil
+	}
+	conn := h.Connection.Data()
+	if conn.Remote != nil && conn.Remote.Address != "" {
+		return conn.Remote.Address, nil
+	}
+	return "", errors.New("no address defined")
+}
+
+// DataAddresses returns the addresses the server host serves targets on.
+func (h *Host) DataAddresses() []string {
+	role := h.Role.Data()
+	switch {
+	case role.Server == nil:
+		return nil
+	case len(role.Server.DataAddresses) > 0:
+		return role.Server.DataAddresses
+	default:
+		return []string{role.Server.Endpoint}
+	}
+}
+
+// NVMeOFPorts returns the nvmet ports the server host serves subsystems on.
+// Without configured ports, a port is derived for each data address when the
+// host has several, and otherwise a single port listens on every address.
+func (h *Host) NVMeOFPorts() []HostNVMeOFPort {
+	role := h.Role.Data()
+	if role.Server == nil {
+		return nil
+	}
+
+	var ports []HostNVMeOFPort
+	switch {
+	case len(role.Server.NVMeOFPorts) > 0:
+		ports = slices.Clone(role.Server.NVMeOFPorts)
+	case len(role.Server.DataAddresses) > 1:
+		for i, address := range role.Server.DataAddresses {
+			port := HostNVMeOFPort{ID: i + 1, Address: strings.Trim(address, "[]")}
+			if host, service, err := net.SplitHostPort(address); err == nil {
+				port.Address = host
+				port.ServiceID, _ = strconv.Atoi(service)
+			}
+			ports = append(ports, port)
+		}
+	default:
+		ports = []HostNVMeOFPort{{ID: 1, Address: "0.0.0.0"}}
+	}
+	for i := range ports {
+		if ports[i].ServiceID == 0 {
+			ports[i].ServiceID = nvmeofServiceID
+		}
+	}
+	return ports
+}
+
+// NVMeOFAddresses returns the addresses clients connect to subsystems
+// through, over TLS or not. They are the addresses of the ports that listen on
+// a single address, or the data addresses on the service ID of the first port
+// when every port listens on all addresses.
+func (h *Host) NVMeOFAddresses(tls bool) []string {
+	ports := slices.DeleteFunc(h.NVMeOFPorts(), func(port HostNVMeOFPort) bool {
+		return port.TLS != tls
+	})
+	if len(ports) == 0 {
+		return nil
+	}
+
+	var addresses []string
+	for _, port := range ports {
+		if ip := net.ParseIP(port.Address); ip != nil && ip.IsUnspecified() {
+			continue
+		}
+		addresses = append(addresses, net.JoinHostPort(port.Address, strconv.Itoa(port.ServiceID)))
+	}
+	if len(addresses) > 0 {
+		return addresses
+	}
+
+	for _, address := range h.DataAddresses() {
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			address = net.JoinHostPort(strings.Trim(address, "[]"), strconv.Itoa(ports[0].ServiceID))
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses
+}
+
+// TLSKey returns the TLS pre-shared key the server host shares with the
+// client host, if one has been generated.
+func (h *Host) TLSKey(clientHost string) string {
+	return h.TLSKeys.Data()[clientHost]
+}
+
+// SetTLSKey sets the TLS pre-shared key the server host shares with the
+// client host.
+func (h *Host) SetTLSKey(clientHost string, key string) {
+	keys := maps.Clone(h.TLSKeys.Data())
+	if keys == nil {
+		keys = make(map[string]string)
+	}
+	keys[clientHost] = key
+	h.TLSKeys = datatypes.NewJSONType(keys)
+}
+
+// DeleteTLSKey deletes the TLS pre-shared key the server host shares with the
+// client host.
+func (h *Host) DeleteTLSKey(clientHost string) {
+	keys := maps.Clone(h.TLSKeys.Data())
+	delete(keys, clientHost)
+	h.TLSKeys = datatypes.NewJSONType(keys)
+}
+
+// AllowsDataset returns whether volumes may be created at the dataset on the
+// host. A server host allows the datasets under its declared pools or parent
+// datasets, or any dataset when it declares none.
+func (h *Host) AllowsDataset(datasetID string) bool {
+	role := h.Role.Data()
+	if role.Type != HostRoleTypeServer || role.Server == nil {
+		return false
+	}
+	if len(role.Server.Datasets) == 0 {
+		return true
+	}
+	for _, dataset := range role.Server.Datasets {
+		if datasetID == dataset || strings.HasPrefix(datasetID, dataset+"/") {
+			return true
+		}
+	}
+	return false
+}
+
 func (h *Host) VolumeIQN(volumeID string) (string, error) {
 	iqn, err := h.IQN()
 	if err != nil {
@@ -159,6 +370,20 @@ func (h *Host) process(encrypt bool) error {
 		}
 	}
 
+	{
+		keys := h.TLSKeys.Data()
+		if len(keys) > 0 {
+			processed := make(map[string]string, len(keys))
+			for clientHost, key := range keys {
+				processed[clientHost], err = fn(key)
+				if err != nil {
+					return err
+				}
+			}
+			h.TLSKeys = datatypes.NewJSONType(processed)
+		}
+	}
+
 	return nil
 }
 
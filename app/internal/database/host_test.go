@@ -1,6 +1,7 @@
 package database_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/jovulic/zfsilo/app/internal/database"
@@ -162,3 +163,269 @@ func TestHost_VolumeNQN(t *testing.T) {
 		})
 	}
 }
+
+func TestHost_Address(t *testing.T) {
+	remote := database.HostConnection{
+		Type:   database.HostConnectionTypeRemote,
+		Remote: &database.HostConnectionRemote{Address: "192.168.1.10", Port: 22},
+	}
+	tests := []struct {
+		name       string
+		role       database.HostRole
+		connection database.HostConnection
+		want       string
+		wantErr    bool
+	}{
+		{
+			name: "server endpoint",
+			role: database.HostRole{
+				Type:   database.HostRoleTypeServer,
+				Server: &database.HostRoleServer{Endpoint: "give"},
+			},
+			connection: remote,
+			want:       "give",
+			wantErr:    false,
+		},
+		{
+			name: "client endpoint",
+			role: database.HostRole{
+				Type:   database.HostRoleTypeClient,
+				Client: &database.HostRoleClient{Endpoint: "take"},
+			},
+			connection: remote,
+			want:       "take",
+			wantErr:    false,
+		},
+		{
+			name: "remote address",
+			role: database.HostRole{
+				Type:   database.HostRoleTypeClient,
+				Client: &database.HostRoleClient{},
+			},
+			connection: remote,
+			want:       "192.168.1.10",
+			wantErr:    false,
+		},
+		{
+			name: "no address",
+			role: database.HostRole{
+				Type:   database.HostRoleTypeClient,
+				Client: &database.HostRoleClient{},
+			},
+			connection: database.HostConnection{
+				Type:  database.HostConnectionTypeLocal,
+				Local: &database.HostConnectionLocal{},
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &database.Host{
+				Role:       datatypes.NewJSONType(tt.role),
+				Connection: datatypes.NewJSONType(tt.connection),
+			}
+			got, err := h.Address()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Host.Address() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHost_DataAddresses(t *testing.T) {
+	tests := []struct {
+		name string
+		role database.HostRole
+		want []string
+	}{
+		{
+			name: "endpoint",
+			role: database.HostRole{
+				Type:   database.HostRoleTypeServer,
+				Server: &database.HostRoleServer{Endpoint: "10.0.0.1"},
+			},
+			want: []string{"10.0.0.1"},
+		},
+		{
+			name: "data addresses",
+			role: database.HostRole{
+				Type: database.HostRoleTypeServer,
+				Server: &database.HostRoleServer{
+					Endpoint:      "10.0.0.1",
+					DataAddresses: []string{"10.0.1.1", "10.0.2.1"},
+				},
+			},
+			want: []string{"10.0.1.1", "10.0.2.1"},
+		},
+		{
+			name: "client",
+			role: database.HostRole{
+				Type:   database.HostRoleTypeClient,
+				Client: &database.HostRoleClient{Endpoint: "10.0.0.2"},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &database.Host{Role: datatypes.NewJSONType(tt.role)}
+			if got := h.DataAddresses(); !slices.Equal(got, tt.want) {
+				t.Errorf("Host.DataAddresses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHost_NVMeOFPorts(t *testing.T) {
+	tests := []struct {
+		name             string
+		server           database.HostRoleServer
+		wantPorts        []database.HostNVMeOFPort
+		wantAddresses    []string
+		wantTLSAddresses []string
+	}{
+		{
+			name:          "default",
+			server:        database.HostRoleServer{Endpoint: "10.0.0.1"},
+			wantPorts:     []database.HostNVMeOFPort{{ID: 1, Address: "0.0.0.0", ServiceID: 4420}},
+			wantAddresses: []string{"10.0.0.1:4420"},
+		},
+		{
+			name: "data addresses",
+			server: database.HostRoleServer{
+				Endpoint:      "10.0.0.1",
+				DataAddresses: []string{"10.0.1.1", "[fd00::1]:4421"},
+			},
+			wantPorts: []database.HostNVMeOFPort{
+				{ID: 1, Address: "10.0.1.1", ServiceID: 4420},
+				{ID: 2, Address: "fd00::1", ServiceID: 4421},
+			},
+			wantAddresses: []string{"10.0.1.1:4420", "[fd00::1]:4421"},
+		},
+		{
+			name: "configured ports",
+			server: database.HostRoleServer{
+				Endpoint: "10.0.0.1",
+				NVMeOFPorts: []database.HostNVMeOFPort{
+					{ID: 10, Address: "10.0.1.1"},
+					{ID: 11, Address: "fd00::1", ServiceID: 4430},
+				},
+			},
+			wantPorts: []database.HostNVMeOFPort{
+				{ID: 10, Address: "10.0.1.1", ServiceID: 4420},
+				{ID: 11, Address: "fd00::1", ServiceID: 4430},
+			},
+			wantAddresses: []string{"10.0.1.1:4420", "[fd00::1]:4430"},
+		},
+		{
+			name: "configured wildcard port",
+			server: database.HostRoleServer{
+				Endpoint:    "storage.example.com",
+				NVMeOFPorts: []database.HostNVMeOFPort{{ID: 2, Address: "::", ServiceID: 4421}},
+			},
+			wantPorts:     []database.HostNVMeOFPort{{ID: 2, Address: "::", ServiceID: 4421}},
+			wantAddresses: []string{"storage.example.com:4421"},
+		},
+		{
+			name: "configured tls ports",
+			server: database.HostRoleServer{
+				Endpoint: "10.0.0.1",
+				NVMeOFPorts: []database.HostNVMeOFPort{
+					{ID: 1, Address: "0.0.0.0"},
+					{ID: 2, Address: "0.0.0.0", ServiceID: 4421, TLS: true},
+				},
+			},
+			wantPorts: []database.HostNVMeOFPort{
+				{ID: 1, Address: "0.0.0.0", ServiceID: 4420},
+				{ID: 2, Address: "0.0.0.0", ServiceID: 4421, TLS: true},
+			},
+			wantAddresses:    []string{"10.0.0.1:4420"},
+			wantTLSAddresses: []string{"10.0.0.1:4421"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &database.Host{Role: datatypes.NewJSONType(database.HostRole{
+				Type:   database.HostRoleTypeServer,
+				Server: &tt.server,
+			})}
+			if got := h.NVMeOFPorts(); !slices.Equal(got, tt.wantPorts) {
+				t.Errorf("Host.NVMeOFPorts() = %v, want %v", got, tt.wantPorts)
+			}
+			if got := h.NVMeOFAddresses(false); !slices.Equal(got, tt.wantAddresses) {
+				t.Errorf("Host.NVMeOFAddresses(false) = %v, want %v", got, tt.wantAddresses)
+			}
+			if got := h.NVMeOFAddresses(true); !slices.Equal(got, tt.wantTLSAddresses) {
+				t.Errorf("Host.NVMeOFAddresses(true) = %v, want %v", got, tt.wantTLSAddresses)
+			}
+		})
+	}
+}
+
+func TestHost_AllowsDataset(t *testing.T) {
+	tests := []struct {
+		name      string
+		role      database.HostRole
+		datasetID string
+		want      bool
+	}{
+		{
+			name: "no datasets declared",
+			role: database.HostRole{
+				Type:   database.HostRoleTypeServer,
+				Server: &database.HostRoleServer{},
+			},
+			datasetID: "tank/vol",
+			want:      true,
+		},
+		{
+			name: "under declared pool",
+			role: database.HostRole{
+				Type:   database.HostRoleTypeServer,
+				Server: &database.HostRoleServer{Datasets: []string{"tank"}},
+			},
+			datasetID: "tank/vol",
+			want:      true,
+		},
+		{
+			name: "under declared parent dataset",
+			role: database.HostRole{
+				Type:   database.HostRoleTypeServer,
+				Server: &database.HostRoleServer{Datasets: []string{"fast", "tank/csi"}},
+			},
+			datasetID: "tank/csi/vol",
+			want:      true,
+		},
+		{
+			name: "outside declared parent dataset",
+			role: database.HostRole{
+				Type:   database.HostRoleTypeServer,
+				Server: &database.HostRoleServer{Datasets: []string{"tank/csi"}},
+			},
+			datasetID: "tank/csi2/vol",
+			want:      false,
+		},
+		{
+			name: "client host",
+			role: database.HostRole{
+				Type:   database.HostRoleTypeClient,
+				Client: &database.HostRoleClient{},
+			},
+			datasetID: "tank/vol",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &database.Host{Role: datatypes.NewJSONType(tt.role)}
+			if got := h.AllowsDataset(tt.datasetID); got != tt.want {
+				t.Errorf("Host.AllowsDataset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
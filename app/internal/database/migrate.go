@@ -0,0 +1,111 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	slogctx "github.com/veqryn/slog-context"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Migrate brings the schema of the database up to date with the models, and
+// carries over data that was kept in a different form before.
+func Migrate(ctx context.Context, db *gorm.DB) error {
+	slogctx.Info(ctx, "running database automigrate")
+	if err := db.AutoMigrate(&Volume{}, &Host{}, &Snapshot{}, &SnapshotPolicy{}, &SnapshotPolicyRun{}, &Replication{}, &SnapshotGroup{}); err != nil {
+		return fmt.Errorf("failed to perform automigrate: %w", err)
+	}
+	if err := migrateVolumeAttachments(ctx, db); err != nil {
+		return fmt.Errorf("failed to migrate volume attachments: %w", err)
+	}
+	return nil
+}
+
+// legacyVolumeAttachmentColumns are the columns the client host of a volume
+// was kept in before a volume could be attached to several client hosts.
+var legacyVolumeAttachmentColumns = []string{
+	"client_host",
+	"staging_path",
+	"target_paths",
+	"read_only",
+	"read_only_target_paths",
+}
+
+// migrateVolumeAttachments moves the client host a volume was attached to,
+// along with its staging and target paths, from the legacy columns into an
+// attachment of the volume, and then drops the legacy columns. The initiator
+// of the client host was kept in the transport of the volume.
+func migrateVolumeAttachments(ctx context.Context, db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&Volume{}, "client_host") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		// The read-only columns only exist on databases that were created
+		// once volumes could be connected read-only.
+		readOnly, readOnlyTargetPaths := "0", "NULL"
+		if tx.Migrator().HasColumn(&Volume{}, "read_only") {
+			readOnly = "read_only"
+		}
+		if tx.Migrator().HasColumn(&Volume{}, "read_only_target_paths") {
+			readOnlyTargetPaths = "read_only_target_paths"
+		}
+
+		var rows []struct {
+			ID                  string
+			ClientHost          string
+			Initiator           string
+			StagingPath         string
+			TargetPaths         datatypes.JSONSlice[string]
+			ReadOnly            bool
+			ReadOnlyTargetPaths datatypes.JSONSlice[string]
+		}
+		err := tx.Raw(fmt.Sprintf(`
+			SELECT
+				id,
+				client_host,
+				COALESCE(
+					json_extract(transport, '$.iscsi.initiatorIQN'),
+					json_extract(transport, '$.nvmeof.initiatorNQN'),
+					json_extract(transport, '$.nfs.clientAddresses[0]'),
+					''
+				) AS initiator,
+				COALESCE(staging_path, '') AS staging_path,
+				target_paths,
+				%s AS read_only,
+				%s AS read_only_target_paths
+			FROM volumes
+			WHERE client_host IS NOT NULL AND client_host <> ''
+		`, readOnly, readOnlyTargetPaths)).Scan(&rows).Error
+		if err != nil {
+			return fmt.Errorf("failed to get attached volumes: %w", err)
+		}
+
+		for _, row := range rows {
+			attachments := datatypes.NewJSONSlice([]VolumeAttachment{{
+				ClientHost:          row.ClientHost,
+				Initiator:           row.Initiator,
+				ReadOnly:            row.ReadOnly,
+				StagingPath:         row.StagingPath,
+				TargetPaths:         row.TargetPaths,
+				ReadOnlyTargetPaths: row.ReadOnlyTargetPaths,
+			}})
+			err := tx.Model(&Volume{}).Where("id = ?", row.ID).UpdateColumn("attachments", attachments).Error
+			if err != nil {
+				return fmt.Errorf("failed to update volume %s: %w", row.ID, err)
+			}
+			slogctx.Info(ctx, "migrated volume attachment", "volumeId", row.ID, "clientHost", row.ClientHost)
+		}
+
+		for _, column := range legacyVolumeAttachmentColumns {
+			if !tx.Migrator().HasColumn(&Volume{}, column) {
+				continue
+			}
+			if err := tx.Migrator().DropColumn(&Volume{}, column); err != nil {
+				return fmt.Errorf("failed to drop column %s: %w", column, err)
+			}
+		}
+		return nil
+	})
+}
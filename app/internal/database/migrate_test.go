@@ -0,0 +1,95 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/jovulic/zfsilo/app/internal/database"
+)
+
+// legacyVolume is a volume as it was stored before volumes could be attached
+// to several client hosts.
+type legacyVolume struct {
+	ID                  string `gorm:"primaryKey"`
+	Name                string
+	DatasetID           string
+	CapacityBytes       int64
+	Status              database.VolumeStatus
+	ServerHost          string
+	ClientHost          string
+	Transport           datatypes.JSON
+	StagingPath         string
+	TargetPaths         datatypes.JSONSlice[string]
+	ReadOnly            bool
+	ReadOnlyTargetPaths datatypes.JSONSlice[string]
+}
+
+func (legacyVolume) TableName() string {
+	return "volumes"
+}
+
+func TestMigrateVolumeAttachments(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open("file:migrate?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&legacyVolume{}))
+
+	legacy := []legacyVolume{
+		{
+			ID:                  "vol_mounted",
+			Name:                "volumes/vol_mounted",
+			DatasetID:           "tank/vol_mounted",
+			CapacityBytes:       1 << 30,
+			Status:              database.VolumeStatusMOUNTED,
+			ServerHost:          "hosts/server",
+			ClientHost:          "hosts/client",
+			Transport:           datatypes.JSON(`{"type":"ISCSI","iscsi":{"targetAddress":"10.0.0.1","targetIQN":"iqn.2006-01.com.example:vol","initiatorIQN":"iqn.2006-01.com.example:client"}}`),
+			StagingPath:         "/staging",
+			TargetPaths:         []string{"/target/a", "/target/b"},
+			ReadOnlyTargetPaths: []string{"/target/b"},
+		},
+		{
+			ID:            "vol_published",
+			Name:          "volumes/vol_published",
+			DatasetID:     "tank/vol_published",
+			CapacityBytes: 1 << 30,
+			Status:        database.VolumeStatusPUBLISHED,
+			ServerHost:    "hosts/server",
+			Transport:     datatypes.JSON(`{"type":"NVMEOF_TCP","nvmeof":{"targetAddress":"10.0.0.1","targetNQN":"nqn.2014-08.com.example:vol"}}`),
+		},
+	}
+	require.NoError(t, db.Create(&legacy).Error)
+
+	require.NoError(t, database.Migrate(ctx, db))
+
+	mounted, err := gorm.G[database.Volume](db).Where("id = ?", "vol_mounted").First(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, database.VolumeStatusMOUNTED, mounted.Status)
+	assert.Equal(t, []database.VolumeAttachment{{
+		ClientHost:          "hosts/client",
+		Initiator:           "iqn.2006-01.com.example:client",
+		StagingPath:         "/staging",
+		TargetPaths:         []string{"/target/a", "/target/b"},
+		ReadOnlyTargetPaths: []string{"/target/b"},
+	}}, []database.VolumeAttachment(mounted.Attachments))
+
+	published, err := gorm.G[database.Volume](db).Where("id = ?", "vol_published").First(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, published.Attachments)
+
+	for _, column := range []string{"client_host", "staging_path", "target_paths", "read_only", "read_only_target_paths"} {
+		assert.False(t, db.Migrator().HasColumn(&database.Volume{}, column), column)
+	}
+
+	// Migrating again leaves the volumes as they are.
+	require.NoError(t, database.Migrate(ctx, db))
+	mounted, err = gorm.G[database.Volume](db).Where("id = ?", "vol_mounted").First(ctx)
+	require.NoError(t, err)
+	assert.Len(t, mounted.Attachments, 1)
+}
@@ -0,0 +1,65 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+type ReplicationStatus struct {
+	LastSyncTime          time.Time `json:"lastSyncTime,omitzero"`
+	LastAttemptTime       time.Time `json:"lastAttemptTime,omitzero"`
+	LastBytesTransferred  int64     `json:"lastBytesTransferred,omitempty"`
+	TotalBytesTransferred int64     `json:"totalBytesTransferred,omitempty"`
+	LastError             string    `json:"lastError,omitempty"`
+	LastSnapshot          string    `json:"lastSnapshot,omitempty"`
+	LastSnapshotTime      time.Time `json:"lastSnapshotTime,omitzero"`
+}
+
+// Lag returns how far the target is behind the volume at the given time. It is
+// zero until the first successful sync.
+func (s ReplicationStatus) Lag(now time.Time) time.Duration {
+	if s.LastSnapshotTime.IsZero() {
+		return 0
+	}
+	return now.Sub(s.LastSnapshotTime)
+}
+
+type Replication struct {
+	Struct          datatypes.JSON
+	CreateTime      time.Time `gorm:"autoCreateTime"`
+	UpdateTime      time.Time `gorm:"autoUpdateTime"`
+	ID              string    `gorm:"primaryKey"`
+	Name            string
+	VolumeID        string `gorm:"index"`
+	TargetHost      string
+	TargetDatasetID string
+	IntervalSeconds int32
+	Status          datatypes.JSONType[ReplicationStatus]
+	Standby         bool
+}
+
+// Interval returns how often the volume is replicated.
+func (r *Replication) Interval() time.Duration {
+	return time.Duration(r.IntervalSeconds) * time.Second
+}
+
+// BuildStandbyReplicationID returns the id of the replication that keeps the
+// standby copy of a volume. A volume has at most one standby so the id is
+// derived from the volume id.
+func BuildStandbyReplicationID(volumeID string) string {
+	return fmt.Sprintf("rpl_%s", volumeID)
+}
+
+// BuildReplicationSnapshotName returns the short name of a snapshot taken to
+// replicate a volume. The same name is used for the bookmark left behind on
+// the volume and the snapshot received on the target.
+func BuildReplicationSnapshotName(replicationID string, suffix string) string {
+	return fmt.Sprintf("%s-%s", replicationID, suffix)
+}
+
+// BuildBookmarkDatasetID returns the full name of a bookmark of a dataset.
+func BuildBookmarkDatasetID(datasetID string, bookmark string) string {
+	return fmt.Sprintf("%s#%s", datasetID, bookmark)
+}
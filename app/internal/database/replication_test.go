@@ -0,0 +1,52 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/jovulic/zfsilo/app/internal/database"
+)
+
+// TestReplicationStatus verifies that the status survives a round trip through
+// the database and that lag is measured from the last replicated snapshot.
+func TestReplicationStatus(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+
+	snapshotTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	newReplication := &database.Replication{
+		ID:              "rpl-test-123",
+		Name:            "replications/rpl-test-123",
+		VolumeID:        "vol-test-456",
+		TargetHost:      "hosts/hst-backup",
+		TargetDatasetID: "backup/vol-test-456",
+		IntervalSeconds: 300,
+		Status: datatypes.NewJSONType(database.ReplicationStatus{
+			LastSyncTime:          snapshotTime.Add(time.Minute),
+			LastBytesTransferred:  1024,
+			TotalBytesTransferred: 4096,
+			LastSnapshot:          "rpl-test-123-first",
+			LastSnapshotTime:      snapshotTime,
+		}),
+	}
+	err := gorm.G[database.Replication](db).Create(ctx, newReplication)
+	assert.NoError(t, err, "Failed to create replication")
+
+	retrievedReplication, err := gorm.G[database.Replication](db).Where("id = ?", "rpl-test-123").First(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, retrievedReplication.Interval())
+
+	status := retrievedReplication.Status.Data()
+	assert.Equal(t, int64(4096), status.TotalBytesTransferred)
+	assert.Equal(t, "rpl-test-123-first", status.LastSnapshot)
+	assert.True(t, status.LastSnapshotTime.Equal(snapshotTime))
+	assert.True(t, status.LastAttemptTime.IsZero())
+	assert.Equal(t, 10*time.Minute, status.Lag(snapshotTime.Add(10*time.Minute)))
+
+	assert.Equal(t, time.Duration(0), database.ReplicationStatus{}.Lag(time.Now()))
+}
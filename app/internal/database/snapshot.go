@@ -0,0 +1,33 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+type Snapshot struct {
+	Struct         datatypes.JSON
+	CreateTime     time.Time `gorm:"autoCreateTime"`
+	UpdateTime     time.Time `gorm:"autoUpdateTime"`
+	ID             string    `gorm:"primaryKey"`
+	Name           string
+	VolumeID       string `gorm:"index"`
+	DatasetID      string
+	CapacityBytes  int64
+	ServerHost     string
+	Hidden         bool
+	SnapshotPolicy string `gorm:"index"`
+	SnapshotGroup  string `gorm:"index"`
+}
+
+func BuildSnapshotDatasetID(datasetID string, snapshotID string) string {
+	return fmt.Sprintf("%s@%s", datasetID, snapshotID)
+}
+
+// BuildCloneSnapshotID returns the id of the hidden snapshot that a volume
+// cloned from another volume is created from.
+func BuildCloneSnapshotID(volumeID string) string {
+	return fmt.Sprintf("snp_%s", volumeID)
+}
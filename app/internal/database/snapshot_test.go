@@ -0,0 +1,75 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/jovulic/zfsilo/app/internal/database"
+)
+
+// TestSnapshotCRUD performs a Create, Read, List, Delete cycle.
+func TestSnapshotCRUD(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+
+	// CREATE
+	t.Run("Create", func(t *testing.T) {
+		newSnapshot := &database.Snapshot{
+			ID:            "snp-test-123",
+			Name:          "TestSnapshot",
+			VolumeID:      "vol-test-456",
+			DatasetID:     database.BuildSnapshotDatasetID("tank/vol-test-456", "snp-test-123"),
+			CapacityBytes: 512 * 1024 * 1024, // 512 MiB
+			ServerHost:    "hosts/hst-server",
+		}
+
+		err := gorm.G[database.Snapshot](db).Create(ctx, newSnapshot)
+		assert.NoError(t, err, "Failed to create snapshot")
+	})
+
+	// READ
+	t.Run("Read", func(t *testing.T) {
+		retrievedSnapshot, err := gorm.G[database.Snapshot](db).Where("id = ?", "snp-test-123").First(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "tank/vol-test-456@snp-test-123", retrievedSnapshot.DatasetID)
+		assert.NotNil(t, retrievedSnapshot.CreateTime)
+	})
+
+	// LIST
+	t.Run("List", func(t *testing.T) {
+		snapshots, err := gorm.G[database.Snapshot](db).Where("volume_id = ?", "vol-test-456").Find(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, snapshots, 1)
+	})
+
+	// DELETE
+	t.Run("Delete", func(t *testing.T) {
+		_, err := gorm.G[database.Snapshot](db).Where("id = ?", "snp-test-123").Delete(ctx)
+		assert.NoError(t, err)
+
+		// Verify it's gone.
+		var result database.Snapshot
+		err = db.First(&result, "id = ?", "snp-test-123").Error
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+}
+
+func TestVolumeSourceSnapshotID(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		volume := database.Volume{ID: "vol_a"}
+		assert.Equal(t, "", volume.SourceSnapshotID())
+	})
+
+	t.Run("SourceSnapshot", func(t *testing.T) {
+		volume := database.Volume{ID: "vol_a", SourceSnapshot: "snp_b"}
+		assert.Equal(t, "snp_b", volume.SourceSnapshotID())
+	})
+
+	t.Run("SourceVolume", func(t *testing.T) {
+		volume := database.Volume{ID: "vol_a", SourceVolume: "vol_b"}
+		assert.Equal(t, "snp_vol_a", volume.SourceSnapshotID())
+	})
+}
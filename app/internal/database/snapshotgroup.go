@@ -0,0 +1,32 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// SnapshotGroup is a set of snapshots of several volumes taken at the same
+// point in time.
+type SnapshotGroup struct {
+	Struct      datatypes.JSON
+	CreateTime  time.Time `gorm:"autoCreateTime"`
+	UpdateTime  time.Time `gorm:"autoUpdateTime"`
+	ID          string    `gorm:"primaryKey"`
+	Name        string
+	VolumeIDs   datatypes.JSONSlice[string]
+	SnapshotIDs datatypes.JSONSlice[string]
+	ServerHost  string
+}
+
+// BuildGroupSnapshotID returns the id of the snapshot taken of a volume as
+// part of a snapshot group.
+func BuildGroupSnapshotID(snapshotGroupID string, volumeID string) string {
+	return fmt.Sprintf(
+		"snp_%s_%s",
+		strings.TrimPrefix(snapshotGroupID, "sgp_"),
+		strings.TrimPrefix(volumeID, "vol_"),
+	)
+}
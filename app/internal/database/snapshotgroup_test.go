@@ -0,0 +1,54 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/jovulic/zfsilo/app/internal/database"
+)
+
+// TestSnapshotGroupCRUD performs a Create, Read, Delete cycle.
+func TestSnapshotGroupCRUD(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+
+	// CREATE
+	t.Run("Create", func(t *testing.T) {
+		newGroup := &database.SnapshotGroup{
+			ID:        "sgp_test",
+			Name:      "snapshotgroups/sgp_test",
+			VolumeIDs: datatypes.NewJSONSlice([]string{"vol_a", "vol_b"}),
+			SnapshotIDs: datatypes.NewJSONSlice([]string{
+				database.BuildGroupSnapshotID("sgp_test", "vol_a"),
+				database.BuildGroupSnapshotID("sgp_test", "vol_b"),
+			}),
+			ServerHost: "hosts/hst_server",
+		}
+
+		err := gorm.G[database.SnapshotGroup](db).Create(ctx, newGroup)
+		assert.NoError(t, err, "Failed to create snapshot group")
+	})
+
+	// READ
+	t.Run("Read", func(t *testing.T) {
+		retrievedGroup, err := gorm.G[database.SnapshotGroup](db).Where("id = ?", "sgp_test").First(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"vol_a", "vol_b"}, []string(retrievedGroup.VolumeIDs))
+		assert.Equal(t, []string{"snp_test_a", "snp_test_b"}, []string(retrievedGroup.SnapshotIDs))
+	})
+
+	// DELETE
+	t.Run("Delete", func(t *testing.T) {
+		_, err := gorm.G[database.SnapshotGroup](db).Where("id = ?", "sgp_test").Delete(ctx)
+		assert.NoError(t, err)
+
+		// Verify it's gone.
+		var result database.SnapshotGroup
+		err = db.First(&result, "id = ?", "sgp_test").Error
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+}
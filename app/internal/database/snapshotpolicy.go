@@ -0,0 +1,40 @@
+package database
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+type SnapshotPolicy struct {
+	Struct     datatypes.JSON
+	CreateTime time.Time `gorm:"autoCreateTime"`
+	UpdateTime time.Time `gorm:"autoUpdateTime"`
+	ID         string    `gorm:"primaryKey"`
+	Name       string
+	Schedule   string
+	KeepLast   int32
+	KeepDaily  int32
+	KeepWeekly int32
+}
+
+//go:generate stringer -type=SnapshotPolicyRunOutcome -linecomment snapshotpolicy.go
+type SnapshotPolicyRunOutcome int
+
+const (
+	SnapshotPolicyRunOutcomeUNSPECIFIED SnapshotPolicyRunOutcome = iota // UNSPECIFIED
+	SnapshotPolicyRunOutcomeSUCCEEDED                                   // SUCCEEDED
+	SnapshotPolicyRunOutcomeFAILED                                      // FAILED
+)
+
+// SnapshotPolicyRun records the outcome of the last run of a snapshot policy
+// against a volume.
+type SnapshotPolicyRun struct {
+	VolumeID         string `gorm:"primaryKey"`
+	SnapshotPolicyID string `gorm:"index"`
+	RunTime          time.Time
+	Outcome          SnapshotPolicyRunOutcome
+	Message          string
+	SnapshotID       string
+	PrunedCount      int32
+}
@@ -0,0 +1,99 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/jovulic/zfsilo/app/internal/database"
+)
+
+// TestSnapshotPolicyCRUD performs a Create, Read, Update, Delete cycle.
+func TestSnapshotPolicyCRUD(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+
+	// CREATE
+	t.Run("Create", func(t *testing.T) {
+		newPolicy := &database.SnapshotPolicy{
+			ID:        "spl-test-123",
+			Name:      "snapshotpolicies/spl-test-123",
+			Schedule:  "0 * * * *",
+			KeepLast:  24,
+			KeepDaily: 7,
+		}
+
+		err := gorm.G[database.SnapshotPolicy](db).Create(ctx, newPolicy)
+		assert.NoError(t, err, "Failed to create snapshot policy")
+	})
+
+	// READ
+	t.Run("Read", func(t *testing.T) {
+		retrievedPolicy, err := gorm.G[database.SnapshotPolicy](db).Where("id = ?", "spl-test-123").First(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "0 * * * *", retrievedPolicy.Schedule)
+		assert.Equal(t, int32(24), retrievedPolicy.KeepLast)
+		assert.Equal(t, int32(7), retrievedPolicy.KeepDaily)
+	})
+
+	// UPDATE
+	t.Run("Update", func(t *testing.T) {
+		_, err := gorm.G[database.SnapshotPolicy](db).Where("id = ?", "spl-test-123").Update(ctx, "keep_weekly", 4)
+		assert.NoError(t, err)
+
+		retrievedPolicy, err := gorm.G[database.SnapshotPolicy](db).Where("id = ?", "spl-test-123").First(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(4), retrievedPolicy.KeepWeekly)
+	})
+
+	// DELETE
+	t.Run("Delete", func(t *testing.T) {
+		_, err := gorm.G[database.SnapshotPolicy](db).Where("id = ?", "spl-test-123").Delete(ctx)
+		assert.NoError(t, err)
+
+		// Verify it's gone.
+		var result database.SnapshotPolicy
+		err = db.First(&result, "id = ?", "spl-test-123").Error
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+}
+
+// TestSnapshotPolicyRunUpsert verifies that only the last run is kept per
+// volume.
+func TestSnapshotPolicyRunUpsert(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+
+	first := &database.SnapshotPolicyRun{
+		VolumeID:         "vol-test-456",
+		SnapshotPolicyID: "spl-test-123",
+		RunTime:          time.Now().Add(-time.Hour),
+		Outcome:          database.SnapshotPolicyRunOutcomeFAILED,
+		Message:          "dataset is busy",
+	}
+	err := gorm.G[database.SnapshotPolicyRun](db, clause.OnConflict{UpdateAll: true}).Create(ctx, first)
+	assert.NoError(t, err)
+
+	second := &database.SnapshotPolicyRun{
+		VolumeID:         "vol-test-456",
+		SnapshotPolicyID: "spl-test-123",
+		RunTime:          time.Now(),
+		Outcome:          database.SnapshotPolicyRunOutcomeSUCCEEDED,
+		SnapshotID:       "snp-test-789",
+		PrunedCount:      2,
+	}
+	err = gorm.G[database.SnapshotPolicyRun](db, clause.OnConflict{UpdateAll: true}).Create(ctx, second)
+	assert.NoError(t, err)
+
+	runs, err := gorm.G[database.SnapshotPolicyRun](db).Where("volume_id = ?", "vol-test-456").Find(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, runs, 1)
+	assert.Equal(t, database.SnapshotPolicyRunOutcomeSUCCEEDED, runs[0].Outcome)
+	assert.Equal(t, "", runs[0].Message)
+	assert.Equal(t, "snp-test-789", runs[0].SnapshotID)
+	assert.Equal(t, int32(2), runs[0].PrunedCount)
+}
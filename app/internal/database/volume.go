@@ -2,11 +2,15 @@
 package database
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
 	"database/sql/driver"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"slices"
 	"time"
 
 	"gorm.io/datatypes"
@@ -44,8 +48,35 @@ const (
 	VolumeModeUNSPECIFIED VolumeMode = iota // UNSPECIFIED
 	VolumeModeBLOCK                         // BLOCK
 	VolumeModeFILESYSTEM                    // FILESYSTEM
+	VolumeModeDATASET                       // DATASET
 )
 
+//go:generate stringer -type=VolumeFSType -linecomment volume.go
+type VolumeFSType int
+
+const (
+	VolumeFSTypeUNSPECIFIED VolumeFSType = iota // UNSPECIFIED
+	VolumeFSTypeEXT4                            // EXT4
+	VolumeFSTypeXFS                             // XFS
+	VolumeFSTypeBTRFS                           // BTRFS
+)
+
+// Name returns the name of the filesystem type as known to mkfs, mount and
+// blkid. An unspecified type is ext4, which volumes were formatted with before
+// the type could be chosen.
+func (t VolumeFSType) Name() string {
+	switch t {
+	case VolumeFSTypeXFS:
+		return "xfs"
+	case VolumeFSTypeBTRFS:
+		return "btrfs"
+	case VolumeFSTypeUNSPECIFIED, VolumeFSTypeEXT4:
+		fallthrough
+	default:
+		return "ext4"
+	}
+}
+
 //go:generate stringer -type=VolumeStatus -linecomment volume.go
 type VolumeStatus int
 
@@ -64,62 +95,135 @@ const (
 	VolumeTransportTypeUNSPECIFIED VolumeTransportType = "UNSPECIFIED"
 	VolumeTransportTypeISCSI       VolumeTransportType = "ISCSI"
 	VolumeTransportTypeNVMEOF_TCP  VolumeTransportType = "NVMEOF_TCP"
+	VolumeTransportTypeNFS         VolumeTransportType = "NFS"
 )
 
 type VolumeTransportISCSI struct {
-	TargetAddress     string `json:"targetAddress,omitempty"`
-	TargetIQN         string `json:"targetIQN,omitempty"`
+	TargetAddress string `json:"targetAddress,omitempty"`
+	// TargetAddresses are the portals the target is served on, the first of
+	// which is the target address.
+	TargetAddresses []string `json:"targetAddresses,omitempty"`
+	TargetIQN       string   `json:"targetIQN,omitempty"`
+	// TargetPassword and InitiatorPassword are the CHAP credentials of the
+	// volume, which every client host connected to it shares. A volume
+	// published before they were generated for each volume has the key of its
+	// server host as the target password and no initiator password, in which
+	// case the key of each client host is used.
 	TargetPassword    string `json:"targetPassword,omitempty"`
-	InitiatorIQN      string `json:"initiatorIQN,omitempty"`
 	InitiatorPassword string `json:"initiatorPassword,omitempty"`
 }
 
+// Portals returns the addresses the target is served on. Clients log in
+// through each of them.
+func (t *VolumeTransportISCSI) Portals() []string {
+	if len(t.TargetAddresses) > 0 {
+		return t.TargetAddresses
+	}
+	return []string{t.TargetAddress}
+}
+
 type VolumeTransportNVMEOF struct {
-	TargetAddress     string `json:"targetAddress,omitempty"`
-	TargetNQN         string `json:"targetNQN,omitempty"`
+	TargetAddress string `json:"targetAddress,omitempty"`
+	// TargetAddresses are the ports the subsystem is served on, the first of
+	// which is the target address.
+	TargetAddresses []string `json:"targetAddresses,omitempty"`
+	TargetNQN       string   `json:"targetNQN,omitempty"`
+	// TargetPassword and InitiatorPassword are the DH-HMAC-CHAP credentials
+	// of the volume, which every client host connected to it shares. Each
+	// client host connects with an NQN of its own for the volume, so that
+	// nvmet keeps the keys of the volume apart from those of other volumes.
+	// A volume published before they were generated for each volume has the
+	// key of its server host as the target password and no initiator
+	// password, in which case the key of each client host is used.
 	TargetPassword    string `json:"targetPassword,omitempty"`
-	InitiatorNQN      string `json:"initiatorNQN,omitempty"`
 	InitiatorPassword string `json:"initiatorPassword,omitempty"`
+	// TLS connects clients over TLS with the pre-shared key of their host
+	// and the server host.
+	TLS bool `json:"tls,omitempty"`
+}
+
+// Ports returns the addresses the subsystem is served on. Clients connect
+// through each of them.
+func (t *VolumeTransportNVMEOF) Ports() []string {
+	if len(t.TargetAddresses) > 0 {
+		return t.TargetAddresses
+	}
+	return []string{t.TargetAddress}
+}
+
+type VolumeTransportNFS struct {
+	ServerAddress   string   `json:"serverAddress,omitempty"`
+	ExportPath      string   `json:"exportPath,omitempty"`
+	ClientAddresses []string `json:"clientAddresses,omitempty"`
 }
 
 type VolumeTransport struct {
 	Type   VolumeTransportType    `json:"type"`
 	ISCSI  *VolumeTransportISCSI  `json:"iscsi,omitempty"`
 	NVMEOF *VolumeTransportNVMEOF `json:"nvmeof,omitempty"`
+	NFS    *VolumeTransportNFS    `json:"nfs,omitempty"`
 }
 
-type Volume struct {
-	Struct        datatypes.JSON
-	CreateTime    time.Time `gorm:"autoCreateTime"`
-	UpdateTime    time.Time `gorm:"autoUpdateTime"`
-	ID            string    `gorm:"primaryKey"`
-	Name          string
-	DatasetID     string
-	Options       datatypes.JSONType[VolumeOptionList]
-	Sparse        bool
-	Mode          VolumeMode
-	CapacityBytes int64 `gorm:"check:capacity_bytes > 0"`
-	Status        VolumeStatus
-	ServerHost    string
-	ClientHost    string
-	Transport     datatypes.JSONType[VolumeTransport]
-	StagingPath   string
-	TargetPaths   datatypes.JSONSlice[string]
+// VolumeAttachment is the attachment of a volume to a client host. Each client
+// host connects to, stages and mounts the volume on its own.
+type VolumeAttachment struct {
+	ClientHost string `json:"clientHost"`
+	// Initiator is the IQN or NQN the client host connects with, or its
+	// address for a dataset exported over NFS.
+	Initiator string `json:"initiator,omitempty"`
+	// ReadOnly is whether the client host is connected read-only, in which
+	// case all of its mounts are read-only.
+	ReadOnly    bool     `json:"readOnly,omitempty"`
+	StagingPath string   `json:"stagingPath,omitempty"`
+	TargetPaths []string `json:"targetPaths,omitempty"`
+	// ReadOnlyTargetPaths are the target paths the volume is mounted at
+	// read-only, a subset of the target paths.
+	ReadOnlyTargetPaths []string `json:"readOnlyTargetPaths,omitempty"`
 }
 
-func (v *Volume) BeforeSave(tx *gorm.DB) error {
-	return v.process(true)
+func (a *VolumeAttachment) IsStaged() bool {
+	return a.StagingPath != ""
 }
 
-func (v *Volume) BeforeUpdate(tx *gorm.DB) error {
-	return v.process(true)
+func (a *VolumeAttachment) IsMounted() bool {
+	return len(a.TargetPaths) > 0
 }
 
-func (v *Volume) AfterSave(tx *gorm.DB) error {
-	return v.process(false)
+type Volume struct {
+	Struct         datatypes.JSON
+	CreateTime     time.Time `gorm:"autoCreateTime"`
+	UpdateTime     time.Time `gorm:"autoUpdateTime"`
+	ID             string    `gorm:"primaryKey"`
+	Name           string
+	DatasetID      string
+	Options        datatypes.JSONType[VolumeOptionList]
+	Sparse         bool
+	Mode           VolumeMode
+	CapacityBytes  int64 `gorm:"check:capacity_bytes > 0"`
+	Status         VolumeStatus
+	ServerHost     string
+	Transport      datatypes.JSONType[VolumeTransport]
+	Attachments    datatypes.JSONSlice[VolumeAttachment]
+	SourceSnapshot string `gorm:"index"`
+	Promote        bool
+	SourceVolume   string `gorm:"index"`
+	SnapshotPolicy string `gorm:"index"`
+	StandbyHost    string
+	FencedHosts    datatypes.JSONSlice[string]
+	Encrypted      bool
+	FSType         VolumeFSType
+	MkfsOptions    datatypes.JSONSlice[string]
+	MountOptions   datatypes.JSONSlice[string]
+	// EncryptionKey is the hex encoded raw key the ZFS volume is encrypted
+	// with.
+	EncryptionKey string
 }
 
-func (v *Volume) AfterUpdate(tx *gorm.DB) error {
+func (v *Volume) BeforeSave(tx *gorm.DB) error {
+	return v.process(true)
+}
+
+func (v *Volume) AfterSave(tx *gorm.DB) error {
 	return v.process(false)
 }
 
@@ -143,9 +247,71 @@ func (v *Volume) IsMounted() bool {
 	return v.Status >= VolumeStatusMOUNTED
 }
 
+// Attachment returns the attachment of the volume to the client host. The
+// attachment can be modified in place.
+func (v *Volume) Attachment(clientHost string) (*VolumeAttachment, bool) {
+	for i := range v.Attachments {
+		if v.Attachments[i].ClientHost == clientHost {
+			return &v.Attachments[i], true
+		}
+	}
+	return nil, false
+}
+
+// Detach removes the attachment of the volume to the client host.
+func (v *Volume) Detach(clientHost string) {
+	v.Attachments = slices.DeleteFunc(v.Attachments, func(attachment VolumeAttachment) bool {
+		return attachment.ClientHost == clientHost
+	})
+}
+
+// UpdateStatus sets the status of a published volume to the furthest any of
+// its attachments has progressed.
+func (v *Volume) UpdateStatus() {
+	if !v.IsPublished() {
+		return
+	}
+	v.Status = VolumeStatusPUBLISHED
+	for _, attachment := range v.Attachments {
+		status := VolumeStatusCONNECTED
+		switch {
+		case attachment.IsMounted():
+			status = VolumeStatusMOUNTED
+		case attachment.IsStaged():
+			status = VolumeStatusSTAGED
+		}
+		v.Status = max(v.Status, status)
+	}
+}
+
+// SourceSnapshotID returns the id of the snapshot the volume is cloned from.
+// For a volume cloned from another volume this is the hidden snapshot taken of
+// the source volume.
+func (v *Volume) SourceSnapshotID() string {
+	if v.SourceVolume != "" {
+		return BuildCloneSnapshotID(v.ID)
+	}
+	return v.SourceSnapshot
+}
+
+// SizeProperty returns the ZFS property that holds the capacity of the volume.
+// A dataset is sized by its quota rather than a fixed volume size.
+func (v *Volume) SizeProperty() string {
+	if v.Mode == VolumeModeDATASET {
+		return "refquota"
+	}
+	return "volsize"
+}
+
 func (v *Volume) DevicePathClient(targetAddress string, targetID string) (string, error) {
-	switch v.Transport.Data().Type {
+	transport := v.Transport.Data()
+	switch transport.Type {
 	case VolumeTransportTypeISCSI:
+		// The sessions through several portals are assembled into a single
+		// device by dm-multipath.
+		if transport.ISCSI != nil && len(transport.ISCSI.Portals()) > 1 {
+			return BuildDevicePathISCSIMultipathClient(v.ID), nil
+		}
 		return BuildDevicePathISCSIClient(targetAddress, targetID), nil
 	case VolumeTransportTypeNVMEOF_TCP:
 		return BuildDevicePathNVMeOFClient(v.ID), nil
@@ -173,6 +339,18 @@ func (v *Volume) DevicePathZFS() string {
 	return BuildDevicePathZFS(v.DatasetID)
 }
 
+// RawEncryptionKey returns the raw key the ZFS volume is encrypted with.
+func (v *Volume) RawEncryptionKey() ([]byte, error) {
+	if !v.Encrypted || v.EncryptionKey == "" {
+		return nil, fmt.Errorf("volume %s is not encrypted", v.ID)
+	}
+	key, err := hex.DecodeString(v.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode encryption key of volume %s: %w", v.ID, err)
+	}
+	return key, nil
+}
+
 func (v *Volume) process(encrypt bool) error {
 	if len(encryptionKey) == 0 {
 		return nil
@@ -228,9 +406,36 @@ func (v *Volume) process(encrypt bool) error {
 		}
 	}
 
+	if v.EncryptionKey != "" {
+		var err error
+		v.EncryptionKey, err = fn(v.EncryptionKey)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// GenerateEncryptionKey returns a new hex encoded 256-bit key to encrypt a ZFS
+// volume with.
+func GenerateEncryptionKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", fmt.Errorf("failed to generate encryption key: %w", err)
+	}
+	return hex.EncodeToString(key), nil
+}
+
+// GenerateCHAPSecret returns a new hex encoded 128-bit CHAP secret.
+func GenerateCHAPSecret() (string, error) {
+	secret := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return "", fmt.Errorf("failed to generate chap secret: %w", err)
+	}
+	return hex.EncodeToString(secret), nil
+}
+
 func BuildDevicePathISCSIClient(address string, iqn string) string {
 	// udev generates iSCSI by-path names using the discovered IP address. To
 	// avoid issues when the provided portal address is a hostname that
@@ -238,6 +443,17 @@ func BuildDevicePathISCSIClient(address string, iqn string) string {
 	return fmt.Sprintf("/dev/disk/by-path/*-iscsi-%s-lun-%d", iqn, 0)
 }
 
+func BuildDevicePathISCSIMultipathClient(volumeID string) string {
+	// LIO derives the NAA identifier of a LUN from the unit serial of its
+	// backstore, which is the SHA-256 hash of the VolumeID, matching the logic
+	// in the iscsi command package. dm-multipath names the device after the
+	// identifier, prefixed with the NAA type 3.
+	hash := sha256.Sum256([]byte(volumeID))
+	serial := fmt.Sprintf("%x", hash)[:25]
+
+	return fmt.Sprintf("/dev/disk/by-id/dm-uuid-mpath-36001405%s", serial)
+}
+
 func BuildDevicePathISCSIServer(iqn string) string {
 	return fmt.Sprintf("/sys/kernel/config/target/iscsi/%s", iqn)
 }
@@ -260,3 +476,9 @@ func BuildDevicePathNVMeOFServer(nqn string) string {
 func BuildDevicePathZFS(datasetID string) string {
 	return fmt.Sprintf("/dev/zvol/%s", datasetID)
 }
+
+// BuildFencedDatasetID returns the name a stale copy of a volume is renamed to
+// when fencing the server host it failed over from.
+func BuildFencedDatasetID(datasetID string, suffix string) string {
+	return fmt.Sprintf("%s-fenced-%s", datasetID, suffix)
+}
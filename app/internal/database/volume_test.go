@@ -23,7 +23,7 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	}
 
 	// Automigrate the schema.
-	err = db.AutoMigrate(&database.Volume{})
+	err = db.AutoMigrate(&database.Volume{}, &database.Snapshot{}, &database.SnapshotPolicy{}, &database.SnapshotPolicyRun{}, &database.Replication{}, &database.SnapshotGroup{})
 	if err != nil {
 		t.Fatalf("Failed to migrate database: %v", err)
 	}
@@ -100,3 +100,31 @@ func TestVolumeCRUD(t *testing.T) {
 		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
 	})
 }
+
+func TestVolumeAttachments(t *testing.T) {
+	volume := database.Volume{Status: database.VolumeStatusPUBLISHED}
+
+	volume.Attachments = append(volume.Attachments,
+		database.VolumeAttachment{ClientHost: "give"},
+		database.VolumeAttachment{ClientHost: "take"},
+	)
+	volume.UpdateStatus()
+	assert.Equal(t, database.VolumeStatusCONNECTED, volume.Status)
+
+	attachment, ok := volume.Attachment("take")
+	assert.True(t, ok)
+	attachment.StagingPath = "/staging"
+	attachment.TargetPaths = []string{"/target"}
+	volume.UpdateStatus()
+	assert.Equal(t, database.VolumeStatusMOUNTED, volume.Status)
+
+	volume.Detach("take")
+	_, ok = volume.Attachment("take")
+	assert.False(t, ok)
+	volume.UpdateStatus()
+	assert.Equal(t, database.VolumeStatusCONNECTED, volume.Status)
+
+	volume.Detach("give")
+	volume.UpdateStatus()
+	assert.Equal(t, database.VolumeStatusPUBLISHED, volume.Status)
+}
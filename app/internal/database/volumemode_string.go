@@ -11,11 +11,12 @@ func _() {
 	_ = x[VolumeModeUNSPECIFIED-0]
 	_ = x[VolumeModeBLOCK-1]
 	_ = x[VolumeModeFILESYSTEM-2]
+	_ = x[VolumeModeDATASET-3]
 }
 
-const _VolumeMode_name = "UNSPECIFIEDBLOCKFILESYSTEM"
+const _VolumeMode_name = "UNSPECIFIEDBLOCKFILESYSTEMDATASET"
 
-var _VolumeMode_index = [...]uint8{0, 11, 16, 26}
+var _VolumeMode_index = [...]uint8{0, 11, 16, 26, 33}
 
 func (i VolumeMode) String() string {
 	if i < 0 || i >= VolumeMode(len(_VolumeMode_index)-1) {
@@ -7,7 +7,6 @@ import (
 
 	"github.com/google/wire"
 	"github.com/jovulic/zfsilo/app/internal/config"
-	slogctx "github.com/veqryn/slog-context"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -33,9 +32,8 @@ func WireDatabase(
 		return nil, fmt.Errorf("failed to init encryption: %w", err)
 	}
 
-	slogctx.Info(ctx, "running database automigrate")
-	if err := db.AutoMigrate(&Volume{}, &Host{}); err != nil {
-		return nil, fmt.Errorf("failed to perform automigrate: %w", err)
+	if err := Migrate(ctx, db); err != nil {
+		return nil, err
 	}
 
 	return db, nil
@@ -0,0 +1,330 @@
+// Package objectstore implements a minimal client for S3-compatible object
+// stores.
+//
+// Only what is needed to stream objects in and out of a single bucket is
+// supported. Requests are addressed path-style and signed with AWS Signature
+// Version 4, which works with AWS S3 as well as self-hosted stores such as
+// MinIO.
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// defaultPartSize is the size of the parts an object is uploaded in.
+	// Objects smaller than a part are uploaded in a single request.
+	defaultPartSize = 16 * 1024 * 1024
+	// maxParts and maxPartSize are the most parts a multipart upload may have
+	// and the largest a part may be.
+	maxParts    = 10000
+	maxPartSize = 5 * 1024 * 1024 * 1024
+)
+
+// ErrNotFound is returned when an object does not exist.
+var ErrNotFound = errors.New("object does not exist")
+
+type Config struct {
+	Endpoint string
+	Region   string
+	Bucket   string
+	// Prefix is prepended to the keys of all objects.
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PartSize is the smallest size of the parts objects are uploaded in. It
+	// defaults to 16MiB and must be at least 5MiB for stores to accept it.
+	PartSize int
+	// HTTPClient defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+type Client struct {
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	prefix          string
+	accessKeyID     string
+	secretAccessKey string
+	partSize        int
+	httpClient      *http.Client
+}
+
+func New(config Config) (*Client, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endpoint: %w", err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported endpoint scheme '%s'", endpoint.Scheme)
+	}
+	if config.Bucket == "" {
+		return nil, errors.New("bucket is empty")
+	}
+
+	partSize := config.PartSize
+	if partSize == 0 {
+		partSize = defaultPartSize
+	}
+	if partSize > maxPartSize {
+		return nil, fmt.Errorf("part size %d is larger than %d", partSize, maxPartSize)
+	}
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{
+		endpoint:        endpoint,
+		region:          config.Region,
+		bucket:          config.Bucket,
+		prefix:          strings.Trim(config.Prefix, "/"),
+		accessKeyID:     config.AccessKeyID,
+		secretAccessKey: config.SecretAccessKey,
+		partSize:        partSize,
+		httpClient:      httpClient,
+	}, nil
+}
+
+// PutObject uploads everything read from r to the object with the given key
+// and returns the number of bytes uploaded. Streams larger than a part are
+// uploaded as a multipart upload, which is aborted should the upload fail.
+//
+// The size is the expected size of the stream, or zero when it is not known.
+// The parts are made large enough for twice the expected size to fit in the
+// parts a multipart upload may have, and a stream expected to be too large to
+// fit at all is refused before anything is uploaded.
+func (c *Client) PutObject(ctx context.Context, key string, r io.Reader, size int64) (int64, error) {
+	partSize, err := c.getPartSize(size)
+	if err != nil {
+		return 0, fmt.Errorf("failed to put object '%s': %w", key, err)
+	}
+
+	buf := make([]byte, partSize)
+	n, err := io.ReadFull(r, buf)
+	switch {
+	case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+		// The whole stream fits in a single request.
+		resp, err := c.do(ctx, http.MethodPut, key, nil, bytes.NewReader(buf[:n]), int64(n))
+		if err != nil {
+			return 0, fmt.Errorf("failed to put object '%s': %w", key, err)
+		}
+		resp.Body.Close()
+		return int64(n), nil
+	case err != nil:
+		return 0, fmt.Errorf("failed to read object '%s': %w", key, err)
+	}
+
+	uploadID, err := c.createMultipartUpload(ctx, key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to put object '%s': %w", key, err)
+	}
+
+	var (
+		total int64
+		parts []completedPart
+	)
+	err = func() error {
+		for {
+			if len(parts) == maxParts {
+				return fmt.Errorf("object is larger than %d parts of %d bytes", maxParts, partSize)
+			}
+			etag, err := c.uploadPart(ctx, key, uploadID, len(parts)+1, buf[:n])
+			if err != nil {
+				return err
+			}
+			parts = append(parts, completedPart{PartNumber: len(parts) + 1, ETag: etag})
+			total += int64(n)
+
+			n, err = io.ReadFull(r, buf)
+			switch {
+			case errors.Is(err, io.EOF):
+				return nil
+			case errors.Is(err, io.ErrUnexpectedEOF):
+				// The last part may be smaller than the part size.
+				if len(parts) == maxParts {
+					return fmt.Errorf("object is larger than %d parts of %d bytes", maxParts, partSize)
+				}
+				etag, err := c.uploadPart(ctx, key, uploadID, len(parts)+1, buf[:n])
+				if err != nil {
+					return err
+				}
+				parts = append(parts, completedPart{PartNumber: len(parts) + 1, ETag: etag})
+				total += int64(n)
+				return nil
+			case err != nil:
+				return fmt.Errorf("failed to read part: %w", err)
+			}
+		}
+	}()
+	if err == nil {
+		err = c.completeMultipartUpload(ctx, key, uploadID, parts)
+	}
+	if err != nil {
+		// We abort with a fresh context so that a cancelled upload is still
+		// cleaned up.
+		if abortErr := c.abortMultipartUpload(context.WithoutCancel(ctx), key, uploadID); abortErr != nil {
+			err = errors.Join(err, abortErr)
+		}
+		return 0, fmt.Errorf("failed to put object '%s': %w", key, err)
+	}
+
+	return total, nil
+}
+
+// getPartSize returns the size of the parts to upload a stream of the given
+// expected size in.
+func (c *Client) getPartSize(size int64) (int, error) {
+	partSize := int64(c.partSize)
+	if need := 2 * size / maxParts; need > partSize {
+		// We round up to a whole MiB to keep the parts aligned.
+		partSize = (need + 1<<20 - 1) &^ (1<<20 - 1)
+	}
+	if partSize > maxPartSize {
+		return 0, fmt.Errorf("object of %d bytes is too large to upload in %d parts", size, maxParts)
+	}
+	return int(partSize), nil
+}
+
+// GetObject returns a reader over the object with the given key. The caller
+// is responsible for closing it.
+func (c *Client) GetObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, http.MethodGet, key, nil, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object '%s': %w", key, err)
+	}
+	return resp.Body, nil
+}
+
+// DeleteObject deletes the object with the given key. Deleting an object that
+// does not exist is not an error.
+func (c *Client) DeleteObject(ctx context.Context, key string) error {
+	resp, err := c.do(ctx, http.MethodDelete, key, nil, nil, 0)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete object '%s': %w", key, err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+func (c *Client) createMultipartUpload(ctx context.Context, key string) (string, error) {
+	resp, err := c.do(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to create multipart upload: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		UploadID string `xml:"UploadId"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode multipart upload: %w", err)
+	}
+	if result.UploadID == "" {
+		return "", errors.New("multipart upload has no upload id")
+	}
+	return result.UploadID, nil
+}
+
+func (c *Client) uploadPart(ctx context.Context, key string, uploadID string, partNumber int, data []byte) (string, error) {
+	query := url.Values{
+		"partNumber": {strconv.Itoa(partNumber)},
+		"uploadId":   {uploadID},
+	}
+	resp, err := c.do(ctx, http.MethodPut, key, query, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+	}
+	resp.Body.Close()
+	return resp.Header.Get("ETag"), nil
+}
+
+func (c *Client) completeMultipartUpload(ctx context.Context, key string, uploadID string, parts []completedPart) error {
+	body, err := xml.Marshal(struct {
+		XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+		Parts   []completedPart `xml:"Part"`
+	}{Parts: parts})
+	if err != nil {
+		return fmt.Errorf("failed to encode multipart upload: %w", err)
+	}
+
+	resp, err := c.do(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadID}}, bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// A completion can fail after the response has started, in which case the
+	// store reports the error in the body.
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read multipart upload completion: %w", err)
+	}
+	if bytes.Contains(data, []byte("<Error>")) {
+		return fmt.Errorf("failed to complete multipart upload: %s", data)
+	}
+	return nil
+}
+
+func (c *Client) abortMultipartUpload(ctx context.Context, key string, uploadID string) error {
+	resp, err := c.do(ctx, http.MethodDelete, key, url.Values{"uploadId": {uploadID}}, nil, 0)
+	if err != nil {
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// do sends a signed request for the object with the given key. Responses
+// other than 2xx are returned as errors.
+func (c *Client) do(ctx context.Context, method string, key string, query url.Values, body io.Reader, contentLength int64) (*http.Response, error) {
+	key = strings.TrimPrefix(key, "/")
+	if c.prefix != "" {
+		key = c.prefix + "/" + key
+	}
+
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + c.bucket + "/" + key
+	// The path is sent encoded exactly as it is signed.
+	u.RawPath = uriEncode(u.Path, false)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.ContentLength = contentLength
+	c.sign(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	return nil, fmt.Errorf("unexpected status %s: %s", resp.Status, data)
+}
@@ -0,0 +1,206 @@
+package objectstore_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jovulic/zfsilo/app/internal/objectstore"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStore is a stand-in for an S3-compatible object store that keeps
+// objects in memory.
+type fakeStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	uploads map[string]map[int][]byte
+	aborted int
+	// failPart fails the upload of the given part number when set.
+	failPart int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		objects: map[string][]byte{},
+		uploads: map[string]map[int][]byte{},
+	}
+}
+
+func (f *fakeStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+		http.Error(w, "<Error><Code>AccessDenied</Code></Error>", http.StatusForbidden)
+		return
+	}
+
+	key := r.URL.Path
+	query := r.URL.Query()
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		uploadID := strconv.Itoa(len(f.uploads) + 1)
+		f.uploads[uploadID] = map[int][]byte{}
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>", uploadID)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		partNumber, _ := strconv.Atoi(query.Get("partNumber"))
+		if partNumber == f.failPart {
+			http.Error(w, "<Error><Code>InternalError</Code></Error>", http.StatusInternalServerError)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		f.uploads[query.Get("uploadId")][partNumber] = data
+		w.Header().Set("ETag", fmt.Sprintf("\"%d\"", partNumber))
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		var complete struct {
+			Parts []struct {
+				PartNumber int `xml:"PartNumber"`
+			} `xml:"Part"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&complete); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		parts := f.uploads[query.Get("uploadId")]
+		numbers := make([]int, 0, len(complete.Parts))
+		for _, part := range complete.Parts {
+			numbers = append(numbers, part.PartNumber)
+		}
+		sort.Ints(numbers)
+		var data []byte
+		for _, number := range numbers {
+			data = append(data, parts[number]...)
+		}
+		f.objects[key] = data
+		delete(f.uploads, query.Get("uploadId"))
+		fmt.Fprint(w, "<CompleteMultipartUploadResult></CompleteMultipartUploadResult>")
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(f.uploads, query.Get("uploadId"))
+		f.aborted++
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[key] = data
+	case r.Method == http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "unsupported", http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestClient(t *testing.T, store *fakeStore) *objectstore.Client {
+	t.Helper()
+
+	server := httptest.NewServer(store)
+	t.Cleanup(server.Close)
+
+	client, err := objectstore.New(objectstore.Config{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Bucket:          "backups",
+		Prefix:          "zfsilo",
+		AccessKeyID:     "access",
+		SecretAccessKey: "secret",
+		PartSize:        1024,
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestPutAndGetObject(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("it puts a small object in a single request", func(t *testing.T) {
+		store := newFakeStore()
+		client := newTestClient(t, store)
+
+		n, err := client.PutObject(ctx, "vol_1/manifest.json", strings.NewReader("{}"), 2)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), n)
+		require.Equal(t, []byte("{}"), store.objects["/backups/zfsilo/vol_1/manifest.json"])
+		require.Empty(t, store.uploads)
+
+		r, err := client.GetObject(ctx, "vol_1/manifest.json")
+		require.NoError(t, err)
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, []byte("{}"), data)
+	})
+
+	t.Run("it puts a large object in parts", func(t *testing.T) {
+		store := newFakeStore()
+		client := newTestClient(t, store)
+
+		data := make([]byte, 2500)
+		_, _ = rand.Read(data)
+
+		n, err := client.PutObject(ctx, "vol_1/exp.zfs", bytes.NewReader(data), 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(data)), n)
+		require.Equal(t, data, store.objects["/backups/zfsilo/vol_1/exp.zfs"])
+	})
+
+	t.Run("it aborts a failed multipart upload", func(t *testing.T) {
+		store := newFakeStore()
+		store.failPart = 2
+		client := newTestClient(t, store)
+
+		_, err := client.PutObject(ctx, "vol_1/exp.zfs", bytes.NewReader(make([]byte, 2500)), 0)
+		require.Error(t, err)
+		require.Equal(t, 1, store.aborted)
+		require.Empty(t, store.objects)
+	})
+
+	t.Run("it grows the parts for a large expected size", func(t *testing.T) {
+		store := newFakeStore()
+		client := newTestClient(t, store)
+
+		data := make([]byte, 2500)
+		_, _ = rand.Read(data)
+
+		// A part large enough for the expected size holds the whole stream.
+		_, err := client.PutObject(ctx, "vol_1/exp.zfs", bytes.NewReader(data), 100<<20)
+		require.NoError(t, err)
+		require.Equal(t, data, store.objects["/backups/zfsilo/vol_1/exp.zfs"])
+		require.Empty(t, store.uploads)
+	})
+
+	t.Run("it refuses an object too large to upload", func(t *testing.T) {
+		store := newFakeStore()
+		client := newTestClient(t, store)
+
+		_, err := client.PutObject(ctx, "vol_1/exp.zfs", bytes.NewReader(make([]byte, 2500)), 1<<50)
+		require.ErrorContains(t, err, "too large to upload")
+		require.Empty(t, store.uploads)
+		require.Empty(t, store.objects)
+	})
+
+	t.Run("it returns not found for a missing object", func(t *testing.T) {
+		store := newFakeStore()
+		client := newTestClient(t, store)
+
+		_, err := client.GetObject(ctx, "vol_1/manifest.json")
+		require.True(t, errors.Is(err, objectstore.ErrNotFound))
+	})
+}
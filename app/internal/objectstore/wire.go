@@ -0,0 +1,35 @@
+package objectstore
+
+import (
+	"fmt"
+
+	"github.com/google/wire"
+	"github.com/jovulic/zfsilo/app/internal/config"
+)
+
+var WireSet = wire.NewSet(
+	WireClient,
+)
+
+// WireClient returns the client for the configured object store, or nil when
+// no object store is configured.
+func WireClient(
+	config config.Config,
+) (*Client, error) {
+	if config.ObjectStore == nil {
+		return nil, nil
+	}
+	client, err := New(Config{
+		Endpoint:        config.ObjectStore.Endpoint,
+		Region:          config.ObjectStore.Region,
+		Bucket:          config.ObjectStore.Bucket,
+		Prefix:          config.ObjectStore.Prefix,
+		AccessKeyID:     config.ObjectStore.AccessKeyID,
+		SecretAccessKey: config.ObjectStore.SecretAccessKey.Value(),
+		PartSize:        config.ObjectStore.PartSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create object store client: %w", err)
+	}
+	return client, nil
+}
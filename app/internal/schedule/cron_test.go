@@ -0,0 +1,74 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jovulic/zfsilo/app/internal/schedule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	valid := []string{
+		"* * * * *",
+		"0 0 * * *",
+		"*/15 * * * *",
+		"0 9-17/2 * * 1-5",
+		"30 2 1,15 * *",
+		"0 0 * * 7",
+	}
+	for _, expr := range valid {
+		_, err := schedule.ParseCron(expr)
+		assert.NoError(t, err, "expected '%s' to parse", expr)
+	}
+
+	invalid := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	}
+	for _, expr := range invalid {
+		_, err := schedule.ParseCron(expr)
+		assert.Error(t, err, "expected '%s' to fail to parse", expr)
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	base := time.Date(2025, time.January, 31, 10, 7, 30, 0, time.UTC) // Friday
+
+	tests := []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2025, time.January, 31, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2025, time.January, 31, 10, 15, 0, 0, time.UTC)},
+		{"0 0 * * *", time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 2 * * 0", time.Date(2025, time.February, 2, 2, 0, 0, 0, time.UTC)},
+		{"0 2 * * 7", time.Date(2025, time.February, 2, 2, 0, 0, 0, time.UTC)},
+		{"0 0 31 * *", time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// Restricting both day fields matches either of them.
+		{"0 0 15 * 1", time.Date(2025, time.February, 3, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			cron, err := schedule.ParseCron(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cron.Next(base))
+		})
+	}
+}
+
+func TestCronNextNever(t *testing.T) {
+	cron, err := schedule.ParseCron("0 0 31 2 *")
+	require.NoError(t, err)
+	assert.True(t, cron.Next(time.Now()).IsZero())
+}
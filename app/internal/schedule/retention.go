@@ -0,0 +1,75 @@
+package schedule
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Retention describes which snapshots of a series are kept. A snapshot is kept
+// when any of the rules selects it. A negative rule is treated as zero.
+type Retention struct {
+	// KeepLast keeps the most recent snapshots.
+	KeepLast int
+	// KeepDaily keeps the most recent snapshot of each of the most recent days
+	// that have snapshots.
+	KeepDaily int
+	// KeepWeekly keeps the most recent snapshot of each of the most recent ISO
+	// weeks that have snapshots.
+	KeepWeekly int
+}
+
+// IsZero reports whether no rules are set, in which case every snapshot is
+// kept.
+func (r Retention) IsZero() bool {
+	return r.KeepLast <= 0 && r.KeepDaily <= 0 && r.KeepWeekly <= 0
+}
+
+// Prune returns the indexes, in ascending order, of the times that are not
+// kept by the retention rules. Days and weeks are determined in UTC.
+func (r Retention) Prune(times []time.Time) []int {
+	if r.IsZero() {
+		return nil
+	}
+
+	// Walk the times from newest to oldest.
+	order := make([]int, len(times))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return times[b].Compare(times[a])
+	})
+
+	keep := make([]bool, len(times))
+	for _, i := range order[:min(max(r.KeepLast, 0), len(order))] {
+		keep[i] = true
+	}
+
+	days := make(map[string]struct{})
+	weeks := make(map[string]struct{})
+	for _, i := range order {
+		t := times[i].UTC()
+
+		day := t.Format(time.DateOnly)
+		if _, ok := days[day]; !ok && len(days) < r.KeepDaily {
+			days[day] = struct{}{}
+			keep[i] = true
+		}
+
+		year, week := t.ISOWeek()
+		weekKey := fmt.Sprintf("%d-%02d", year, week)
+		if _, ok := weeks[weekKey]; !ok && len(weeks) < r.KeepWeekly {
+			weeks[weekKey] = struct{}{}
+			keep[i] = true
+		}
+	}
+
+	var pruned []int
+	for i, kept := range keep {
+		if !kept {
+			pruned = append(pruned, i)
+		}
+	}
+	return pruned
+}
@@ -0,0 +1,62 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jovulic/zfsilo/app/internal/schedule"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetentionPrune(t *testing.T) {
+	// Snapshots every twelve hours over three weeks, oldest first.
+	start := time.Date(2025, time.January, 6, 0, 0, 0, 0, time.UTC) // Monday
+	var times []time.Time
+	for i := range 42 {
+		times = append(times, start.Add(time.Duration(i)*12*time.Hour))
+	}
+
+	t.Run("Zero", func(t *testing.T) {
+		assert.Empty(t, schedule.Retention{}.Prune(times))
+	})
+
+	t.Run("KeepLast", func(t *testing.T) {
+		pruned := schedule.Retention{KeepLast: 3}.Prune(times)
+		assert.Len(t, pruned, 39)
+		assert.NotContains(t, pruned, 39)
+		assert.NotContains(t, pruned, 40)
+		assert.NotContains(t, pruned, 41)
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		pruned := schedule.Retention{KeepLast: -1, KeepDaily: 2}.Prune(times)
+		assert.Len(t, pruned, 40)
+		assert.Empty(t, schedule.Retention{KeepLast: -1}.Prune(times))
+	})
+
+	t.Run("KeepDaily", func(t *testing.T) {
+		pruned := schedule.Retention{KeepDaily: 2}.Prune(times)
+		assert.Len(t, pruned, 40)
+		// The noon snapshots of the last two days.
+		assert.NotContains(t, pruned, 41)
+		assert.NotContains(t, pruned, 39)
+	})
+
+	t.Run("KeepWeekly", func(t *testing.T) {
+		pruned := schedule.Retention{KeepWeekly: 5}.Prune(times)
+		// Only three weeks exist, keeping the last snapshot of each.
+		assert.Len(t, pruned, 39)
+		assert.NotContains(t, pruned, 13)
+		assert.NotContains(t, pruned, 27)
+		assert.NotContains(t, pruned, 41)
+	})
+
+	t.Run("Combined", func(t *testing.T) {
+		pruned := schedule.Retention{KeepLast: 2, KeepDaily: 2, KeepWeekly: 2}.Prune(times)
+		// Indexes 41 and 40 by last, 39 by daily and 27 by weekly.
+		assert.Len(t, pruned, 38)
+		for _, kept := range []int{41, 40, 39, 27} {
+			assert.NotContains(t, pruned, kept)
+		}
+	})
+}
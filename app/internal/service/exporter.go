@@ -0,0 +1,525 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jovulic/zfsilo/app/internal/command"
+	"github.com/jovulic/zfsilo/app/internal/command/zfs"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	"github.com/jovulic/zfsilo/app/internal/objectstore"
+	libcommand "github.com/jovulic/zfsilo/lib/command"
+	ulid "github.com/oklog/ulid/v2"
+	slogctx "github.com/veqryn/slog-context"
+	"gorm.io/gorm"
+)
+
+var (
+	// errObjectStoreNotConfigured is returned when exporting or importing
+	// without an object store.
+	errObjectStoreNotConfigured = errors.New("no object store is configured")
+	// errNoExports is returned when a volume has not been exported.
+	errNoExports = errors.New("volume has no exports")
+	// errExportNotFound is returned when an export is not in the manifest.
+	errExportNotFound = errors.New("export does not exist")
+	// errExportIncompatible is returned when exports cannot be imported into
+	// a volume.
+	errExportIncompatible = errors.New("export is incompatible with volume")
+)
+
+// exportManifest records the exports of a volume along with their lineage. It
+// is kept next to the exports in the object store so that they can be
+// restored without the database. The exports of an encrypted volume are raw
+// send streams that can only be read with its key.
+type exportManifest struct {
+	VolumeID      string                `json:"volumeId"`
+	DatasetID     string                `json:"datasetId"`
+	Mode          database.VolumeMode   `json:"mode"`
+	CapacityBytes int64                 `json:"capacityBytes"`
+	Encrypted     bool                  `json:"encrypted,omitempty"`
+	Exports       []exportManifestEntry `json:"exports"`
+}
+
+type exportManifestEntry struct {
+	Name       string    `json:"name"`
+	Base       string    `json:"base,omitempty"`
+	ObjectKey  string    `json:"objectKey"`
+	Bytes      int64     `json:"bytes"`
+	CreateTime time.Time `json:"createTime"`
+}
+
+// latest returns the most recent export.
+func (m *exportManifest) latest() (exportManifestEntry, bool) {
+	if len(m.Exports) == 0 {
+		return exportManifestEntry{}, false
+	}
+	return m.Exports[len(m.Exports)-1], true
+}
+
+// lineage returns the exports needed to restore the named export, starting
+// with the full export it descends from. The latest export is used when the
+// name is empty.
+func (m *exportManifest) lineage(name string) ([]exportManifestEntry, error) {
+	if name == "" {
+		latest, ok := m.latest()
+		if !ok {
+			return nil, errNoExports
+		}
+		name = latest.Name
+	}
+
+	byName := make(map[string]exportManifestEntry, len(m.Exports))
+	for _, entry := range m.Exports {
+		byName[entry.Name] = entry
+	}
+
+	var entries []exportManifestEntry
+	for name != "" {
+		entry, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", errExportNotFound, name)
+		}
+		// A manifest is only ever appended to, so a base always precedes the
+		// exports built on it and the walk cannot cycle.
+		if len(entries) > len(m.Exports) {
+			return nil, errors.New("export lineage is cyclic")
+		}
+		entries = append([]exportManifestEntry{entry}, entries...)
+		name = entry.Base
+	}
+	return entries, nil
+}
+
+func buildExportName() string {
+	return fmt.Sprintf("exp-%s", strings.ToLower(ulid.Make().String()))
+}
+
+func buildExportManifestKey(volumeID string) string {
+	return fmt.Sprintf("%s/manifest.json", volumeID)
+}
+
+func buildExportObjectKey(volumeID string, name string) string {
+	return fmt.Sprintf("%s/%s.zfs", volumeID, name)
+}
+
+// Exporter streams volumes to and from an object store as ZFS send streams.
+//
+// Each export takes a snapshot of the volume and uploads its send stream, in
+// full or incrementally from the previous export. As with replication, the
+// snapshot is swapped for a bookmark so that the volume does not hold on to
+// exported data.
+type Exporter struct {
+	database        *gorm.DB
+	executorFactory *command.ExecutorFactory
+	store           *objectstore.Client
+
+	// mu serializes exports and imports as they read and write the manifest.
+	mu sync.Mutex
+}
+
+func NewExporter(
+	database *gorm.DB,
+	executorFactory *command.ExecutorFactory,
+	store *objectstore.Client,
+) *Exporter {
+	return &Exporter{
+		database:        database,
+		executorFactory: executorFactory,
+		store:           store,
+	}
+}
+
+// List returns the exports of the volume, oldest first.
+func (e *Exporter) List(ctx context.Context, volumeID string) ([]exportManifestEntry, error) {
+	if e.store == nil {
+		return nil, errObjectStoreNotConfigured
+	}
+
+	manifest, err := e.readManifest(ctx, volumeID)
+	if err != nil {
+		if errors.Is(err, errNoExports) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return manifest.Exports, nil
+}
+
+// Export uploads the send stream of the volume to the object store and records
+// it in the manifest of the volume.
+func (e *Exporter) Export(ctx context.Context, volumedb *database.Volume, incremental bool) (exportManifestEntry, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.store == nil {
+		return exportManifestEntry{}, errObjectStoreNotConfigured
+	}
+	if err := requireServerHost(volumedb); err != nil {
+		return exportManifestEntry{}, err
+	}
+
+	manifest, err := e.readManifest(ctx, volumedb.ID)
+	if err != nil && !errors.Is(err, errNoExports) {
+		return exportManifestEntry{}, err
+	}
+	latest, hasLatest := manifest.latest()
+	if incremental && !hasLatest {
+		return exportManifestEntry{}, errNoExports
+	}
+
+	executor, err := e.getExecutorForHost(ctx, volumedb.ServerHost)
+	if err != nil {
+		return exportManifestEntry{}, err
+	}
+	source := zfs.With(executor)
+
+	name := buildExportName()
+	snapshot := database.BuildSnapshotDatasetID(volumedb.DatasetID, name)
+	bookmark := database.BuildBookmarkDatasetID(volumedb.DatasetID, name)
+	entry := exportManifestEntry{
+		Name:       name,
+		ObjectKey:  buildExportObjectKey(volumedb.ID, name),
+		CreateTime: time.Now().UTC(),
+	}
+
+	err = source.CreateSnapshot(ctx, zfs.CreateSnapshotArguments{
+		Name: snapshot,
+	})
+	if err != nil {
+		return exportManifestEntry{}, fmt.Errorf("failed to create zfs snapshot: %w", err)
+	}
+	// The snapshot is only needed for the send as the bookmark takes its place
+	// as the base of the next incremental.
+	defer func() {
+		err := source.DestroySnapshot(ctx, zfs.DestroySnapshotArguments{
+			Name: snapshot,
+		})
+		if err != nil {
+			slogctx.Error(ctx, "failed to destroy export snapshot", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+		}
+	}()
+
+	err = source.CreateBookmark(ctx, zfs.CreateBookmarkArguments{
+		Snapshot: snapshot,
+		Bookmark: bookmark,
+	})
+	if err != nil {
+		return exportManifestEntry{}, fmt.Errorf("failed to create zfs bookmark: %w", err)
+	}
+	destroyBookmark := func(bookmark string) {
+		if err := source.DestroyBookmark(ctx, zfs.DestroyBookmarkArguments{Name: bookmark}); err != nil {
+			slogctx.Error(ctx, "failed to destroy export bookmark", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+		}
+	}
+
+	// Encrypted volumes are sent raw so that they stay encrypted in transit
+	// and on the other end.
+	sendArgs := zfs.SendArguments{
+		Snapshot: snapshot,
+		Raw:      volumedb.Encrypted,
+	}
+	if incremental {
+		entry.Base = latest.Name
+		sendArgs.From = database.BuildBookmarkDatasetID(volumedb.DatasetID, latest.Name)
+	}
+	// The stream is expected to be at most the logical size of the snapshot,
+	// which sizes the parts it is uploaded in.
+	sizeString, err := source.GetProperty(ctx, zfs.GetPropertyArguments{
+		Name:        snapshot,
+		PropertyKey: "logicalreferenced",
+	})
+	if err != nil {
+		destroyBookmark(bookmark)
+		return exportManifestEntry{}, fmt.Errorf("failed to get zfs snapshot size: %w", err)
+	}
+	size, err := strconv.ParseInt(sizeString, 10, 64)
+	if err != nil {
+		destroyBookmark(bookmark)
+		return exportManifestEntry{}, fmt.Errorf("failed to parse zfs snapshot size: %w", err)
+	}
+	entry.Bytes, err = upload(ctx, source, sendArgs, e.store, entry.ObjectKey, size)
+	if err != nil {
+		destroyBookmark(bookmark)
+		return exportManifestEntry{}, err
+	}
+
+	manifest.VolumeID = volumedb.ID
+	manifest.DatasetID = volumedb.DatasetID
+	manifest.Mode = volumedb.Mode
+	manifest.CapacityBytes = volumedb.CapacityBytes
+	manifest.Encrypted = volumedb.Encrypted
+	manifest.Exports = append(manifest.Exports, entry)
+	if err := e.writeManifest(ctx, manifest); err != nil {
+		if err := e.store.DeleteObject(ctx, entry.ObjectKey); err != nil {
+			slogctx.Error(ctx, "failed to delete unrecorded export", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+		}
+		destroyBookmark(bookmark)
+		return exportManifestEntry{}, err
+	}
+
+	// Only the bookmark of the latest export is needed to export incrementally.
+	if hasLatest {
+		destroyBookmark(database.BuildBookmarkDatasetID(volumedb.DatasetID, latest.Name))
+	}
+
+	return entry, nil
+}
+
+// Import receives the exports of the source volume, up to the named export,
+// as the ZFS volume of the volume on the host. The received volume keeps no
+// export snapshots.
+func (e *Exporter) Import(ctx context.Context, volumedb *database.Volume, hostID string, sourceVolumeID string, name string) ([]exportManifestEntry, int64, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.store == nil {
+		return nil, 0, errObjectStoreNotConfigured
+	}
+
+	manifest, err := e.readManifest(ctx, sourceVolumeID)
+	if err != nil {
+		return nil, 0, err
+	}
+	entries, err := manifest.lineage(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	switch {
+	case manifest.Mode != volumedb.Mode:
+		return nil, 0, fmt.Errorf("%w: volume mode must match the exported volume mode", errExportIncompatible)
+	case volumedb.CapacityBytes < manifest.CapacityBytes:
+		return nil, 0, fmt.Errorf("%w: volume capacity must be at least the exported volume capacity of %d bytes", errExportIncompatible, manifest.CapacityBytes)
+	case manifest.Encrypted != volumedb.Encrypted:
+		return nil, 0, fmt.Errorf("%w: volume encryption must match the exported volume encryption", errExportIncompatible)
+	}
+
+	// The exports of an encrypted volume are encrypted with its key, which we
+	// take from the exported volume when importing into another volume.
+	var sourceKey []byte
+	if manifest.Encrypted {
+		keySource := volumedb
+		if sourceVolumeID != volumedb.ID {
+			keySource, err = gorm.G[*database.Volume](e.database).Where("id = ?", sourceVolumeID).First(ctx)
+			switch {
+			case err == nil:
+				// okay
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				return nil, 0, fmt.Errorf("%w: the key of the exported volume is not known", errExportIncompatible)
+			default:
+				return nil, 0, fmt.Errorf("failed to get exported volume: %w", err)
+			}
+		}
+		sourceKey, err = keySource.RawEncryptionKey()
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w: %w", errExportIncompatible, err)
+		}
+	}
+
+	executor, err := e.getExecutorForHost(ctx, hostID)
+	if err != nil {
+		return nil, 0, err
+	}
+	target := zfs.With(executor)
+
+	exists, err := target.VolumeExists(ctx, zfs.VolumeExistsArguments{
+		Name: volumedb.DatasetID,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to check zfs volume: %w", err)
+	}
+	if exists {
+		return nil, 0, fmt.Errorf("%w: dataset %s already exists on server host", errExportIncompatible, volumedb.DatasetID)
+	}
+
+	var bytesTransferred int64
+	for i, entry := range entries {
+		n, err := download(ctx, e.store, entry.ObjectKey, target, zfs.ReceiveArguments{
+			Name: volumedb.DatasetID,
+			// Incrementals roll back anything that touched the volume since
+			// the previous receive.
+			Force: i > 0,
+		})
+		if err != nil {
+			err := target.DestroyVolume(ctx, zfs.DestroyVolumeArguments{
+				Name:      volumedb.DatasetID,
+				Recursive: true,
+			})
+			if err != nil {
+				slogctx.Error(ctx, "failed to destroy partially imported zfs volume", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+			}
+			return nil, 0, fmt.Errorf("failed to import export %s: %w", entry.Name, err)
+		}
+		bytesTransferred += n
+	}
+
+	// The received volume is encrypted under the key of the exported volume,
+	// so we switch it over to the key of the volume.
+	if manifest.Encrypted {
+		err := importKey(ctx, target, volumedb, sourceKey)
+		if err != nil {
+			err := target.DestroyVolume(ctx, zfs.DestroyVolumeArguments{
+				Name:      volumedb.DatasetID,
+				Recursive: true,
+			})
+			if err != nil {
+				slogctx.Error(ctx, "failed to destroy imported zfs volume", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+			}
+			return nil, 0, err
+		}
+	}
+
+	// The import has gone through at this point, so failing to clean up after
+	// it is not an error.
+	for _, entry := range entries {
+		err := target.DestroySnapshot(ctx, zfs.DestroySnapshotArguments{
+			Name: database.BuildSnapshotDatasetID(volumedb.DatasetID, entry.Name),
+		})
+		if err != nil {
+			slogctx.Error(ctx, "failed to destroy export snapshot", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+		}
+	}
+
+	return entries, bytesTransferred, nil
+}
+
+// importKey loads the key of the exported volume on a received encrypted ZFS
+// volume and changes it to the key of the volume.
+func importKey(ctx context.Context, target zfs.ZFS, volumedb *database.Volume, sourceKey []byte) error {
+	err := target.LoadKey(ctx, zfs.LoadKeyArguments{
+		Name: volumedb.DatasetID,
+		Key:  sourceKey,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load key of imported zfs volume: %w", err)
+	}
+
+	key, err := volumedb.RawEncryptionKey()
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(key, sourceKey) {
+		return nil
+	}
+	err = target.ChangeKey(ctx, zfs.ChangeKeyArguments{
+		Name: volumedb.DatasetID,
+		Key:  key,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to change key of imported zfs volume: %w", err)
+	}
+	return nil
+}
+
+// readManifest returns the manifest of the volume. A volume that has not been
+// exported gets an empty manifest along with errNoExports.
+func (e *Exporter) readManifest(ctx context.Context, volumeID string) (*exportManifest, error) {
+	r, err := e.store.GetObject(ctx, buildExportManifestKey(volumeID))
+	if err != nil {
+		if errors.Is(err, objectstore.ErrNotFound) {
+			return &exportManifest{VolumeID: volumeID}, errNoExports
+		}
+		return nil, fmt.Errorf("failed to read export manifest: %w", err)
+	}
+	defer r.Close()
+
+	var manifest exportManifest
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode export manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+func (e *Exporter) writeManifest(ctx context.Context, manifest *exportManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode export manifest: %w", err)
+	}
+	_, err = e.store.PutObject(ctx, buildExportManifestKey(manifest.VolumeID), bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return fmt.Errorf("failed to write export manifest: %w", err)
+	}
+	return nil
+}
+
+// upload pipes a send stream of the expected size from the source into an
+// object and returns the number of bytes uploaded.
+func upload(ctx context.Context, source zfs.ZFS, sendArgs zfs.SendArguments, store *objectstore.Client, key string, size int64) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pr, pw := io.Pipe()
+
+	sendErr := make(chan error, 1)
+	go func() {
+		err := source.Send(ctx, sendArgs, pw)
+		pw.CloseWithError(err)
+		sendErr <- err
+	}()
+
+	n, putErr := store.PutObject(ctx, key, pr, size)
+	// We close the reader so that a send is not left blocked on an upload
+	// that has stopped reading.
+	pr.Close()
+	if putErr != nil {
+		cancel()
+		<-sendErr
+		return 0, putErr
+	}
+	if err := <-sendErr; err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// download pipes an object into a receive on the target and returns the
+// number of bytes received.
+func download(ctx context.Context, store *objectstore.Client, key string, target zfs.ZFS, receiveArgs zfs.ReceiveArguments) (int64, error) {
+	r, err := store.GetObject(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	counter := &countingReader{r: r}
+	if err := target.Receive(ctx, receiveArgs, counter); err != nil {
+		return 0, err
+	}
+	return counter.n, nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (e *Exporter) getExecutorForHost(ctx context.Context, hostID string) (libcommand.Executor, error) {
+	if hostID == "" {
+		return nil, fmt.Errorf("host ID is empty")
+	}
+	// We search by name.
+	host, err := gorm.G[*database.Host](e.database).Where("name = ?", hostID).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host %s: %w", hostID, err)
+	}
+	executor, err := e.executorFactory.BuildExecutor(host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build executor for host %s: %w", hostID, err)
+	}
+	return executor, nil
+}
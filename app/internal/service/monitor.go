@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jovulic/zfsilo/app/internal/command"
+	"github.com/jovulic/zfsilo/app/internal/command/zpool"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	"github.com/jovulic/zfsilo/app/internal/schedule"
+	libcommand "github.com/jovulic/zfsilo/lib/command"
+	slogctx "github.com/veqryn/slog-context"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// poolMonitorInterval is how often the monitor polls the pools of the server
+// hosts. It matches the minute granularity of cron schedules.
+const poolMonitorInterval = time.Minute
+
+// PoolMonitor polls the pools of the server hosts, recording their health on
+// the host, and scrubs them according to the scrub schedules of the hosts.
+type PoolMonitor struct {
+	database        *gorm.DB
+	executorFactory *command.ExecutorFactory
+}
+
+func NewPoolMonitor(
+	database *gorm.DB,
+	executorFactory *command.ExecutorFactory,
+) *PoolMonitor {
+	return &PoolMonitor{
+		database:        database,
+		executorFactory: executorFactory,
+	}
+}
+
+// Start runs the monitor in the background until the context is done.
+func (m *PoolMonitor) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(poolMonitorInterval)
+		defer ticker.Stop()
+
+		// Each pass starts the scrubs that were due since the previous pass.
+		// Scrubs missed while the application was down are not caught up on.
+		last := time.Now().UTC()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				now := time.Now().UTC()
+				if err := m.Run(ctx, last, now); err != nil {
+					slogctx.Error(ctx, "failed to monitor pools", slogctx.Err(err))
+				}
+				last = now
+			}
+		}
+	}()
+}
+
+// Run polls every server host and starts the scrubs with a scheduled time in
+// (since, until]. Failures against a host are recorded in its status rather
+// than returned.
+func (m *PoolMonitor) Run(ctx context.Context, since time.Time, until time.Time) error {
+	hostdbs, err := gorm.G[*database.Host](m.database).Where("json_extract(role, '$.type') = ?", database.HostRoleTypeServer).Find(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get server hosts: %w", err)
+	}
+
+	for _, hostdb := range hostdbs {
+		status := hostdb.Status.Data()
+		status.LastPollTime = until
+
+		pools, pollErr := m.pollHost(ctx, hostdb, since, until)
+		if pollErr != nil {
+			slogctx.Error(ctx, "failed to poll host pools", slog.String("hostId", hostdb.ID), slogctx.Err(pollErr))
+			status.LastError = pollErr.Error()
+		} else {
+			status.LastError = ""
+			status.Pools = pools
+		}
+		hostdb.Status = datatypes.NewJSONType(status)
+
+		_, err := gorm.G[*database.Host](m.database).
+			Where("id = ?", hostdb.ID).
+			Select("status").
+			Updates(ctx, hostdb)
+		if err != nil {
+			return fmt.Errorf("failed to update status of host %s: %w", hostdb.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// pollHost returns the status of each pool of the host, carrying over what is
+// tracked across polls from its previous status.
+func (m *PoolMonitor) pollHost(ctx context.Context, hostdb *database.Host, since time.Time, until time.Time) ([]database.HostPoolStatus, error) {
+	executor, err := m.executorFactory.BuildExecutor(hostdb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build executor: %w", err)
+	}
+
+	pools, err := zpool.With(executor).List(ctx, zpool.ListArguments{})
+	if err != nil {
+		return nil, err
+	}
+
+	var scrubSchedules map[string]string
+	if role := hostdb.Role.Data(); role.Server != nil {
+		scrubSchedules = role.Server.ScrubSchedules
+	}
+
+	previous := hostdb.Status.Data()
+	statuses := make([]database.HostPoolStatus, 0, len(pools))
+	for _, pool := range pools {
+		prev, seen := previous.Pool(pool.Name)
+		status, err := m.pollPool(ctx, executor, pool.Name, prev, until)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case !seen:
+			status.HealthChangeTime = until
+		case prev.Health != status.Health:
+			status.PreviousHealth = prev.Health
+			status.HealthChangeTime = until
+			attrs := []any{slog.String("hostId", hostdb.ID), slog.String("pool", pool.Name), slog.String("previousHealth", prev.Health), slog.String("health", status.Health)}
+			if status.Health == string(zpool.HealthOnline) {
+				slogctx.Info(ctx, "pool health recovered", attrs...)
+			} else {
+				slogctx.Warn(ctx, "pool health changed", attrs...)
+			}
+		}
+		if seen && status.ChecksumErrors > prev.ChecksumErrors {
+			slogctx.Warn(ctx, "pool checksum errors increased", slog.String("hostId", hostdb.ID), slog.String("pool", pool.Name), slog.Uint64("checksumErrors", status.ChecksumErrors))
+		}
+
+		if expr, ok := scrubSchedules[pool.Name]; ok {
+			m.scrubPool(ctx, executor, &status, expr, since, until)
+		}
+
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// pollPool returns the current status of the pool. The health change and the
+// scrub results are carried over from the previous status and are updated by
+// the caller and from the scan of the pool.
+func (m *PoolMonitor) pollPool(ctx context.Context, executor libcommand.Executor, name string, prev database.HostPoolStatus, now time.Time) (database.HostPoolStatus, error) {
+	poolStatus, err := zpool.With(executor).Status(ctx, zpool.StatusArguments{Name: name})
+	if err != nil {
+		return database.HostPoolStatus{}, err
+	}
+
+	status := database.HostPoolStatus{
+		Name:             name,
+		PollTime:         now,
+		Health:           string(poolStatus.State),
+		PreviousHealth:   prev.PreviousHealth,
+		HealthChangeTime: prev.HealthChangeTime,
+		Status:           poolStatus.Status,
+		Action:           poolStatus.Action,
+		Errors:           poolStatus.Errors,
+		ReadErrors:       poolStatus.ReadErrors,
+		WriteErrors:      poolStatus.WriteErrors,
+		ChecksumErrors:   poolStatus.ChecksumErrors,
+		LastScrub:        prev.LastScrub,
+		ScrubError:       prev.ScrubError,
+	}
+
+	// A pool only reports its latest scan, so the last scrub is kept from the
+	// previous status while the pool resilvers.
+	if scan, ok := zpool.ParseScan(poolStatus.Scan); ok {
+		switch scan.Function {
+		case zpool.ScanFunctionScrub:
+			status.LastScrub = database.HostPoolScrub{
+				State:         string(scan.State),
+				StartTime:     scan.StartTime,
+				EndTime:       scan.EndTime,
+				RepairedBytes: scan.RepairedBytes,
+				Errors:        scan.Errors,
+				PercentDone:   scan.PercentDone,
+			}
+		case zpool.ScanFunctionResilver:
+			if scan.State == zpool.ScanStateScanning {
+				status.ResilverPercentDone = scan.PercentDone
+			}
+		}
+	}
+
+	return status, nil
+}
+
+// scrubPool starts a scrub of the pool if its schedule was due in
+// (since, until]. The outcome is recorded in the status.
+func (m *PoolMonitor) scrubPool(ctx context.Context, executor libcommand.Executor, status *database.HostPoolStatus, expr string, since time.Time, until time.Time) {
+	status.ScrubSchedule = expr
+
+	cron, err := schedule.ParseCron(expr)
+	if err != nil {
+		status.ScrubError = err.Error()
+		return
+	}
+	status.NextScrubTime = cron.Next(until)
+
+	next := cron.Next(since)
+	if next.IsZero() || next.After(until) {
+		return
+	}
+
+	err = zpool.With(executor).Scrub(ctx, zpool.ScrubArguments{Name: status.Name})
+	if err != nil {
+		slogctx.Error(ctx, "failed to start scheduled scrub", slog.String("pool", status.Name), slogctx.Err(err))
+		status.ScrubError = err.Error()
+		return
+	}
+	status.ScrubError = ""
+	status.LastScrub = database.HostPoolScrub{
+		State:     string(zpool.ScanStateScanning),
+		StartTime: until,
+	}
+}
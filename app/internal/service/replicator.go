@@ -0,0 +1,374 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jovulic/zfsilo/app/internal/command"
+	"github.com/jovulic/zfsilo/app/internal/command/zfs"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	libcommand "github.com/jovulic/zfsilo/lib/command"
+	ulid "github.com/oklog/ulid/v2"
+	slogctx "github.com/veqryn/slog-context"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// replicatorInterval is how often the replicator checks for due replications.
+const replicatorInterval = time.Minute
+
+// Replicator periodically sends the changes of replicated volumes to their
+// target hosts.
+//
+// Each sync takes a snapshot of the volume, sends it to the target (in full
+// the first time and incrementally from the previous bookmark afterwards), and
+// then swaps the snapshot for a bookmark on the volume. Bookmarks take no space
+// so the volume does not hold on to replicated data, while the target keeps the
+// most recently received snapshot as the base of the next incremental.
+type Replicator struct {
+	database        *gorm.DB
+	executorFactory *command.ExecutorFactory
+
+	// mu serializes syncs so that the background loop and explicit syncs do
+	// not race on the same incremental chain.
+	mu sync.Mutex
+	// cancelMu guards cancel, which cancels the sync in flight if any, and
+	// holds, the number of callers waiting on or holding off syncs.
+	cancelMu sync.Mutex
+	cancel   context.CancelFunc
+	holds    int
+}
+
+func NewReplicator(
+	database *gorm.DB,
+	executorFactory *command.ExecutorFactory,
+) *Replicator {
+	return &Replicator{
+		database:        database,
+		executorFactory: executorFactory,
+	}
+}
+
+// Start runs the replicator in the background until the context is done.
+func (r *Replicator) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(replicatorInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Run(ctx, time.Now().UTC()); err != nil {
+					slogctx.Error(ctx, "failed to run replications", slogctx.Err(err))
+				}
+			}
+		}
+	}()
+}
+
+// Run syncs every replication whose interval has elapsed since its last
+// attempt. Failures of a sync are recorded in the status of its replication
+// rather than returned.
+func (r *Replicator) Run(ctx context.Context, now time.Time) error {
+	repldbs, err := gorm.G[*database.Replication](r.database).Find(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get replications: %w", err)
+	}
+
+	for _, repldb := range repldbs {
+		status := repldb.Status.Data()
+		if !status.LastAttemptTime.IsZero() && now.Before(status.LastAttemptTime.Add(repldb.Interval())) {
+			continue
+		}
+		if _, err := r.Sync(ctx, repldb.ID); err != nil {
+			slogctx.Error(ctx, "failed to sync replication", slog.String("replicationId", repldb.ID), slogctx.Err(err))
+		}
+	}
+
+	return nil
+}
+
+// Sync replicates the current state of the volume to the target and returns
+// the replication with its updated status. The outcome is recorded in the
+// status whether or not the sync succeeds.
+func (r *Replicator) Sync(ctx context.Context, id string) (*database.Replication, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	repldb, err := gorm.G[*database.Replication](r.database).Where("id = ?", id).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get replication: %w", err)
+	}
+	volumedb, err := gorm.G[*database.Volume](r.database).Where("id = ?", repldb.VolumeID).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volume: %w", err)
+	}
+
+	status := repldb.Status.Data()
+	status.LastAttemptTime = time.Now().UTC()
+
+	// The sync is cancelled by Hold, including when it got the lock ahead of
+	// a pending hold, in which case its outcome is still recorded.
+	syncCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	r.cancelMu.Lock()
+	if r.holds > 0 {
+		cancel()
+	}
+	r.cancel = cancel
+	r.cancelMu.Unlock()
+	defer func() {
+		r.cancelMu.Lock()
+		r.cancel = nil
+		r.cancelMu.Unlock()
+	}()
+
+	result, syncErr := r.replicate(syncCtx, repldb, volumedb, status.LastSnapshot)
+	if syncErr != nil {
+		status.LastError = syncErr.Error()
+	} else {
+		status.LastSyncTime = time.Now().UTC()
+		status.LastBytesTransferred = result.bytes
+		status.TotalBytesTransferred += result.bytes
+		status.LastError = ""
+		status.LastSnapshot = result.snapshot
+		status.LastSnapshotTime = result.snapshotTime
+	}
+	repldb.Status = datatypes.NewJSONType(status)
+
+	_, err = gorm.G[*database.Replication](r.database).
+		Where("id = ?", repldb.ID).
+		Select("status").
+		Updates(ctx, repldb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update replication status: %w", err)
+	}
+
+	return repldb, syncErr
+}
+
+// Hold stops replications from syncing until the returned release function is
+// called. A sync in flight is cancelled rather than waited on, as it may be
+// stuck on a host that has become unreachable.
+func (r *Replicator) Hold() (release func()) {
+	r.cancelMu.Lock()
+	r.holds++
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.cancelMu.Unlock()
+
+	r.mu.Lock()
+	return func() {
+		r.cancelMu.Lock()
+		r.holds--
+		r.cancelMu.Unlock()
+		r.mu.Unlock()
+	}
+}
+
+// Remove deletes the replication along with the bookmark it left on the
+// volume. The replicated dataset on the target host is kept.
+func (r *Replicator) Remove(ctx context.Context, repldb *database.Replication) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	volumedb, err := gorm.G[*database.Volume](r.database).Where("id = ?", repldb.VolumeID).First(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to get volume: %w", err)
+	}
+
+	return r.database.Transaction(func(tx *gorm.DB) error {
+		_, err := gorm.G[*database.Replication](tx).Where("id = ?", repldb.ID).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		lastSnapshot := repldb.Status.Data().LastSnapshot
+		if volumedb == nil || volumedb.ServerHost == "" || lastSnapshot == "" {
+			return nil
+		}
+		executor, err := r.getExecutorForHost(ctx, volumedb.ServerHost)
+		if err != nil {
+			return err
+		}
+		err = zfs.With(executor).DestroyBookmark(ctx, zfs.DestroyBookmarkArguments{
+			Name: database.BuildBookmarkDatasetID(volumedb.DatasetID, lastSnapshot),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to destroy zfs bookmark: %w", err)
+		}
+		return nil
+	})
+}
+
+type replicateResult struct {
+	snapshot     string
+	snapshotTime time.Time
+	bytes        int64
+}
+
+func (r *Replicator) replicate(ctx context.Context, repldb *database.Replication, volumedb *database.Volume, prevSnapshot string) (replicateResult, error) {
+	if err := requireServerHost(volumedb); err != nil {
+		return replicateResult{}, err
+	}
+	if volumedb.ServerHost == repldb.TargetHost && volumedb.DatasetID == repldb.TargetDatasetID {
+		return replicateResult{}, errors.New("volume cannot be replicated onto itself")
+	}
+
+	sourceExecutor, err := r.getExecutorForHost(ctx, volumedb.ServerHost)
+	if err != nil {
+		return replicateResult{}, err
+	}
+	targetExecutor, err := r.getExecutorForHost(ctx, repldb.TargetHost)
+	if err != nil {
+		return replicateResult{}, err
+	}
+	source := zfs.With(sourceExecutor)
+	target := zfs.With(targetExecutor)
+
+	name := database.BuildReplicationSnapshotName(repldb.ID, strings.ToLower(ulid.Make().String()))
+	snapshot := database.BuildSnapshotDatasetID(volumedb.DatasetID, name)
+	bookmark := database.BuildBookmarkDatasetID(volumedb.DatasetID, name)
+	snapshotTime := time.Now().UTC()
+
+	err = source.CreateSnapshot(ctx, zfs.CreateSnapshotArguments{
+		Name: snapshot,
+	})
+	if err != nil {
+		return replicateResult{}, fmt.Errorf("failed to create zfs snapshot: %w", err)
+	}
+	// The snapshot is only needed for the send as the bookmark takes its place
+	// as the base of the next incremental.
+	defer func() {
+		err := source.DestroySnapshot(ctx, zfs.DestroySnapshotArguments{
+			Name: snapshot,
+		})
+		if err != nil {
+			slogctx.Error(ctx, "failed to destroy replication snapshot", slog.String("replicationId", repldb.ID), slogctx.Err(err))
+		}
+	}()
+
+	// We bookmark before sending so that a received snapshot always has a
+	// bookmark to send the next incremental from.
+	err = source.CreateBookmark(ctx, zfs.CreateBookmarkArguments{
+		Snapshot: snapshot,
+		Bookmark: bookmark,
+	})
+	if err != nil {
+		return replicateResult{}, fmt.Errorf("failed to create zfs bookmark: %w", err)
+	}
+
+	// Encrypted volumes are sent raw so that they stay encrypted in transit
+	// and on the other end.
+	sendArgs := zfs.SendArguments{
+		Snapshot: snapshot,
+		Raw:      volumedb.Encrypted,
+	}
+	if prevSnapshot != "" {
+		sendArgs.From = database.BuildBookmarkDatasetID(volumedb.DatasetID, prevSnapshot)
+	}
+	receiveArgs := zfs.ReceiveArguments{
+		Name: repldb.TargetDatasetID,
+		// The target is rolled back to the last received snapshot in case it
+		// has been modified since.
+		Force: prevSnapshot != "",
+	}
+	bytes, err := transfer(ctx, source, sendArgs, target, receiveArgs)
+	if err != nil {
+		if err := source.DestroyBookmark(ctx, zfs.DestroyBookmarkArguments{Name: bookmark}); err != nil {
+			slogctx.Error(ctx, "failed to destroy replication bookmark", slog.String("replicationId", repldb.ID), slogctx.Err(err))
+		}
+		return replicateResult{}, err
+	}
+
+	// With the new snapshot received the previous bookmark and target snapshot
+	// are no longer needed. Failing to clean them up does not fail the sync.
+	if prevSnapshot != "" {
+		err := source.DestroyBookmark(ctx, zfs.DestroyBookmarkArguments{
+			Name: database.BuildBookmarkDatasetID(volumedb.DatasetID, prevSnapshot),
+		})
+		if err != nil {
+			slogctx.Error(ctx, "failed to destroy previous replication bookmark", slog.String("replicationId", repldb.ID), slogctx.Err(err))
+		}
+		err = target.DestroySnapshot(ctx, zfs.DestroySnapshotArguments{
+			Name: database.BuildSnapshotDatasetID(repldb.TargetDatasetID, prevSnapshot),
+		})
+		if err != nil {
+			slogctx.Error(ctx, "failed to destroy previous replicated snapshot", slog.String("replicationId", repldb.ID), slogctx.Err(err))
+		}
+	}
+
+	return replicateResult{
+		snapshot:     name,
+		snapshotTime: snapshotTime,
+		bytes:        bytes,
+	}, nil
+}
+
+// transfer pipes a send stream from the source into a receive on the target
+// and returns the number of bytes transferred.
+func transfer(ctx context.Context, source zfs.ZFS, sendArgs zfs.SendArguments, target zfs.ZFS, receiveArgs zfs.ReceiveArguments) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pr, pw := io.Pipe()
+	counter := &countingWriter{w: pw}
+
+	sendErr := make(chan error, 1)
+	go func() {
+		err := source.Send(ctx, sendArgs, counter)
+		pw.CloseWithError(err)
+		sendErr <- err
+	}()
+
+	receiveErr := target.Receive(ctx, receiveArgs, pr)
+	// We close the reader so that a send is not left blocked on a receive
+	// that has stopped reading.
+	pr.Close()
+	if receiveErr != nil {
+		cancel()
+		<-sendErr
+		return 0, receiveErr
+	}
+	if err := <-sendErr; err != nil {
+		return 0, err
+	}
+
+	return counter.n, nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (r *Replicator) getExecutorForHost(ctx context.Context, hostID string) (libcommand.Executor, error) {
+	if hostID == "" {
+		return nil, fmt.Errorf("host ID is empty")
+	}
+	// We search by name.
+	host, err := gorm.G[*database.Host](r.database).Where("name = ?", hostID).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host %s: %w", hostID, err)
+	}
+	executor, err := r.executorFactory.BuildExecutor(host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build executor for host %s: %w", hostID, err)
+	}
+	return executor, nil
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jovulic/zfsilo/app/internal/command"
+	"github.com/jovulic/zfsilo/app/internal/command/zfs"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	"github.com/jovulic/zfsilo/app/internal/schedule"
+	libcommand "github.com/jovulic/zfsilo/lib/command"
+	ulid "github.com/oklog/ulid/v2"
+	slogctx "github.com/veqryn/slog-context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// snapshotSchedulerInterval is how often the scheduler checks for due
+// snapshot policies. It matches the minute granularity of cron schedules.
+const snapshotSchedulerInterval = time.Minute
+
+// SnapshotScheduler takes and prunes snapshots of volumes according to their
+// attached snapshot policies.
+type SnapshotScheduler struct {
+	database        *gorm.DB
+	executorFactory *command.ExecutorFactory
+}
+
+func NewSnapshotScheduler(
+	database *gorm.DB,
+	executorFactory *command.ExecutorFactory,
+) *SnapshotScheduler {
+	return &SnapshotScheduler{
+		database:        database,
+		executorFactory: executorFactory,
+	}
+}
+
+// Start runs the scheduler in the background until the context is done.
+func (s *SnapshotScheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(snapshotSchedulerInterval)
+		defer ticker.Stop()
+
+		// Each pass runs the policies that were due since the previous pass.
+		// Runs missed while the application was down are not caught up on.
+		last := time.Now().UTC()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				now := time.Now().UTC()
+				if err := s.Run(ctx, last, now); err != nil {
+					slogctx.Error(ctx, "failed to run snapshot policies", slogctx.Err(err))
+				}
+				last = now
+			}
+		}
+	}()
+}
+
+// Run runs every snapshot policy with a scheduled time in (since, until]
+// against its attached volumes. Failures against a volume are recorded as the
+// outcome of its run rather than returned.
+func (s *SnapshotScheduler) Run(ctx context.Context, since time.Time, until time.Time) error {
+	policydbs, err := gorm.G[*database.SnapshotPolicy](s.database).Find(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get snapshot policies: %w", err)
+	}
+
+	for _, policydb := range policydbs {
+		cron, err := schedule.ParseCron(policydb.Schedule)
+		if err != nil {
+			slogctx.Error(ctx, "skipping snapshot policy with invalid schedule", slog.String("snapshotPolicyId", policydb.ID), slogctx.Err(err))
+			continue
+		}
+		next := cron.Next(since)
+		if next.IsZero() || next.After(until) {
+			continue
+		}
+
+		volumedbs, err := gorm.G[*database.Volume](s.database).Where("snapshot_policy = ?", policydb.ID).Find(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get volumes for snapshot policy %s: %w", policydb.ID, err)
+		}
+		for _, volumedb := range volumedbs {
+			rundb := s.runVolume(ctx, policydb, volumedb, until)
+			err := gorm.G[*database.SnapshotPolicyRun](s.database, clause.OnConflict{UpdateAll: true}).Create(ctx, &rundb)
+			if err != nil {
+				return fmt.Errorf("failed to record snapshot policy run for volume %s: %w", volumedb.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *SnapshotScheduler) runVolume(ctx context.Context, policydb *database.SnapshotPolicy, volumedb *database.Volume, now time.Time) *database.SnapshotPolicyRun {
+	rundb := &database.SnapshotPolicyRun{
+		VolumeID:         volumedb.ID,
+		SnapshotPolicyID: policydb.ID,
+		RunTime:          now,
+	}
+
+	snapshotID, err := s.takeSnapshot(ctx, policydb, volumedb)
+	if err != nil {
+		slogctx.Error(ctx, "failed to take policy snapshot", slog.String("snapshotPolicyId", policydb.ID), slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+		rundb.Outcome = database.SnapshotPolicyRunOutcomeFAILED
+		rundb.Message = err.Error()
+		return rundb
+	}
+	rundb.SnapshotID = snapshotID
+
+	prunedCount, err := s.pruneSnapshots(ctx, policydb, volumedb)
+	rundb.PrunedCount = int32(prunedCount)
+	if err != nil {
+		slogctx.Error(ctx, "failed to prune policy snapshots", slog.String("snapshotPolicyId", policydb.ID), slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+		rundb.Outcome = database.SnapshotPolicyRunOutcomeFAILED
+		rundb.Message = err.Error()
+		return rundb
+	}
+
+	rundb.Outcome = database.SnapshotPolicyRunOutcomeSUCCEEDED
+	return rundb
+}
+
+func (s *SnapshotScheduler) takeSnapshot(ctx context.Context, policydb *database.SnapshotPolicy, volumedb *database.Volume) (string, error) {
+	if err := requireServerHost(volumedb); err != nil {
+		return "", err
+	}
+
+	snapshotID := fmt.Sprintf("snp_%s", strings.ToLower(ulid.Make().String()))
+	snapshotdb := &database.Snapshot{
+		ID:             snapshotID,
+		Name:           fmt.Sprintf("snapshots/%s", snapshotID),
+		VolumeID:       volumedb.ID,
+		DatasetID:      database.BuildSnapshotDatasetID(volumedb.DatasetID, snapshotID),
+		CapacityBytes:  volumedb.CapacityBytes,
+		ServerHost:     volumedb.ServerHost,
+		SnapshotPolicy: policydb.ID,
+	}
+
+	err := s.database.Transaction(func(tx *gorm.DB) error {
+		err := gorm.G[*database.Snapshot](tx).Create(ctx, &snapshotdb)
+		if err != nil {
+			return err
+		}
+
+		executor, err := s.getExecutorForHost(ctx, snapshotdb.ServerHost)
+		if err != nil {
+			return err
+		}
+		err = zfs.With(executor).CreateSnapshot(ctx, zfs.CreateSnapshotArguments{
+			Name: snapshotdb.DatasetID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create zfs snapshot: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return snapshotID, nil
+}
+
+// pruneSnapshots destroys the snapshots taken by the policy that fall outside
+// its retention rules. Snapshots that volumes have been cloned from are kept.
+func (s *SnapshotScheduler) pruneSnapshots(ctx context.Context, policydb *database.SnapshotPolicy, volumedb *database.Volume) (int, error) {
+	retention := schedule.Retention{
+		KeepLast:   int(policydb.KeepLast),
+		KeepDaily:  int(policydb.KeepDaily),
+		KeepWeekly: int(policydb.KeepWeekly),
+	}
+	if retention.IsZero() {
+		return 0, nil
+	}
+
+	snapshotdbs, err := gorm.G[*database.Snapshot](s.database).
+		Where("volume_id = ? AND snapshot_policy = ?", volumedb.ID, policydb.ID).
+		Find(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get policy snapshots: %w", err)
+	}
+
+	times := make([]time.Time, len(snapshotdbs))
+	for i, snapshotdb := range snapshotdbs {
+		times[i] = snapshotdb.CreateTime
+	}
+
+	var pruned int
+	for _, i := range retention.Prune(times) {
+		snapshotdb := snapshotdbs[i]
+
+		count, err := gorm.G[*database.Volume](s.database).Where("source_snapshot = ?", snapshotdb.ID).Count(ctx, "id")
+		if err != nil {
+			return pruned, fmt.Errorf("failed to check volume references: %w", err)
+		}
+		if count > 0 {
+			continue
+		}
+
+		err = s.database.Transaction(func(tx *gorm.DB) error {
+			executor, err := s.getExecutorForHost(ctx, snapshotdb.ServerHost)
+			if err != nil {
+				return err
+			}
+			err = zfs.With(executor).DestroySnapshot(ctx, zfs.DestroySnapshotArguments{
+				Name: snapshotdb.DatasetID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to destroy zfs snapshot: %w", err)
+			}
+
+			_, err = gorm.G[*database.Snapshot](tx).Where("id = ?", snapshotdb.ID).Delete(ctx)
+			if err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return pruned, fmt.Errorf("failed to prune snapshot %s: %w", snapshotdb.ID, err)
+		}
+		pruned++
+	}
+
+	return pruned, nil
+}
+
+func (s *SnapshotScheduler) getExecutorForHost(ctx context.Context, hostID string) (libcommand.Executor, error) {
+	if hostID == "" {
+		return nil, fmt.Errorf("host ID is empty")
+	}
+	// We search by name.
+	host, err := gorm.G[*database.Host](s.database).Where("name = ?", hostID).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host %s: %w", hostID, err)
+	}
+	executor, err := s.executorFactory.BuildExecutor(host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build executor for host %s: %w", hostID, err)
+	}
+	return executor, nil
+}
@@ -5,13 +5,16 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"connectrpc.com/connect"
 	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
 	"github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1/zfsilov1connect"
 	"github.com/jovulic/zfsilo/app/internal/command"
 	"github.com/jovulic/zfsilo/app/internal/command/zfs"
+	"github.com/jovulic/zfsilo/app/internal/command/zpool"
 	"github.com/jovulic/zfsilo/app/internal/database"
+	libcommand "github.com/jovulic/zfsilo/lib/command"
 	"gorm.io/gorm"
 )
 
@@ -38,29 +41,81 @@ func (s *Service) GetCapacity(ctx context.Context, req *connect.Request[zfsilov1
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to find server hosts: %w", err))
 	}
 
-	var totalAvail int64
+	var totalAvail, maxAvail int64
 
 	for _, host := range hosts {
+		parentDatasetID := req.Msg.ParentDatasetId
+		if parentDatasetID != "" && !host.AllowsDataset(parentDatasetID) {
+			continue
+		}
+
 		executor, err := s.executorFactory.BuildExecutor(host)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to build executor for host %s: %w", host.ID, err))
 		}
 
-		availString, err := zfs.With(executor).GetProperty(ctx, zfs.GetPropertyArguments{
-			Name:        "tank",
-			PropertyKey: "avail",
-		})
-		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get dataset available capacity for host %s: %w", host.ID, err))
+		datasets := []string{parentDatasetID}
+		if parentDatasetID == "" {
+			datasets, err = getHostDatasets(ctx, executor, host)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get datasets for host %s: %w", host.ID, err))
+			}
 		}
 
-		avail, err := strconv.ParseInt(availString, 10, 64)
-		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse dataset available capacity for host %s: %w", host.ID, err))
+		// The datasets of a pool share its free space, so each pool is only
+		// counted once with its most available dataset.
+		poolAvails := make(map[string]int64)
+		for _, dataset := range datasets {
+			availString, err := zfs.With(executor).GetProperty(ctx, zfs.GetPropertyArguments{
+				Name:        dataset,
+				PropertyKey: "avail",
+			})
+			if err != nil {
+				// Volumes cannot be created under a dataset the host does
+				// not have.
+				if strings.Contains(err.Error(), "dataset does not exist") {
+					continue
+				}
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get dataset available capacity for host %s: %w", host.ID, err))
+			}
+
+			avail, err := strconv.ParseInt(availString, 10, 64)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse dataset available capacity for host %s: %w", host.ID, err))
+			}
+
+			pool, _, _ := strings.Cut(dataset, "/")
+			poolAvails[pool] = max(poolAvails[pool], avail)
+		}
+
+		for _, avail := range poolAvails {
+			totalAvail += avail
+			maxAvail = max(maxAvail, avail)
 		}
+	}
+
+	return connect.NewResponse(&zfsilov1.GetCapacityResponse{
+		AvailableCapacityBytes: totalAvail,
+		MaximumVolumeSizeBytes: maxAvail,
+	}), nil
+}
 
-		totalAvail += avail
+// getHostDatasets returns the pools or parent datasets volumes may be created
+// under on the server host. These are the datasets the host declares, or every
+// pool of the host when it declares none.
+func getHostDatasets(ctx context.Context, executor libcommand.Executor, host *database.Host) ([]string, error) {
+	role := host.Role.Data()
+	if role.Server != nil && len(role.Server.Datasets) > 0 {
+		return role.Server.Datasets, nil
 	}
 
-	return connect.NewResponse(&zfsilov1.GetCapacityResponse{AvailableCapacityBytes: totalAvail}), nil
+	pools, err := zpool.With(executor).List(ctx, zpool.ListArguments{})
+	if err != nil {
+		return nil, err
+	}
+	datasets := make([]string, 0, len(pools))
+	for _, pool := range pools {
+		datasets = append(datasets, pool.Name)
+	}
+	return datasets, nil
 }
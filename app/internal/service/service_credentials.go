@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/command/iscsi"
+	"github.com/jovulic/zfsilo/app/internal/command/nvmeof"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	libcommand "github.com/jovulic/zfsilo/lib/command"
+	slogctx "github.com/veqryn/slog-context"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// RotateVolumeCredentials replaces the CHAP credentials of a volume published
+// over iSCSI or NVMe-oF with newly generated ones. The ACLs of the target and
+// the node records of the connected client hosts are updated in place, so
+// sessions that are logged in carry on and log in with the new credentials
+// when they are reestablished. Over NVMe-oF, the keys of the host NQNs the
+// clients connect to the volume with are replaced, and connected controllers
+// reauthenticate with the new keys.
+func (s *VolumeService) RotateVolumeCredentials(ctx context.Context, req *connect.Request[zfsilov1.RotateVolumeCredentialsRequest]) (*connect.Response[zfsilov1.RotateVolumeCredentialsResponse], error) {
+	volumedb, err := gorm.G[*database.Volume](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("volume does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
+	}
+
+	if volumedb.ServerHost == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("volume is not published"))
+	}
+
+	previousTransport := volumedb.Transport.Data()
+	transport := previousTransport
+	switch {
+	case transport.Type == database.VolumeTransportTypeISCSI && transport.ISCSI != nil:
+		next := *transport.ISCSI
+		transport.ISCSI = &next
+	case transport.Type == database.VolumeTransportTypeNVMEOF_TCP && transport.NVMEOF != nil:
+		next := *transport.NVMEOF
+		transport.NVMEOF = &next
+	default:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("volume is not published over iSCSI or NVMe-oF"))
+	}
+	if err := generateCredentials(&transport); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	volumedb.Transport = datatypes.NewJSONType(transport)
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		_, err := gorm.G[*database.Volume](tx).
+			Where("id = ?", volumedb.ID).
+			Select("transport").
+			Updates(ctx, volumedb)
+		if err != nil {
+			return fmt.Errorf("failed to update volume in database: %w", err)
+		}
+
+		serverExecutor, _, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
+		if err != nil {
+			return err
+		}
+		clients, err := s.getAttachedClients(ctx, volumedb)
+		if err != nil {
+			return err
+		}
+
+		// A client connected with the NQN of its host, before each volume had
+		// an NQN of its own, shares its keys in nvmet with the other volumes
+		// of the server host, so the volume cannot be given keys of its own.
+		if transport.Type == database.VolumeTransportTypeNVMEOF_TCP {
+			for _, client := range clients {
+				if isHostNQN(client.attachment.Initiator, client.host) {
+					return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("client host %s connects with the NQN of the host, disconnect and connect it again to rotate the credentials", client.host.Name))
+				}
+			}
+		}
+
+		// The credentials are only committed once every client has them. We
+		// undo the clients that were updated otherwise, as a client left with
+		// credentials that do not match its ACL could not log in again.
+		var updatedClients []attachedClient
+		for _, client := range clients {
+			err := updateClientCredentials(ctx, serverExecutor, client, transport)
+			if err != nil {
+				for _, updated := range append(updatedClients, client) {
+					if err := updateClientCredentials(ctx, serverExecutor, updated, previousTransport); err != nil {
+						slogctx.Error(ctx, "failed to restore client credentials", slog.String("volumeId", volumedb.ID), slog.String("clientHost", updated.host.Name), slogctx.Err(err))
+					}
+				}
+				return err
+			}
+			updatedClients = append(updatedClients, client)
+		}
+		return nil
+	})
+	if err != nil {
+		if code := connect.CodeOf(err); code != connect.CodeUnknown {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to rotate volume credentials: %w", err))
+	}
+
+	volumeapi, err := s.converter.FromDBToAPI(volumedb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
+	}
+	return connect.NewResponse(&zfsilov1.RotateVolumeCredentialsResponse{Volume: volumeapi}), nil
+}
+
+// updateClientCredentials sets the credentials described by the transport on
+// the authorization of the attached client on the server, and on the
+// connection of the client to the target.
+func updateClientCredentials(
+	ctx context.Context,
+	serverExecutor libcommand.Executor,
+	client attachedClient,
+	transport database.VolumeTransport,
+) error {
+	initiatorPassword := getInitiatorPassword(transport, client.host)
+	switch transport.Type {
+	case database.VolumeTransportTypeISCSI:
+		t := transport.ISCSI
+		err := iscsi.With(serverExecutor).UpdateAuthorization(ctx, iscsi.UpdateAuthorizationArguments{
+			TargetIQN:         iscsi.IQN(t.TargetIQN),
+			InitiatorIQN:      iscsi.IQN(client.attachment.Initiator),
+			InitiatorPassword: initiatorPassword,
+			TargetPassword:    t.TargetPassword,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update client authorization: %w", err)
+		}
+
+		err = iscsi.With(client.executor).UpdateTargetCredentials(ctx, iscsi.UpdateTargetCredentialsArguments{
+			TargetIQN:         iscsi.IQN(t.TargetIQN),
+			TargetPassword:    t.TargetPassword,
+			InitiatorIQN:      iscsi.IQN(client.attachment.Initiator),
+			InitiatorPassword: initiatorPassword,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update client credentials: %w", err)
+		}
+	case database.VolumeTransportTypeNVMEOF_TCP:
+		// Authorizing again replaces the keys of the host NQN on the server.
+		t := transport.NVMEOF
+		err := nvmeof.With(serverExecutor).Authorize(ctx, nvmeof.AuthorizeArguments{
+			TargetNQN:         nvmeof.NQN(t.TargetNQN),
+			InitiatorNQN:      nvmeof.NQN(client.attachment.Initiator),
+			InitiatorPassword: initiatorPassword,
+			TargetPassword:    t.TargetPassword,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update client authorization: %w", err)
+		}
+
+		err = nvmeof.With(client.executor).UpdateTargetCredentials(ctx, nvmeof.UpdateTargetCredentialsArguments{
+			TargetNQN:         nvmeof.NQN(t.TargetNQN),
+			InitiatorPassword: initiatorPassword,
+			TargetPassword:    t.TargetPassword,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update client credentials: %w", err)
+		}
+	case database.VolumeTransportTypeNFS:
+		// NFS clients are authorized by their address rather than a secret.
+	case database.VolumeTransportTypeUNSPECIFIED:
+		fallthrough
+	default:
+		return fmt.Errorf("no transport specified on volume")
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/command/zfs"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	libcommand "github.com/jovulic/zfsilo/lib/command"
+	"gorm.io/gorm"
+)
+
+// loadZFSKey loads the key of an encrypted volume for the dataset so that it
+// can be accessed, as keys are not loaded when a server host boots. The key is
+// loaded on the encryption root of the dataset, which for a clone is the
+// dataset of its source.
+func loadZFSKey(ctx context.Context, executor libcommand.Executor, volumedb *database.Volume, datasetID string) error {
+	if !volumedb.Encrypted {
+		return nil
+	}
+	key, err := volumedb.RawEncryptionKey()
+	if err != nil {
+		return err
+	}
+
+	root, err := zfs.With(executor).GetProperty(ctx, zfs.GetPropertyArguments{
+		Name:        datasetID,
+		PropertyKey: "encryptionroot",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get zfs encryption root: %w", err)
+	}
+	err = zfs.With(executor).LoadKey(ctx, zfs.LoadKeyArguments{
+		Name: root,
+		Key:  key,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load zfs key: %w", err)
+	}
+	return nil
+}
+
+// RotateVolumeKey replaces the key of an encrypted volume with a newly
+// generated one. Only the key wrapping the data key of the ZFS volume is
+// changed, so the data is not re-encrypted.
+func (s *VolumeService) RotateVolumeKey(ctx context.Context, req *connect.Request[zfsilov1.RotateVolumeKeyRequest]) (*connect.Response[zfsilov1.RotateVolumeKeyResponse], error) {
+	volumedb, err := gorm.G[*database.Volume](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("volume does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
+	}
+
+	if !volumedb.Encrypted {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("volume is not encrypted"))
+	}
+	// The key of the ZFS volume is changed along with the key of the volume,
+	// which would otherwise no longer load it.
+	if err := requireServerHost(volumedb); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	// Clones share the key of the volume they were cloned from, so neither
+	// side can change it on its own.
+	if volumedb.SourceSnapshotID() != "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("volume shares the key of its source volume"))
+	}
+	count, err := gorm.G[*database.Volume](s.database).
+		Where("source_volume = ? OR source_snapshot IN (?)", volumedb.ID, s.database.Model(&database.Snapshot{}).Select("id").Where("volume_id = ?", volumedb.ID)).
+		Count(ctx, "id")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check clone references: %w", err))
+	}
+	if count > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume shares its key with %d clones", count))
+	}
+
+	// The current key has to be loaded to change it, so we hold on to the
+	// volume as it was before the rotation.
+	previousdb := *volumedb
+	volumedb.EncryptionKey, err = database.GenerateEncryptionKey()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		_, err := gorm.G[*database.Volume](tx).
+			Where("id = ?", volumedb.ID).
+			Select("encryption_key").
+			Updates(ctx, volumedb)
+		if err != nil {
+			return fmt.Errorf("failed to update volume in database: %w", err)
+		}
+
+		executor, _, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
+		if err != nil {
+			return err
+		}
+		if err := loadZFSKey(ctx, executor, &previousdb, previousdb.DatasetID); err != nil {
+			return err
+		}
+
+		key, err := volumedb.RawEncryptionKey()
+		if err != nil {
+			return err
+		}
+		err = zfs.With(executor).ChangeKey(ctx, zfs.ChangeKeyArguments{
+			Name: volumedb.DatasetID,
+			Key:  key,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to change zfs key: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if code := connect.CodeOf(err); code != connect.CodeUnknown {
+			return nil, err
+		}
+		if strings.Contains(err.Error(), "dataset does not exist") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume dataset does not exist: %w", err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to rotate volume key: %w", err))
+	}
+
+	volumeapi, err := s.converter.FromDBToAPI(volumedb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
+	}
+	return connect.NewResponse(&zfsilov1.RotateVolumeKeyResponse{Volume: volumeapi}), nil
+}
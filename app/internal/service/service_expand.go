@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/command/fs"
+	"github.com/jovulic/zfsilo/app/internal/command/iscsi"
+	"github.com/jovulic/zfsilo/app/internal/command/nvmeof"
+	"github.com/jovulic/zfsilo/app/internal/command/zfs"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	"gorm.io/gorm"
+)
+
+// ExpandVolume grows a volume to a new capacity. The size of the ZFS volume is
+// set on the server host and, when the volume is connected, the target is
+// rescanned on the client host, which waits for the device to report the new
+// size before growing the filesystem on it.
+func (s *VolumeService) ExpandVolume(ctx context.Context, req *connect.Request[zfsilov1.ExpandVolumeRequest]) (*connect.Response[zfsilov1.ExpandVolumeResponse], error) {
+	volumedb, err := gorm.G[*database.Volume](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("volume does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
+	}
+
+	if req.Msg.CapacityBytes < volumedb.CapacityBytes {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("volume cannot be shrunk from %d bytes", volumedb.CapacityBytes))
+	}
+
+	if req.Msg.CapacityBytes > volumedb.CapacityBytes {
+		volumedb.CapacityBytes = req.Msg.CapacityBytes
+		err = s.database.Transaction(func(tx *gorm.DB) error {
+			_, err := gorm.G[*database.Volume](tx).
+				Where("id = ?", volumedb.ID).
+				Select("capacity_bytes").
+				Updates(ctx, volumedb)
+			if err != nil {
+				return fmt.Errorf("failed to update volume in database: %w", err)
+			}
+
+			// The ZFS volume of an unpublished volume is grown when the
+			// volume is published again.
+			if err := requireServerHost(volumedb); err != nil {
+				return nil
+			}
+			executor, _, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
+			if err != nil {
+				return err
+			}
+			err = zfs.With(executor).SetProperty(ctx, zfs.SetPropertyArguments{
+				Name:          volumedb.DatasetID,
+				PropertyKey:   volumedb.SizeProperty(),
+				PropertyValue: fmt.Sprintf("%d", volumedb.CapacityBytes),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update volume size on producer: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			if code := connect.CodeOf(err); code != connect.CodeUnknown {
+				return nil, err
+			}
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to expand volume: %w", err))
+		}
+	}
+
+	sizeBytes, err := s.expandClientVolume(ctx, volumedb)
+	if err != nil {
+		if code := connect.CodeOf(err); code != connect.CodeUnknown {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	volumeapi, err := s.converter.FromDBToAPI(volumedb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
+	}
+	return connect.NewResponse(&zfsilov1.ExpandVolumeResponse{
+		Volume:    volumeapi,
+		SizeBytes: sizeBytes,
+	}), nil
+}
+
+// expandClientVolume grows the device of a connected volume on each of its
+// client hosts to the capacity of the volume, and the filesystem on it when
+// the volume is staged there. It returns the size of the device, or the
+// capacity of the volume when there is no device to grow.
+func (s *VolumeService) expandClientVolume(ctx context.Context, volumedb *database.Volume) (int64, error) {
+	if !volumedb.IsConnected() || len(volumedb.Attachments) == 0 || volumedb.Mode == database.VolumeModeDATASET {
+		return volumedb.CapacityBytes, nil
+	}
+
+	_, publishHost, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get publish host: %w", err)
+	}
+
+	transport := volumedb.Transport.Data()
+
+	targetID, err := getTargetID(publishHost, transport, volumedb.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get target ID: %w", err)
+	}
+
+	targetAddress, _ := getServerConnection(publishHost)
+
+	var sizeBytes int64
+	for i := range volumedb.Attachments {
+		attachment := &volumedb.Attachments[i]
+		sizeBytes, err = s.expandAttachment(ctx, volumedb, attachment, transport, targetAddress, targetID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to expand volume on host %s: %w", attachment.ClientHost, err)
+		}
+	}
+	return sizeBytes, nil
+}
+
+// expandAttachment grows the device of the volume on the client host of the
+// attachment, and the filesystem on it when the attachment staged it.
+func (s *VolumeService) expandAttachment(
+	ctx context.Context,
+	volumedb *database.Volume,
+	attachment *database.VolumeAttachment,
+	transport database.VolumeTransport,
+	targetAddress string,
+	targetID string,
+) (int64, error) {
+	consumeExecutor, _, err := s.getExecutorForHost(ctx, attachment.ClientHost)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get consumer executor: %w", err)
+	}
+
+	switch transport.Type {
+	case database.VolumeTransportTypeISCSI:
+		args := iscsi.RescanTargetArguments{
+			TargetIQN: iscsi.IQN(targetID),
+		}
+		if transport.ISCSI != nil && len(transport.ISCSI.Portals()) > 1 {
+			args.MultipathDevice = database.BuildDevicePathISCSIMultipathClient(volumedb.ID)
+		}
+		err = iscsi.With(consumeExecutor).RescanTarget(ctx, args)
+	case database.VolumeTransportTypeNVMEOF_TCP:
+		err = nvmeof.With(consumeExecutor).RescanTarget(ctx, nvmeof.RescanTargetArguments{
+			TargetNQN: nvmeof.NQN(targetID),
+		})
+	case database.VolumeTransportTypeUNSPECIFIED:
+		fallthrough
+	default:
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no transport specified on volume"))
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to perform rescan on consumer: %w", err)
+	}
+
+	devicePattern, err := volumedb.DevicePathClient(targetAddress, targetID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get device path: %w", err)
+	}
+	devicePath, err := fs.With(consumeExecutor).ResolveDevice(ctx, devicePattern)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve device path %s: %w", devicePattern, err)
+	}
+
+	// The rescan returns before the kernel has picked up the new size, and
+	// growing the filesystem before then leaves it at the old size.
+	sizeBytes, err := fs.With(consumeExecutor).WaitForDeviceSize(ctx, fs.WaitForDeviceSizeArguments{
+		Device:  devicePath,
+		Size:    volumedb.CapacityBytes,
+		Timeout: 30 * time.Second,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to wait for device to grow: %w", err)
+	}
+
+	// The filesystem is grown while it is mounted at the staging path, which
+	// is when it is in use and the only time xfs can be grown. A filesystem
+	// mounted read-only cannot be grown, and is left as is for another
+	// attachment to grow.
+	if volumedb.Mode == database.VolumeModeFILESYSTEM && attachment.IsStaged() && !attachment.ReadOnly {
+		fsType, err := fs.With(consumeExecutor).GetFSType(ctx, devicePath)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get filesystem type: %w", err)
+		}
+		err = fs.With(consumeExecutor).Resize(ctx, fs.ResizeArguments{
+			Device:    devicePath,
+			Type:      fsType,
+			MountPath: attachment.StagingPath,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to perform resize on consumer: %w", err)
+		}
+	}
+
+	return sizeBytes, nil
+}
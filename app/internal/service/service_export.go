@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/command/zfs"
+	converteriface "github.com/jovulic/zfsilo/app/internal/converter/iface"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	slogctx "github.com/veqryn/slog-context"
+	"gorm.io/gorm"
+)
+
+func exportToAPI(entry exportManifestEntry) (*zfsilov1.VolumeExport, error) {
+	createTime, err := converteriface.ConvertTimeToTimestamp(entry.CreateTime)
+	if err != nil {
+		return nil, err
+	}
+	return &zfsilov1.VolumeExport{
+		Name:       entry.Name,
+		Base:       entry.Base,
+		ObjectKey:  entry.ObjectKey,
+		Bytes:      entry.Bytes,
+		CreateTime: createTime,
+	}, nil
+}
+
+func exportsToAPI(entries []exportManifestEntry) ([]*zfsilov1.VolumeExport, error) {
+	exportapis := make([]*zfsilov1.VolumeExport, 0, len(entries))
+	for _, entry := range entries {
+		exportapi, err := exportToAPI(entry)
+		if err != nil {
+			return nil, err
+		}
+		exportapis = append(exportapis, exportapi)
+	}
+	return exportapis, nil
+}
+
+// ExportVolume uploads the volume to the object store as a ZFS send stream,
+// either in full or incrementally from its previous export.
+func (s *VolumeService) ExportVolume(ctx context.Context, req *connect.Request[zfsilov1.ExportVolumeRequest]) (*connect.Response[zfsilov1.ExportVolumeResponse], error) {
+	volumedb, err := gorm.G[*database.Volume](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("volume does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
+	}
+
+	entry, err := s.exporter.Export(ctx, volumedb, req.Msg.Incremental)
+	if err != nil {
+		switch {
+		case errors.Is(err, errObjectStoreNotConfigured), errors.Is(err, errVolumeNotPublished):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, errNoExports):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("volume has no export to be incremental from"))
+		case strings.Contains(err.Error(), "dataset is busy"):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset is busy: %w", err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to export volume: %w", err))
+	}
+
+	exportapi, err := exportToAPI(entry)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map export: %w", err))
+	}
+	return connect.NewResponse(&zfsilov1.ExportVolumeResponse{Export: exportapi}), nil
+}
+
+// ImportVolume restores an export from the object store as the ZFS volume of a
+// volume that has not been published yet. The full export it descends from
+// and every incremental up to it are received in order. The volume can then be
+// published on the server host it was imported on.
+func (s *VolumeService) ImportVolume(ctx context.Context, req *connect.Request[zfsilov1.ImportVolumeRequest]) (*connect.Response[zfsilov1.ImportVolumeResponse], error) {
+	volumedb, err := gorm.G[*database.Volume](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("volume does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
+	}
+
+	// The import receives the ZFS volume onto the server host it is given, so
+	// the volume must not be on a server host already.
+	switch {
+	case volumedb.ServerHost != "":
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("volume already has a server host"))
+	case volumedb.SourceSnapshotID() != "":
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is a clone"))
+	}
+
+	hostdb, err := gorm.G[*database.Host](s.database).Where("name = ?", req.Msg.ServerHost).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("server host does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get server host: %w", err))
+	}
+	if hostdb.Role.Data().Type != database.HostRoleTypeServer {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume can only be imported on a server host"))
+	}
+	if !hostdb.AllowsDataset(volumedb.DatasetID) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("server host does not allow dataset %s", volumedb.DatasetID))
+	}
+
+	sourceVolumeID := req.Msg.SourceVolumeId
+	if sourceVolumeID == "" {
+		sourceVolumeID = volumedb.ID
+	}
+	entries, bytesTransferred, err := s.exporter.Import(ctx, volumedb, hostdb.Name, sourceVolumeID, req.Msg.Export)
+	if err != nil {
+		switch {
+		case errors.Is(err, errObjectStoreNotConfigured), errors.Is(err, errExportIncompatible):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, errNoExports), errors.Is(err, errExportNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to import volume: %w", err))
+	}
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		volumedb.ServerHost = hostdb.Name
+		_, err := gorm.G[*database.Volume](tx).
+			Where("id = ?", volumedb.ID).
+			Select("server_host").
+			Updates(ctx, volumedb)
+		if err != nil {
+			return fmt.Errorf("failed to update volume in database: %w", err)
+		}
+
+		// The received volume carries the size and properties of the exported
+		// volume, which we bring in line with the volume.
+		executor, _, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
+		if err != nil {
+			return err
+		}
+		err = zfs.With(executor).SetProperty(ctx, zfs.SetPropertyArguments{
+			Name:          volumedb.DatasetID,
+			PropertyKey:   volumedb.SizeProperty(),
+			PropertyValue: fmt.Sprintf("%d", volumedb.CapacityBytes),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update volume size: %w", err)
+		}
+		return setZFSVolumeOptions(ctx, executor, volumedb)
+	})
+	if err != nil {
+		volumedb.ServerHost = ""
+		executor, _, execErr := s.getExecutorForHost(ctx, hostdb.Name)
+		if execErr == nil {
+			execErr = zfs.With(executor).DestroyVolume(ctx, zfs.DestroyVolumeArguments{
+				Name:      volumedb.DatasetID,
+				Recursive: true,
+			})
+		}
+		if execErr != nil {
+			slogctx.Error(ctx, "failed to destroy imported zfs volume", slog.String("volumeId", volumedb.ID), slogctx.Err(execErr))
+		}
+		if code := connect.CodeOf(err); code != connect.CodeUnknown {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to import volume: %w", err))
+	}
+
+	volumeapi, err := s.converter.FromDBToAPI(volumedb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
+	}
+	exportapis, err := exportsToAPI(entries)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map exports: %w", err))
+	}
+	return connect.NewResponse(&zfsilov1.ImportVolumeResponse{
+		Volume:           volumeapi,
+		Exports:          exportapis,
+		BytesTransferred: bytesTransferred,
+	}), nil
+}
+
+// ListVolumeExports lists the exports recorded in the manifest of a volume.
+// The volume need not exist anymore.
+func (s *VolumeService) ListVolumeExports(ctx context.Context, req *connect.Request[zfsilov1.ListVolumeExportsRequest]) (*connect.Response[zfsilov1.ListVolumeExportsResponse], error) {
+	entries, err := s.exporter.List(ctx, req.Msg.VolumeId)
+	if err != nil {
+		if errors.Is(err, errObjectStoreNotConfigured) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list volume exports: %w", err))
+	}
+
+	exportapis, err := exportsToAPI(entries)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map exports: %w", err))
+	}
+	return connect.NewResponse(&zfsilov1.ListVolumeExportsResponse{Exports: exportapis}), nil
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/command/zfs"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	slogctx "github.com/veqryn/slog-context"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// standbyIntervalSeconds is how often the standby copy of a volume is brought
+// up to date.
+const standbyIntervalSeconds = 300
+
+// buildStandbyReplication returns the replication that keeps the standby copy
+// of the volume. The copy uses the same dataset id on the standby host so that
+// it can take over from the volume as is.
+func buildStandbyReplication(volumedb *database.Volume) *database.Replication {
+	id := database.BuildStandbyReplicationID(volumedb.ID)
+	return &database.Replication{
+		ID:              id,
+		Name:            fmt.Sprintf("replications/%s", id),
+		VolumeID:        volumedb.ID,
+		TargetHost:      volumedb.StandbyHost,
+		TargetDatasetID: volumedb.DatasetID,
+		IntervalSeconds: standbyIntervalSeconds,
+		Standby:         true,
+	}
+}
+
+// validateStandbyHost verifies the standby host of the volume can keep a copy
+// of it.
+func (s *VolumeService) validateStandbyHost(ctx context.Context, volumedb *database.Volume) error {
+	hostdb, err := gorm.G[*database.Host](s.database).Where("name = ?", volumedb.StandbyHost).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return connect.NewError(connect.CodeNotFound, errors.New("standby host does not exist"))
+	default:
+		return connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get standby host: %w", err))
+	}
+
+	switch {
+	case hostdb.Role.Data().Type != database.HostRoleTypeServer:
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("standby host must be a server host"))
+	case volumedb.StandbyHost == volumedb.ServerHost:
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("standby host must differ from the server host"))
+	case slices.Contains(volumedb.FencedHosts, volumedb.StandbyHost):
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("standby host still holds a stale copy of the volume"))
+	case !hostdb.AllowsDataset(volumedb.DatasetID):
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("standby host does not allow dataset %s", volumedb.DatasetID))
+	}
+	return nil
+}
+
+// removeStandby stops keeping the standby copy of the volume and destroys it.
+// The copy is only destroyed on a best effort basis as the standby host may no
+// longer be reachable.
+func (s *VolumeService) removeStandby(ctx context.Context, volumeID string) error {
+	repldb, err := gorm.G[*database.Replication](s.database).Where("id = ?", database.BuildStandbyReplicationID(volumeID)).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil
+	default:
+		return fmt.Errorf("failed to get standby replication: %w", err)
+	}
+
+	if err := s.replicator.Remove(ctx, repldb); err != nil {
+		return fmt.Errorf("failed to remove standby replication: %w", err)
+	}
+
+	executor, _, err := s.getExecutorForHost(ctx, repldb.TargetHost)
+	if err == nil {
+		err = zfs.With(executor).DestroyVolume(ctx, zfs.DestroyVolumeArguments{
+			Name:      repldb.TargetDatasetID,
+			Recursive: true,
+		})
+	}
+	if err != nil {
+		slogctx.Error(ctx, "failed to destroy standby copy", slog.String("volumeId", volumeID), slog.String("standbyHost", repldb.TargetHost), slogctx.Err(err))
+	}
+	return nil
+}
+
+// FailoverVolume promotes the standby copy of a volume in place of the volume
+// on its server host, for when the server host has become unavailable.
+//
+// The standby copy is rolled back to the last snapshot it received and
+// published on the standby host under its own target id. Only then is the
+// standby host made the server host of the volume, and the old server host
+// recorded as fenced so that the stale copy it holds is unpublished and set
+// aside once it is reachable again. Anything written to the volume since the
+// last sync of the standby is lost. Snapshots that did not reach the standby
+// host stay on the old server host, where they are kept along with its stale
+// copy of the volume.
+//
+// A connected client is forcibly disconnected from the old target and the
+// volume is synced to connect it to the new one, restaging and remounting the
+// volume as needed.
+func (s *VolumeService) FailoverVolume(ctx context.Context, req *connect.Request[zfsilov1.FailoverVolumeRequest]) (*connect.Response[zfsilov1.FailoverVolumeResponse], error) {
+	volumedb, err := gorm.G[*database.Volume](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("volume does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
+	}
+
+	if volumedb.StandbyHost == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("volume has no standby host"))
+	}
+
+	// We hold off replications so the standby copy does not change under us,
+	// and no longer has anything sent to it once promoted.
+	release := s.replicator.Hold()
+	defer release()
+
+	repldb, err := gorm.G[*database.Replication](s.database).Where("id = ?", database.BuildStandbyReplicationID(volumedb.ID)).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("volume has no standby replication"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get standby replication: %w", err))
+	}
+	lastSnapshot := repldb.Status.Data().LastSnapshot
+	if lastSnapshot == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("standby copy has not been synced yet"))
+	}
+
+	standbyExecutor, standbyHost, err := s.getExecutorForHost(ctx, volumedb.StandbyHost)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	standby := zfs.With(standbyExecutor)
+	standbySnapshot := database.BuildSnapshotDatasetID(volumedb.DatasetID, lastSnapshot)
+
+	// A replication that failed part way may have left the copy ahead of the
+	// last snapshot we know it received.
+	err = standby.RollbackSnapshot(ctx, zfs.RollbackSnapshotArguments{
+		Name:         standbySnapshot,
+		DestroyNewer: true,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "dataset is busy") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset is busy: %w", err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to promote standby copy: %w", err))
+	}
+
+	// The snapshots of the volume that reached the standby host move over to
+	// it along with the volume. The standby is synced from a bookmark without
+	// the snapshots in between, so most snapshots only the old server host
+	// holds.
+	standbySnapshots, err := standby.ListSnapshots(ctx, zfs.ListSnapshotsArguments{
+		Name: volumedb.DatasetID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list snapshots of standby copy: %w", err))
+	}
+
+	previousTransport := volumedb.Transport.Data()
+	transport := previousTransport
+	if volumedb.IsPublished() {
+		transport, err = publishTarget(ctx, standbyExecutor, standbyHost, volumedb, previousTransport)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to publish volume on standby host: %w", err))
+		}
+	}
+
+	previousServerHost := volumedb.ServerHost
+	volumedb.ServerHost = volumedb.StandbyHost
+	volumedb.StandbyHost = ""
+	volumedb.Transport = datatypes.NewJSONType(transport)
+	if previousServerHost != "" && !slices.Contains(volumedb.FencedHosts, previousServerHost) {
+		volumedb.FencedHosts = append(volumedb.FencedHosts, previousServerHost)
+	}
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		_, err := gorm.G[*database.Volume](tx).
+			Where("id = ?", volumedb.ID).
+			Select("server_host", "standby_host", "transport", "fenced_hosts").
+			Updates(ctx, volumedb)
+		if err != nil {
+			return fmt.Errorf("failed to update volume in database: %w", err)
+		}
+		_, err = gorm.G[*database.Replication](tx).Where("id = ?", repldb.ID).Delete(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete standby replication in database: %w", err)
+		}
+
+		snapshotdbs, err := gorm.G[*database.Snapshot](tx).Where("volume_id = ? AND server_host = ?", volumedb.ID, previousServerHost).Find(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get snapshots from database: %w", err)
+		}
+		// The other snapshots are left on the old server host, along with the
+		// groups they belong to and the volumes cloned from them, and are
+		// renamed along with its stale copy when it is fenced.
+		for _, snapshotdb := range snapshotdbs {
+			if !slices.Contains(standbySnapshots, snapshotdb.DatasetID) {
+				continue
+			}
+			_, err = gorm.G[*database.Snapshot](tx).Where("id = ?", snapshotdb.ID).Update(ctx, "server_host", volumedb.ServerHost)
+			if err != nil {
+				return fmt.Errorf("failed to update snapshot in database: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if volumedb.IsPublished() {
+			if err := unpublishTarget(ctx, standbyExecutor, volumedb, transport); err != nil {
+				slogctx.Error(ctx, "failed to unpublish volume from standby host", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+			}
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fail over volume: %w", err))
+	}
+
+	// The volume has failed over at this point, so failing to clean up after
+	// it is not an error.
+	err = standby.DestroySnapshot(ctx, zfs.DestroySnapshotArguments{
+		Name: standbySnapshot,
+	})
+	if err != nil {
+		slogctx.Error(ctx, "failed to destroy standby snapshot", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+	}
+
+	if volumedb.IsConnected() {
+		clients, err := s.getAttachedClients(ctx, volumedb)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("volume failed over but the clients could not be reached: %w", err))
+		}
+
+		// The old target is likely gone, so the mounts and sessions on the
+		// clients are torn down regardless of errors.
+		for _, client := range clients {
+			if err := unmountClient(ctx, client, true); err != nil {
+				slogctx.Error(ctx, "failed to unmount volume from old target", slog.String("volumeId", volumedb.ID), slog.String("clientHost", client.host.Name), slogctx.Err(err))
+			}
+			if err := disconnectClient(ctx, client.executor, previousTransport); err != nil {
+				slogctx.Error(ctx, "failed to disconnect client from old target", slog.String("volumeId", volumedb.ID), slog.String("clientHost", client.host.Name), slogctx.Err(err))
+			}
+		}
+
+		if err := s.syncer.Sync(ctx, volumedb); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("volume failed over but the clients failed to reconnect, sync the volume to retry: %w", err))
+		}
+	}
+
+	volumeapi, err := s.converter.FromDBToAPI(volumedb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
+	}
+	return connect.NewResponse(&zfsilov1.FailoverVolumeResponse{Volume: volumeapi}), nil
+}
@@ -9,8 +9,10 @@ import (
 	"connectrpc.com/connect"
 	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
 	"github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1/zfsilov1connect"
+	"github.com/jovulic/zfsilo/app/internal/command"
 	converteriface "github.com/jovulic/zfsilo/app/internal/converter/iface"
 	"github.com/jovulic/zfsilo/app/internal/database"
+	"github.com/jovulic/zfsilo/app/internal/schedule"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	"gorm.io/gorm"
 )
@@ -98,20 +100,36 @@ func applyHostUpdate(
 	return nil
 }
 
+// validateHost checks the fields of a host that are not covered by the request
+// validation.
+func validateHost(host *database.Host) error {
+	if role := host.Role.Data(); role.Server != nil {
+		for pool, expr := range role.Server.ScrubSchedules {
+			if _, err := schedule.ParseCron(expr); err != nil {
+				return fmt.Errorf("invalid scrub schedule for pool %s: %w", pool, err)
+			}
+		}
+	}
+	return nil
+}
+
 type HostService struct {
 	zfsilov1connect.UnimplementedHostServiceHandler
 
-	database  *gorm.DB
-	converter converteriface.HostConverter
+	database        *gorm.DB
+	converter       converteriface.HostConverter
+	executorFactory *command.ExecutorFactory
 }
 
 func NewHostService(
 	database *gorm.DB,
 	converter converteriface.HostConverter,
+	executorFactory *command.ExecutorFactory,
 ) *HostService {
 	return &HostService{
-		database:  database,
-		converter: converter,
+		database:        database,
+		converter:       converter,
+		executorFactory: executorFactory,
 	}
 }
 
@@ -200,6 +218,10 @@ func (s *HostService) CreateHost(ctx context.Context, req *connect.Request[zfsil
 		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map host: %w", err))
 	}
 
+	if err := validateHost(hostdb); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	err = s.database.Transaction(func(tx *gorm.DB) error {
 		// Create database entry.
 		err := gorm.G[*database.Host](tx).Create(ctx, &hostdb)
@@ -279,7 +301,7 @@ func (s *HostService) DeleteHost(ctx context.Context, req *connect.Request[zfsil
 	}
 
 	// Check if host is referenced by any volumes.
-	count, err := gorm.G[*database.Volume](s.database).Where("server_host = ? OR client_host = ?", hostdb.Name, hostdb.Name).Count(ctx, "id")
+	count, err := gorm.G[*database.Volume](s.database).Where("server_host = ? OR standby_host = ? OR EXISTS (SELECT 1 FROM json_each(attachments) WHERE json_extract(value, '$.clientHost') = ?)", hostdb.Name, hostdb.Name, hostdb.Name).Count(ctx, "id")
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check volume references: %w", err))
 	}
@@ -292,6 +314,23 @@ func (s *HostService) DeleteHost(ctx context.Context, req *connect.Request[zfsil
 		if err != nil {
 			return err
 		}
+
+		// The TLS keys shared with the host are dropped so that they are not
+		// handed to another host that later takes its name.
+		serverdbs, err := gorm.G[*database.Host](tx).Where("json_extract(tls_keys, '$.' || json_quote(?)) IS NOT NULL", hostdb.Name).Find(ctx)
+		if err != nil {
+			return err
+		}
+		for _, serverdb := range serverdbs {
+			serverdb.DeleteTLSKey(hostdb.Name)
+			_, err = gorm.G[*database.Host](tx).
+				Where("id = ?", serverdb.ID).
+				Select("tls_keys").
+				Updates(ctx, serverdb)
+			if err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 	if err != nil {
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	libcommand "github.com/jovulic/zfsilo/lib/command"
+	slogctx "github.com/veqryn/slog-context"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// RotateHostKey replaces the key of a host and updates the volumes the host
+// serves or is connected to whose credentials are derived from it. Volumes
+// published since volumes are given credentials of their own do not use the
+// host key, and are left out of the results. The
+// authorizations on the server hosts and the credentials of the connected
+// client hosts are updated in place, so connected clients stay connected.
+//
+// The key of the host is changed even when some volumes fail to be updated,
+// as the volumes that were updated could not be reverted reliably either. The
+// outcome of each volume is reported instead, and rotating again to the same
+// key updates every volume to it.
+func (s *HostService) RotateHostKey(ctx context.Context, req *connect.Request[zfsilov1.RotateHostKeyRequest]) (*connect.Response[zfsilov1.RotateHostKeyResponse], error) {
+	hostdb, err := gorm.G[*database.Host](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("host does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get host: %w", err))
+	}
+
+	key := req.Msg.Key
+	switch {
+	case hostdb.ByConfig:
+		// The key of a host declared in the configuration is set from the
+		// configuration on startup, so any other key would not last. The key
+		// is changed in the configuration instead, and rotating updates the
+		// volumes of the host to it.
+		if key != "" && key != hostdb.Key {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("host is declared in the configuration, change its key there and rotate without a key"))
+		}
+		key = hostdb.Key
+	case key == "":
+		key, err = database.GenerateCHAPSecret()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	hostdb.Key = key
+
+	_, err = gorm.G[*database.Host](s.database).
+		Where("id = ?", hostdb.ID).
+		Select("key").
+		Updates(ctx, hostdb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update host in database: %w", err))
+	}
+
+	volumedbs, err := gorm.G[*database.Volume](s.database).
+		Where("server_host = ? OR EXISTS (SELECT 1 FROM json_each(attachments) WHERE json_extract(value, '$.clientHost') = ?)", hostdb.Name, hostdb.Name).
+		Order("id").
+		Find(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get volumes of host: %w", err))
+	}
+
+	results := make([]*zfsilov1.RotateHostKeyResponse_VolumeResult, 0, len(volumedbs))
+	for _, volumedb := range volumedbs {
+		if !usesHostKey(volumedb) {
+			continue
+		}
+		result := &zfsilov1.RotateHostKeyResponse_VolumeResult{Volume: volumedb.ID}
+		if err := s.updateVolumeHostKey(ctx, hostdb, volumedb); err != nil {
+			slogctx.Error(ctx, "failed to update volume to host key", slog.String("hostId", hostdb.ID), slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	hostapi, err := s.converter.FromDBToAPI(hostdb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map host: %w", err))
+	}
+	return connect.NewResponse(&zfsilov1.RotateHostKeyResponse{
+		Host:    hostapi,
+		Results: results,
+	}), nil
+}
+
+// usesHostKey reports whether the credentials of a volume are derived from the
+// keys of its hosts. Only published volumes that were not given credentials of
+// their own, as volumes published before per-volume credentials were not, use
+// them.
+func usesHostKey(volumedb *database.Volume) bool {
+	if volumedb.ServerHost == "" {
+		return false
+	}
+	transport := volumedb.Transport.Data()
+	switch transport.Type {
+	case database.VolumeTransportTypeISCSI:
+		return transport.ISCSI != nil && transport.ISCSI.InitiatorPassword == ""
+	case database.VolumeTransportTypeNVMEOF_TCP:
+		return transport.NVMEOF != nil && transport.NVMEOF.InitiatorPassword == ""
+	default:
+		return false
+	}
+}
+
+// updateVolumeHostKey updates the credentials of a volume that uses the key of
+// the host. The host key is the target password of volumes served by the host,
+// and the initiator password of the clients connecting from it.
+func (s *HostService) updateVolumeHostKey(ctx context.Context, hostdb *database.Host, volumedb *database.Volume) error {
+	isServer := volumedb.ServerHost == hostdb.Name
+
+	transport := volumedb.Transport.Data()
+	if isServer {
+		switch transport.Type {
+		case database.VolumeTransportTypeISCSI:
+			next := *transport.ISCSI
+			next.TargetPassword = hostdb.Key
+			transport.ISCSI = &next
+		case database.VolumeTransportTypeNVMEOF_TCP:
+			next := *transport.NVMEOF
+			next.TargetPassword = hostdb.Key
+			transport.NVMEOF = &next
+		}
+	}
+
+	// The volume is updated first, so that the credentials are set from the
+	// new key when its clients are synced or connected again.
+	if isServer {
+		volumedb.Transport = datatypes.NewJSONType(transport)
+		_, err := gorm.G[*database.Volume](s.database).
+			Where("id = ?", volumedb.ID).
+			Select("transport").
+			Updates(ctx, volumedb)
+		if err != nil {
+			return fmt.Errorf("failed to update volume in database: %w", err)
+		}
+	}
+
+	serverExecutor, _, err := s.getExecutor(ctx, hostdb, volumedb.ServerHost)
+	if err != nil {
+		return err
+	}
+
+	// We carry on past the clients that fail, so that as many of them as
+	// possible are left with credentials that match their authorization.
+	var errs []error
+	for i := range volumedb.Attachments {
+		attachment := &volumedb.Attachments[i]
+		if !isServer && attachment.ClientHost != hostdb.Name {
+			continue
+		}
+		executor, clientdb, err := s.getExecutor(ctx, hostdb, attachment.ClientHost)
+		if err == nil {
+			err = updateClientCredentials(ctx, serverExecutor, attachedClient{
+				attachment: attachment,
+				host:       clientdb,
+				executor:   executor,
+			}, transport)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("client host %s: %w", attachment.ClientHost, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// getExecutor returns an executor for the host with the given name. The host
+// whose key is rotated is used as is, so that it carries the new key.
+func (s *HostService) getExecutor(ctx context.Context, hostdb *database.Host, name string) (libcommand.Executor, *database.Host, error) {
+	host := hostdb
+	if name != hostdb.Name {
+		var err error
+		host, err = gorm.G[*database.Host](s.database).Where("name = ?", name).First(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get host %s: %w", name, err)
+		}
+	}
+	executor, err := s.executorFactory.BuildExecutor(host)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build executor for host %s: %w", name, err)
+	}
+	return executor, host, nil
+}
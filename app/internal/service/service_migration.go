@@ -0,0 +1,667 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/command/iscsi"
+	"github.com/jovulic/zfsilo/app/internal/command/literal"
+	"github.com/jovulic/zfsilo/app/internal/command/mount"
+	"github.com/jovulic/zfsilo/app/internal/command/nfs"
+	"github.com/jovulic/zfsilo/app/internal/command/nvmeof"
+	"github.com/jovulic/zfsilo/app/internal/command/zfs"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	libcommand "github.com/jovulic/zfsilo/lib/command"
+	ulid "github.com/oklog/ulid/v2"
+	slogctx "github.com/veqryn/slog-context"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// publishTarget publishes the ZFS volume of the volume on the host and returns
+// the transport with the target details of the host. Initiator details are
+// carried over from the given transport.
+func publishTarget(
+	ctx context.Context,
+	executor libcommand.Executor,
+	host *database.Host,
+	volumedb *database.Volume,
+	transport database.VolumeTransport,
+) (database.VolumeTransport, error) {
+	if err := loadZFSKey(ctx, executor, volumedb, volumedb.DatasetID); err != nil {
+		return transport, err
+	}
+
+	var targetID string
+	var err error
+	if transport.Type != database.VolumeTransportTypeNFS {
+		targetID, err = getTargetID(host, transport, volumedb.ID)
+		if err != nil {
+			return transport, fmt.Errorf("failed to generate target ID: %w", err)
+		}
+	}
+
+	targetAddress, targetPassword := getServerConnection(host)
+	targetAddresses := host.DataAddresses()
+	switch transport.Type {
+	case database.VolumeTransportTypeISCSI:
+		next := &database.VolumeTransportISCSI{}
+		if transport.ISCSI != nil {
+			*next = *transport.ISCSI
+		}
+		next.TargetAddress = targetAddresses[0]
+		next.TargetAddresses = targetAddresses
+		next.TargetIQN = targetID
+		// The credentials of the volume move along with it, and a volume
+		// that still authenticates with the keys of its hosts is given its
+		// own.
+		transport.ISCSI = next
+		if next.InitiatorPassword == "" {
+			if err := generateCredentials(&transport); err != nil {
+				return transport, err
+			}
+		}
+
+		err = iscsi.With(executor).PublishVolume(ctx, iscsi.PublishVolumeArguments{
+			VolumeID:   volumedb.ID,
+			DevicePath: volumedb.DevicePathZFS(),
+			TargetIQN:  iscsi.IQN(targetID),
+			Portals:    getBindAddresses(targetAddresses),
+		})
+	case database.VolumeTransportTypeNVMEOF_TCP:
+		next := &database.VolumeTransportNVMEOF{}
+		if transport.NVMEOF != nil {
+			*next = *transport.NVMEOF
+		}
+		nvmeofAddresses, err := getNVMeOFAddresses(host, next.TLS)
+		if err != nil {
+			return transport, err
+		}
+		next.TargetAddress = nvmeofAddresses[0]
+		next.TargetAddresses = nvmeofAddresses
+		next.TargetNQN = targetID
+		// The credentials of the volume move along with it. A volume that
+		// still authenticates with the keys of its hosts keeps doing so, as
+		// its clients may connect with the NQNs of their hosts, whose keys
+		// nvmet shares across the volumes of the server host.
+		if next.InitiatorPassword == "" {
+			next.TargetPassword = targetPassword
+		}
+		transport.NVMEOF = next
+
+		err = nvmeof.With(executor).PublishVolume(ctx, nvmeof.PublishVolumeArguments{
+			VolumeID:   volumedb.ID,
+			DevicePath: volumedb.DevicePathZFS(),
+			TargetNQN:  nvmeof.NQN(targetID),
+			Ports:      getNVMeOFPorts(host, next.TLS),
+		})
+	case database.VolumeTransportTypeNFS:
+		next := &database.VolumeTransportNFS{}
+		if transport.NFS != nil {
+			*next = *transport.NFS
+		}
+		next.ServerAddress = targetAddress
+		next.ExportPath, err = getExportPath(ctx, executor, volumedb)
+		if err != nil {
+			return transport, err
+		}
+		transport.NFS = next
+
+		err = nfs.With(executor).Export(ctx, nfs.ExportArguments{
+			VolumeID:        volumedb.ID,
+			Path:            next.ExportPath,
+			Clients:         next.ClientAddresses,
+			ReadOnlyClients: getReadOnlyClients(volumedb),
+		})
+	case database.VolumeTransportTypeUNSPECIFIED:
+		fallthrough
+	default:
+		return transport, fmt.Errorf("no transport specified on volume")
+	}
+	if err != nil {
+		return transport, fmt.Errorf("failed to publish volume: %w", err)
+	}
+	return transport, nil
+}
+
+// unpublishTarget removes the target described by the transport from the host.
+func unpublishTarget(
+	ctx context.Context,
+	executor libcommand.Executor,
+	volumedb *database.Volume,
+	transport database.VolumeTransport,
+) error {
+	var err error
+	switch transport.Type {
+	case database.VolumeTransportTypeISCSI:
+		err = iscsi.With(executor).UnpublishVolume(ctx, iscsi.UnpublishVolumeArguments{
+			VolumeID:  volumedb.ID,
+			TargetIQN: iscsi.IQN(transport.ISCSI.TargetIQN),
+		})
+	case database.VolumeTransportTypeNVMEOF_TCP:
+		err = nvmeof.With(executor).UnpublishVolume(ctx, nvmeof.UnpublishVolumeArguments{
+			TargetNQN: nvmeof.NQN(transport.NVMEOF.TargetNQN),
+		})
+	case database.VolumeTransportTypeNFS:
+		err = nfs.With(executor).Unexport(ctx, nfs.UnexportArguments{
+			VolumeID: volumedb.ID,
+		})
+	case database.VolumeTransportTypeUNSPECIFIED:
+		fallthrough
+	default:
+		return fmt.Errorf("no transport specified on volume")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to unpublish volume: %w", err)
+	}
+	return nil
+}
+
+// attachedClient is the client host of an attachment of a volume, along with
+// the executor to reach it.
+type attachedClient struct {
+	attachment *database.VolumeAttachment
+	host       *database.Host
+	executor   libcommand.Executor
+}
+
+// getAttachedClients returns the client hosts the volume is attached to.
+func (s *VolumeService) getAttachedClients(ctx context.Context, volumedb *database.Volume) ([]attachedClient, error) {
+	clients := make([]attachedClient, 0, len(volumedb.Attachments))
+	for i := range volumedb.Attachments {
+		attachment := &volumedb.Attachments[i]
+		executor, host, err := s.getExecutorForHost(ctx, attachment.ClientHost)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, attachedClient{
+			attachment: attachment,
+			host:       host,
+			executor:   executor,
+		})
+	}
+	return clients, nil
+}
+
+// connectClient authorizes the initiator of the attached client on the server
+// and connects the client to the target described by the transport.
+func connectClient(
+	ctx context.Context,
+	db *gorm.DB,
+	serverExecutor libcommand.Executor,
+	serverHost *database.Host,
+	client attachedClient,
+	volumedb *database.Volume,
+	transport database.VolumeTransport,
+) error {
+	tlsKey, err := getTLSKey(ctx, db, transport, serverHost, client.host.Name)
+	if err != nil {
+		return err
+	}
+
+	switch transport.Type {
+	case database.VolumeTransportTypeISCSI:
+		t := transport.ISCSI
+		err = iscsi.With(serverExecutor).Authorize(ctx, iscsi.AuthorizeArguments{
+			TargetIQN:         iscsi.IQN(t.TargetIQN),
+			TargetPassword:    t.TargetPassword,
+			InitiatorIQN:      iscsi.IQN(client.attachment.Initiator),
+			InitiatorPassword: getInitiatorPassword(transport, client.host),
+			ReadOnly:          client.attachment.ReadOnly,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to authorize client: %w", err)
+		}
+
+		err = iscsi.With(client.executor).ConnectTarget(ctx, iscsi.ConnectTargetArguments{
+			TargetAddresses:   t.Portals(),
+			TargetIQN:         iscsi.IQN(t.TargetIQN),
+			TargetPassword:    t.TargetPassword,
+			InitiatorIQN:      iscsi.IQN(client.attachment.Initiator),
+			InitiatorPassword: getInitiatorPassword(transport, client.host),
+		})
+	case database.VolumeTransportTypeNVMEOF_TCP:
+		t := transport.NVMEOF
+		err = nvmeof.With(serverExecutor).Authorize(ctx, nvmeof.AuthorizeArguments{
+			TargetNQN:         nvmeof.NQN(t.TargetNQN),
+			TargetPassword:    t.TargetPassword,
+			InitiatorNQN:      nvmeof.NQN(client.attachment.Initiator),
+			InitiatorPassword: getInitiatorPassword(transport, client.host),
+			TLSKey:            tlsKey,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to authorize client: %w", err)
+		}
+
+		err = nvmeof.With(client.executor).ConnectTarget(ctx, nvmeof.ConnectTargetArguments{
+			TargetAddresses:   t.Ports(),
+			TargetNQN:         nvmeof.NQN(t.TargetNQN),
+			TargetPassword:    t.TargetPassword,
+			InitiatorNQN:      nvmeof.NQN(client.attachment.Initiator),
+			HostID:            getHostID(client.attachment.Initiator, client.host),
+			InitiatorPassword: getInitiatorPassword(transport, client.host),
+			TLSKey:            tlsKey,
+		})
+	case database.VolumeTransportTypeNFS:
+		// The client mounts the export directly when the volume is staged.
+		err = nfs.With(serverExecutor).Export(ctx, nfs.ExportArguments{
+			VolumeID:        volumedb.ID,
+			Path:            transport.NFS.ExportPath,
+			Clients:         transport.NFS.ClientAddresses,
+			ReadOnlyClients: getReadOnlyClients(volumedb),
+		})
+	case database.VolumeTransportTypeUNSPECIFIED:
+		fallthrough
+	default:
+		return fmt.Errorf("no transport specified on volume")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect volume: %w", err)
+	}
+	return nil
+}
+
+// disconnectClient disconnects the client from the target described by the
+// transport.
+func disconnectClient(
+	ctx context.Context,
+	clientExecutor libcommand.Executor,
+	transport database.VolumeTransport,
+) error {
+	var err error
+	switch transport.Type {
+	case database.VolumeTransportTypeISCSI:
+		err = iscsi.With(clientExecutor).DisconnectTarget(ctx, iscsi.DisconnectTargetArguments{
+			TargetIQN: iscsi.IQN(transport.ISCSI.TargetIQN),
+		})
+	case database.VolumeTransportTypeNVMEOF_TCP:
+		err = nvmeof.With(clientExecutor).DisconnectTarget(ctx, nvmeof.DisconnectTargetArguments{
+			TargetNQN: nvmeof.NQN(transport.NVMEOF.TargetNQN),
+		})
+	case database.VolumeTransportTypeNFS:
+		// The client holds no connection outside of its staging mount.
+		return nil
+	case database.VolumeTransportTypeUNSPECIFIED:
+		fallthrough
+	default:
+		return fmt.Errorf("no transport specified on volume")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to disconnect volume: %w", err)
+	}
+	return nil
+}
+
+// unmountClient unmounts the volume from the target paths and the staging path
+// of the attached client, so that it can be disconnected from its target. A
+// lazy unmount detaches the mounts even when they are still in use.
+func unmountClient(ctx context.Context, client attachedClient, lazy bool) error {
+	if !client.attachment.IsStaged() {
+		return nil
+	}
+	var errs []error
+	paths := append(slices.Clone(client.attachment.TargetPaths), client.attachment.StagingPath)
+	for _, path := range paths {
+		isMounted, _ := mount.With(client.executor).IsMounted(ctx, path)
+		if !isMounted {
+			continue
+		}
+		err := mount.With(client.executor).Umount(ctx, mount.UmountArguments{
+			Path: path,
+			Lazy: lazy,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to unmount %s: %w", path, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// buildMigrationSnapshotName returns the name of a snapshot taken to migrate a
+// volume. It is not recorded as a snapshot resource.
+func buildMigrationSnapshotName() string {
+	return fmt.Sprintf("mig-%s", strings.ToLower(ulid.Make().String()))
+}
+
+// MigrateVolume moves the ZFS volume of a volume, along with its snapshots, to
+// another server host.
+//
+// The bulk of the data is sent while the volume remains in use. The cutover
+// then unmounts and disconnects the clients, unpublishes the volume, sends
+// what changed since and publishes the volume on the new host before
+// reconnecting the clients. Only then are the server host and transport of the
+// volume updated, together, and the clients restaged and remounted against
+// the new target. The ZFS volume on the old host is destroyed last. A failed
+// cutover restores the volume on the old host.
+func (s *VolumeService) MigrateVolume(ctx context.Context, req *connect.Request[zfsilov1.MigrateVolumeRequest]) (*connect.Response[zfsilov1.MigrateVolumeResponse], error) {
+	volumedb, err := gorm.G[*database.Volume](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("volume does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
+	}
+
+	if err := requireServerHost(volumedb); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	switch {
+	case volumedb.ServerHost == req.Msg.ServerHost:
+		volumeapi, err := s.converter.FromDBToAPI(volumedb)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
+		}
+		return connect.NewResponse(&zfsilov1.MigrateVolumeResponse{Volume: volumeapi}), nil
+	}
+
+	// Clones have to live alongside their origin, so neither a clone nor a
+	// volume with clones can be moved.
+	if volumedb.SourceSnapshotID() != "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("volume is a clone and must stay with its source snapshot"))
+	}
+	count, err := gorm.G[*database.Volume](s.database).
+		Where("source_volume = ? OR source_snapshot IN (?)", volumedb.ID, s.database.Model(&database.Snapshot{}).Select("id").Where("volume_id = ?", volumedb.ID)).
+		Count(ctx, "id")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check clone references: %w", err))
+	}
+	if count > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume has %d dependent clones", count))
+	}
+
+	// Replications send incrementally from bookmarks that do not move with the
+	// volume.
+	count, err = gorm.G[*database.Replication](s.database).Where("volume_id = ?", volumedb.ID).Count(ctx, "id")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check replication references: %w", err))
+	}
+	if count > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume is still replicated by %d replications", count))
+	}
+
+	targetHost, err := gorm.G[*database.Host](s.database).Where("name = ?", req.Msg.ServerHost).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("server host does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get server host: %w", err))
+	}
+	if targetHost.Role.Data().Type != database.HostRoleTypeServer {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume can only be migrated to a server host"))
+	}
+	if !targetHost.AllowsDataset(volumedb.DatasetID) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("server host does not allow dataset %s", volumedb.DatasetID))
+	}
+
+	sourceExecutor, sourceHost, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	targetExecutor, err := s.executorFactory.BuildExecutor(targetHost)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to build executor for host %s: %w", targetHost.Name, err))
+	}
+	clients, err := s.getAttachedClients(ctx, volumedb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	source := zfs.With(sourceExecutor)
+	target := zfs.With(targetExecutor)
+
+	exists, err := target.VolumeExists(ctx, zfs.VolumeExistsArguments{
+		Name: volumedb.DatasetID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check zfs volume on server host: %w", err))
+	}
+	if exists {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset %s already exists on server host", volumedb.DatasetID))
+	}
+
+	// Everything received on the server host, including the migration
+	// snapshots, is removed if the migration does not go through.
+	destroyTarget := func() {
+		err := target.DestroyVolume(ctx, zfs.DestroyVolumeArguments{
+			Name:      volumedb.DatasetID,
+			Recursive: true,
+		})
+		if err != nil {
+			slogctx.Error(ctx, "failed to destroy partially migrated zfs volume", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+		}
+	}
+	// The migration snapshots on the old host go along with its ZFS volume
+	// once the migration has gone through.
+	var sourceDestroyed bool
+	destroySourceSnapshot := func(name string) {
+		if sourceDestroyed {
+			return
+		}
+		err := source.DestroySnapshot(ctx, zfs.DestroySnapshotArguments{
+			Name: database.BuildSnapshotDatasetID(volumedb.DatasetID, name),
+		})
+		if err != nil {
+			slogctx.Error(ctx, "failed to destroy migration snapshot", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+		}
+	}
+
+	// Send everything up to now while the volume remains in use.
+	initialSnapshot := buildMigrationSnapshotName()
+	err = source.CreateSnapshot(ctx, zfs.CreateSnapshotArguments{
+		Name: database.BuildSnapshotDatasetID(volumedb.DatasetID, initialSnapshot),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create zfs snapshot: %w", err))
+	}
+	defer destroySourceSnapshot(initialSnapshot)
+
+	bytesTransferred, err := transfer(
+		ctx,
+		source,
+		zfs.SendArguments{
+			Snapshot:  database.BuildSnapshotDatasetID(volumedb.DatasetID, initialSnapshot),
+			Replicate: true,
+			Raw:       volumedb.Encrypted,
+		},
+		target,
+		zfs.ReceiveArguments{
+			Name: volumedb.DatasetID,
+		},
+	)
+	if err != nil {
+		destroyTarget()
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to send volume: %w", err))
+	}
+
+	// Cutover.
+	previousTransport := volumedb.Transport.Data()
+	transport := previousTransport
+	var (
+		disconnectedClients []attachedClient
+		sourceUnpublished   bool
+		targetPublished     bool
+	)
+	restore := func() {
+		// We undo the cutover in reverse, leaving the volume as it was on the
+		// original server host.
+		if targetPublished {
+			if err := unpublishTarget(ctx, targetExecutor, volumedb, transport); err != nil {
+				slogctx.Error(ctx, "failed to unpublish volume from server host", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+			}
+		}
+		destroyTarget()
+		if sourceUnpublished {
+			if _, err := publishTarget(ctx, sourceExecutor, sourceHost, volumedb, previousTransport); err != nil {
+				slogctx.Error(ctx, "failed to republish volume on original server host", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+			}
+		}
+		for _, client := range disconnectedClients {
+			if err := connectClient(ctx, s.database, sourceExecutor, sourceHost, client, volumedb, previousTransport); err != nil {
+				slogctx.Error(ctx, "failed to reconnect client to original server host", slog.String("volumeId", volumedb.ID), slog.String("clientHost", client.host.Name), slogctx.Err(err))
+			}
+		}
+		if volumedb.IsStaged() {
+			if err := s.syncer.Sync(ctx, volumedb); err != nil {
+				slogctx.Error(ctx, "failed to remount volume from original server host", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+			}
+		}
+	}
+
+	finalSnapshot := buildMigrationSnapshotName()
+	err = func() error {
+		for _, client := range clients {
+			// Writes the client still holds are flushed so that they make it
+			// into the final snapshot. A block volume is written to directly
+			// by its consumer, which is left to do so itself.
+			if client.attachment.IsStaged() && volumedb.Mode != database.VolumeModeBLOCK {
+				_, err := literal.With(client.executor).Run(ctx, fmt.Sprintf("sync -f %s", client.attachment.StagingPath))
+				if err != nil {
+					return fmt.Errorf("failed to flush volume on client host %s: %w", client.host.Name, err)
+				}
+			}
+			if err := unmountClient(ctx, client, false); err != nil {
+				return fmt.Errorf("failed to unmount volume on client host %s: %w", client.host.Name, err)
+			}
+			disconnectedClients = append(disconnectedClients, client)
+			if err := disconnectClient(ctx, client.executor, previousTransport); err != nil {
+				return err
+			}
+		}
+		if volumedb.IsPublished() {
+			if err := unpublishTarget(ctx, sourceExecutor, volumedb, previousTransport); err != nil {
+				return err
+			}
+			sourceUnpublished = true
+		}
+
+		// With the volume no longer in use we send what changed since, which
+		// includes any snapshots taken in the meantime.
+		err := source.CreateSnapshot(ctx, zfs.CreateSnapshotArguments{
+			Name: database.BuildSnapshotDatasetID(volumedb.DatasetID, finalSnapshot),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create zfs snapshot: %w", err)
+		}
+		n, err := transfer(
+			ctx,
+			source,
+			zfs.SendArguments{
+				Snapshot:     database.BuildSnapshotDatasetID(volumedb.DatasetID, finalSnapshot),
+				From:         database.BuildSnapshotDatasetID(volumedb.DatasetID, initialSnapshot),
+				Intermediary: true,
+				Replicate:    true,
+				Raw:          volumedb.Encrypted,
+			},
+			target,
+			zfs.ReceiveArguments{
+				Name:  volumedb.DatasetID,
+				Force: true,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to send volume: %w", err)
+		}
+		bytesTransferred += n
+
+		if volumedb.IsPublished() {
+			transport, err = publishTarget(ctx, targetExecutor, targetHost, volumedb, previousTransport)
+			if err != nil {
+				return err
+			}
+			targetPublished = true
+		}
+		for _, client := range clients {
+			if err := connectClient(ctx, s.database, targetExecutor, targetHost, client, volumedb, transport); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	defer destroySourceSnapshot(finalSnapshot)
+	if err != nil {
+		restore()
+		if code := connect.CodeOf(err); code != connect.CodeUnknown {
+			return nil, err
+		}
+		if strings.Contains(err.Error(), "dataset is busy") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset is busy: %w", err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to migrate volume: %w", err))
+	}
+
+	previousServerHost := volumedb.ServerHost
+	volumedb.ServerHost = targetHost.Name
+	volumedb.Transport = datatypes.NewJSONType(transport)
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		_, err := gorm.G[*database.Volume](tx).
+			Where("id = ?", volumedb.ID).
+			Select("server_host", "transport").
+			Updates(ctx, volumedb)
+		if err != nil {
+			return fmt.Errorf("failed to update volume in database: %w", err)
+		}
+		_, err = gorm.G[*database.Snapshot](tx).
+			Where("volume_id = ? AND server_host = ?", volumedb.ID, previousServerHost).
+			Update(ctx, "server_host", volumedb.ServerHost)
+		if err != nil {
+			return fmt.Errorf("failed to update snapshots in database: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		volumedb.ServerHost = previousServerHost
+		volumedb.Transport = datatypes.NewJSONType(previousTransport)
+		restore()
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to migrate volume: %w", err))
+	}
+
+	// The clients are restaged and remounted against the new target before
+	// anything else, as they are without the volume until then.
+	var remountErr error
+	if volumedb.IsStaged() {
+		remountErr = s.syncer.Sync(ctx, volumedb)
+	}
+
+	// The migration has gone through at this point, so failing to clean up
+	// after it is not an error.
+	for _, name := range []string{initialSnapshot, finalSnapshot} {
+		err := target.DestroySnapshot(ctx, zfs.DestroySnapshotArguments{
+			Name: database.BuildSnapshotDatasetID(volumedb.DatasetID, name),
+		})
+		if err != nil {
+			slogctx.Error(ctx, "failed to destroy migration snapshot", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+		}
+	}
+	err = source.DestroyVolume(ctx, zfs.DestroyVolumeArguments{
+		Name:      volumedb.DatasetID,
+		Recursive: true,
+	})
+	if err != nil {
+		slogctx.Error(ctx, "failed to destroy migrated zfs volume on original server host", slog.String("volumeId", volumedb.ID), slogctx.Err(err))
+	} else {
+		sourceDestroyed = true
+	}
+
+	if remountErr != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("volume migrated but the clients failed to remount, sync the volume to retry: %w", remountErr))
+	}
+
+	volumeapi, err := s.converter.FromDBToAPI(volumedb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
+	}
+	return connect.NewResponse(&zfsilov1.MigrateVolumeResponse{
+		Volume:           volumeapi,
+		BytesTransferred: bytesTransferred,
+	}), nil
+}
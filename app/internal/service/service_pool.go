@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1/zfsilov1connect"
+	"github.com/jovulic/zfsilo/app/internal/command"
+	"github.com/jovulic/zfsilo/app/internal/command/zpool"
+	converteriface "github.com/jovulic/zfsilo/app/internal/converter/iface"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	"gorm.io/gorm"
+)
+
+type PoolService struct {
+	zfsilov1connect.UnimplementedPoolServiceHandler
+
+	database        *gorm.DB
+	executorFactory *command.ExecutorFactory
+}
+
+func NewPoolService(
+	database *gorm.DB,
+	executorFactory *command.ExecutorFactory,
+) *PoolService {
+	return &PoolService{
+		database:        database,
+		executorFactory: executorFactory,
+	}
+}
+
+func (s *PoolService) GetPool(ctx context.Context, req *connect.Request[zfsilov1.GetPoolRequest]) (*connect.Response[zfsilov1.GetPoolResponse], error) {
+	hostdb, err := gorm.G[*database.Host](s.database).Where("name = ?", req.Msg.ServerHost).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("server host does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get server host: %w", err))
+	}
+	if hostdb.Role.Data().Type != database.HostRoleTypeServer {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("host is not a server host"))
+	}
+
+	poolapis, err := s.getPools(ctx, hostdb, []string{req.Msg.Name})
+	if err != nil {
+		if strings.Contains(err.Error(), "pool does not exist") {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("pool does not exist"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get pool: %w", err))
+	}
+	if len(poolapis) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("pool does not exist"))
+	}
+
+	return connect.NewResponse(&zfsilov1.GetPoolResponse{Pool: poolapis[0]}), nil
+}
+
+func (s *PoolService) GetPoolStatus(ctx context.Context, req *connect.Request[zfsilov1.GetPoolStatusRequest]) (*connect.Response[zfsilov1.GetPoolStatusResponse], error) {
+	hostdb, err := gorm.G[*database.Host](s.database).Where("name = ?", req.Msg.ServerHost).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("server host does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get server host: %w", err))
+	}
+	if hostdb.Role.Data().Type != database.HostRoleTypeServer {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("host is not a server host"))
+	}
+
+	pool, ok := hostdb.Status.Data().Pool(req.Msg.Name)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("pool has not been polled"))
+	}
+
+	poolStatusapi, err := converteriface.ConvertPoolStatusFromDBToAPI(hostdb.Name, pool)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map pool status: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.GetPoolStatusResponse{PoolStatus: poolStatusapi}), nil
+}
+
+func (s *PoolService) ListPools(ctx context.Context, req *connect.Request[zfsilov1.ListPoolsRequest]) (*connect.Response[zfsilov1.ListPoolsResponse], error) {
+	query := gorm.G[*database.Host](s.database).Order("name").Where("json_extract(role, '$.type') = ?", database.HostRoleTypeServer)
+	if req.Msg.ServerHost != "" {
+		query = query.Where("name = ?", req.Msg.ServerHost)
+	}
+	hostdbs, err := query.Find(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to find server hosts: %w", err))
+	}
+
+	var poolapis []*zfsilov1.Pool
+	for _, hostdb := range hostdbs {
+		hostPoolapis, err := s.getPools(ctx, hostdb, nil)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list pools of host %s: %w", hostdb.Name, err))
+		}
+		poolapis = append(poolapis, hostPoolapis...)
+	}
+
+	return connect.NewResponse(&zfsilov1.ListPoolsResponse{Pools: poolapis}), nil
+}
+
+// getPools returns the named pools of the server host, or all of its pools
+// when no names are given.
+func (s *PoolService) getPools(ctx context.Context, hostdb *database.Host, names []string) ([]*zfsilov1.Pool, error) {
+	executor, err := s.executorFactory.BuildExecutor(hostdb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build executor for host %s: %w", hostdb.Name, err)
+	}
+
+	pools, err := zpool.With(executor).List(ctx, zpool.ListArguments{Names: names})
+	if err != nil {
+		return nil, err
+	}
+
+	datasets, err := getHostDatasets(ctx, executor, hostdb)
+	if err != nil {
+		return nil, err
+	}
+
+	poolapis := make([]*zfsilov1.Pool, 0, len(pools))
+	for _, pool := range pools {
+		status, err := zpool.With(executor).Status(ctx, zpool.StatusArguments{Name: pool.Name})
+		if err != nil {
+			return nil, err
+		}
+
+		var poolDatasets []string
+		for _, dataset := range datasets {
+			if dataset == pool.Name || strings.HasPrefix(dataset, pool.Name+"/") {
+				poolDatasets = append(poolDatasets, dataset)
+			}
+		}
+
+		poolapis = append(poolapis, &zfsilov1.Pool{
+			ServerHost:           hostdb.Name,
+			Name:                 pool.Name,
+			SizeBytes:            int64(pool.SizeBytes),
+			AllocatedBytes:       int64(pool.AllocatedBytes),
+			FreeBytes:            int64(pool.FreeBytes),
+			FragmentationPercent: int32(pool.FragmentationPercent),
+			Health:               converteriface.ConvertPoolHealthToAPI(string(pool.Health)),
+			Status:               status.Status,
+			Action:               status.Action,
+			Errors:               status.Errors,
+			Datasets:             poolDatasets,
+		})
+	}
+	return poolapis, nil
+}
@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1/zfsilov1connect"
+	converteriface "github.com/jovulic/zfsilo/app/internal/converter/iface"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const (
+	listReplicationsDefaultPageSize = 25
+	listReplicationsMaxPageSize     = 100
+
+	replicationDefaultIntervalSeconds = 3600
+	replicationMinIntervalSeconds     = 60
+)
+
+// applyReplicationUpdate modifies an existing Replication object with fields
+// from a protobuf Struct. It returns an error if any of the provided fields
+// have an incorrect type.
+func applyReplicationUpdate(
+	existingReplication *zfsilov1.Replication,
+	updates *structpb.Struct,
+) error {
+	if updates == nil || len(updates.GetFields()) == 0 {
+		// Nothing to update.
+		return nil
+	}
+
+	updateMap := updates.GetFields()
+
+	// We loop over all fields explicitly handling any fields that can be
+	// updated.
+	for key, value := range updateMap {
+		// We Use a switch to explicitly handle only the mutable fields.
+		switch key {
+		case "struct":
+			nestedStruct, ok := value.GetKind().(*structpb.Value_StructValue)
+			if !ok {
+				return &FieldTypeError{
+					FieldName:    key,
+					ExpectedType: "object",
+					ActualType:   fmt.Sprintf("%T", value.GetKind()),
+				}
+			}
+			existingReplication.Struct = nestedStruct.StructValue
+		case "interval_seconds":
+			numValue, ok := value.GetKind().(*structpb.Value_NumberValue)
+			if !ok {
+				return &FieldTypeError{
+					FieldName:    key,
+					ExpectedType: "number",
+					ActualType:   fmt.Sprintf("%T", value.GetKind()),
+				}
+			}
+			existingReplication.IntervalSeconds = int32(numValue.NumberValue)
+		default:
+			// Silently ignore immutable, read-only, or unknown fields.
+			// skip
+		}
+	}
+
+	return nil
+}
+
+// validateReplicationInterval checks the interval of a replication, applying
+// the default when it is unset.
+func validateReplicationInterval(repl *database.Replication) error {
+	if repl.IntervalSeconds == 0 {
+		repl.IntervalSeconds = replicationDefaultIntervalSeconds
+	}
+	if repl.IntervalSeconds < replicationMinIntervalSeconds {
+		return fmt.Errorf("interval must be at least %d seconds", replicationMinIntervalSeconds)
+	}
+	return nil
+}
+
+type ReplicationService struct {
+	zfsilov1connect.UnimplementedReplicationServiceHandler
+
+	database   *gorm.DB
+	converter  converteriface.ReplicationConverter
+	replicator *Replicator
+}
+
+func NewReplicationService(
+	database *gorm.DB,
+	converter converteriface.ReplicationConverter,
+	replicator *Replicator,
+) *ReplicationService {
+	return &ReplicationService{
+		database:   database,
+		converter:  converter,
+		replicator: replicator,
+	}
+}
+
+func (s *ReplicationService) GetReplication(ctx context.Context, req *connect.Request[zfsilov1.GetReplicationRequest]) (*connect.Response[zfsilov1.GetReplicationResponse], error) {
+	repldb, err := gorm.G[*database.Replication](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("replication does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get replication: %w", err))
+	}
+
+	replapi, err := s.converter.FromDBToAPI(repldb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map replication: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.GetReplicationResponse{Replication: replapi}), nil
+}
+
+func (s *ReplicationService) ListReplications(ctx context.Context, req *connect.Request[zfsilov1.ListReplicationsRequest]) (*connect.Response[zfsilov1.ListReplicationsResponse], error) {
+	// Determine the offset and limit parameters.
+	var offset, limit int
+
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = listReplicationsDefaultPageSize
+	}
+	if pageSize > listReplicationsMaxPageSize {
+		pageSize = listReplicationsMaxPageSize
+	}
+
+	if req.Msg.PageToken == "" {
+		offset = 0
+		limit = pageSize
+	} else {
+		pageToken, err := UnmarshalPageToken(req.Msg.PageToken)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to unmarshal page token: %w", err))
+		}
+		offset = pageToken.Offset
+		limit = pageToken.Limit
+	}
+
+	// Execute the database query.
+	query := gorm.G[*database.Replication](s.database).Order("create_time desc")
+	if req.Msg.VolumeId != "" {
+		query = query.Where("volume_id = ?", req.Msg.VolumeId)
+	}
+	repldbs, err := query.
+		Offset(offset).
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get replications from database: %w", err))
+	}
+
+	// Convert database models to API models.
+	replapis, err := s.converter.FromDBToAPIList(repldbs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to map database replications to API: %w", err))
+	}
+
+	// Determine the next page token.
+	var nextPageTokenString string
+	if len(replapis) == limit {
+		nextPageToken := PageToken{
+			Offset: offset + len(replapis),
+			Limit:  limit,
+		}
+		tokenStr, err := nextPageToken.Marshal()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to marshal next page token: %w", err))
+		}
+		nextPageTokenString = tokenStr
+	}
+
+	return connect.NewResponse(&zfsilov1.ListReplicationsResponse{
+		Replications:  replapis,
+		NextPageToken: nextPageTokenString,
+	}), nil
+}
+
+func (s *ReplicationService) CreateReplication(ctx context.Context, req *connect.Request[zfsilov1.CreateReplicationRequest]) (*connect.Response[zfsilov1.CreateReplicationResponse], error) {
+	repldb, err := s.converter.FromAPIToDB(req.Msg.Replication)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map replication: %w", err))
+	}
+	// The status is only ever written by the replicator.
+	repldb.Status = datatypes.NewJSONType(database.ReplicationStatus{})
+
+	if err := validateReplicationInterval(repldb); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	volumedb, err := gorm.G[*database.Volume](s.database).Where("id = ?", repldb.VolumeID).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("volume does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
+	}
+
+	hostdb, err := gorm.G[*database.Host](s.database).Where("name = ?", repldb.TargetHost).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("target host does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get target host: %w", err))
+	}
+	if hostdb.Role.Data().Type != database.HostRoleTypeServer {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("target host must be a server host"))
+	}
+	if !hostdb.AllowsDataset(repldb.TargetDatasetID) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("target host does not allow dataset %s", repldb.TargetDatasetID))
+	}
+	if repldb.TargetHost == volumedb.ServerHost && repldb.TargetDatasetID == volumedb.DatasetID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume cannot be replicated onto itself"))
+	}
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		// Create database entry.
+		err := gorm.G[*database.Replication](tx).Create(ctx, &repldb)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("replication already exists"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create replication: %w", err))
+	}
+
+	replapi, err := s.converter.FromDBToAPI(repldb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map replication: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.CreateReplicationResponse{Replication: replapi}), nil
+}
+
+func (s *ReplicationService) UpdateReplication(ctx context.Context, req *connect.Request[zfsilov1.UpdateReplicationRequest]) (*connect.Response[zfsilov1.UpdateReplicationResponse], error) {
+	idValue := req.Msg.Replication.GetFields()["id"]
+	if idValue == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("replication id must be defined"))
+	}
+	id := idValue.GetStringValue()
+
+	repldb, err := gorm.G[*database.Replication](s.database).Where("id = ?", id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("replication does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get replication: %w", err))
+	}
+
+	replapi, err := s.converter.FromDBToAPI(repldb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map replication: %w", err))
+	}
+
+	err = applyReplicationUpdate(replapi, req.Msg.Replication)
+	if err != nil {
+		var errField *FieldTypeError
+		if errors.As(err, &errField) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to update replication: %w", errField))
+		}
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to apply update to replication: %w", err))
+	}
+
+	repldb, err = s.converter.FromAPIToDB(replapi)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map replication: %w", err))
+	}
+
+	if err := validateReplicationInterval(repldb); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	replapi.IntervalSeconds = repldb.IntervalSeconds
+
+	// NOTE: We use Select so that the status, which the replicator may be
+	// updating concurrently, is left untouched.
+	_, err = gorm.G[*database.Replication](s.database).
+		Where("id = ?", repldb.ID).
+		Select("struct", "interval_seconds").
+		Updates(ctx, repldb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update replication in database: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.UpdateReplicationResponse{Replication: replapi}), nil
+}
+
+func (s *ReplicationService) DeleteReplication(ctx context.Context, req *connect.Request[zfsilov1.DeleteReplicationRequest]) (*connect.Response[zfsilov1.DeleteReplicationResponse], error) {
+	repldb, err := gorm.G[*database.Replication](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("replication does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get replication: %w", err))
+	}
+
+	// The standby replication is managed through the standby host of its
+	// volume.
+	if repldb.Standby {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("replication keeps the standby copy of its volume and is removed by clearing the standby host"))
+	}
+
+	if err := s.replicator.Remove(ctx, repldb); err != nil {
+		if strings.Contains(err.Error(), "dataset is busy") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset is busy: %w", err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete replication: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.DeleteReplicationResponse{}), nil
+}
+
+func (s *ReplicationService) SyncReplication(ctx context.Context, req *connect.Request[zfsilov1.SyncReplicationRequest]) (*connect.Response[zfsilov1.SyncReplicationResponse], error) {
+	_, err := gorm.G[*database.Replication](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("replication does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get replication: %w", err))
+	}
+
+	repldb, err := s.replicator.Sync(ctx, req.Msg.Id)
+	if err != nil {
+		if errors.Is(err, errVolumeNotPublished) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if strings.Contains(err.Error(), "dataset is busy") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset is busy: %w", err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to sync replication: %w", err))
+	}
+
+	replapi, err := s.converter.FromDBToAPI(repldb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map replication: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.SyncReplicationResponse{Replication: replapi}), nil
+}
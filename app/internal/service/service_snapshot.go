@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/command/zfs"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	"gorm.io/gorm"
+)
+
+const (
+	listSnapshotsDefaultPageSize = 25
+	listSnapshotsMaxPageSize     = 100
+)
+
+func (s *VolumeService) GetSnapshot(ctx context.Context, req *connect.Request[zfsilov1.GetSnapshotRequest]) (*connect.Response[zfsilov1.GetSnapshotResponse], error) {
+	snapshotdb, err := gorm.G[*database.Snapshot](s.database).Where("id = ? AND hidden = ?", req.Msg.Id, false).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("snapshot does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get snapshot: %w", err))
+	}
+
+	snapshotapi, err := s.snapshotConverter.FromDBToAPI(snapshotdb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshot: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.GetSnapshotResponse{Snapshot: snapshotapi}), nil
+}
+
+func (s *VolumeService) ListSnapshots(ctx context.Context, req *connect.Request[zfsilov1.ListSnapshotsRequest]) (*connect.Response[zfsilov1.ListSnapshotsResponse], error) {
+	// Determine the offset and limit parameters.
+	var offset, limit int
+
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = listSnapshotsDefaultPageSize
+	}
+	if pageSize > listSnapshotsMaxPageSize {
+		pageSize = listSnapshotsMaxPageSize
+	}
+
+	// The page token is empty on the first request and populated on subsequent
+	// requests.
+	if req.Msg.PageToken == "" {
+		offset = 0
+		limit = pageSize
+	} else {
+		pageToken, err := UnmarshalPageToken(req.Msg.PageToken)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to unmarshal page token: %w", err))
+		}
+		offset = pageToken.Offset
+		limit = pageToken.Limit
+	}
+
+	// Execute the database query using the determined parameters.
+	query := gorm.G[*database.Snapshot](s.database).Order("create_time desc").Where("hidden = ?", false)
+	if req.Msg.VolumeId != "" {
+		query = query.Where("volume_id = ?", req.Msg.VolumeId)
+	}
+	snapshotdbs, err := query.
+		Offset(offset).
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get snapshots from database: %w", err))
+	}
+
+	// Convert database models to API models.
+	snapshotapis, err := s.snapshotConverter.FromDBToAPIList(snapshotdbs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to map database snapshots to API: %w", err))
+	}
+
+	// Determine the next page token and build the response.
+	var nextPageTokenString string
+	if len(snapshotapis) == limit {
+		nextPageToken := PageToken{
+			Offset: offset + len(snapshotapis),
+			Limit:  limit,
+		}
+		tokenStr, err := nextPageToken.Marshal()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to marshal next page token: %w", err))
+		}
+		nextPageTokenString = tokenStr
+	}
+
+	return connect.NewResponse(&zfsilov1.ListSnapshotsResponse{
+		Snapshots:     snapshotapis,
+		NextPageToken: nextPageTokenString,
+	}), nil
+}
+
+func (s *VolumeService) CreateSnapshot(ctx context.Context, req *connect.Request[zfsilov1.CreateSnapshotRequest]) (*connect.Response[zfsilov1.CreateSnapshotResponse], error) {
+	snapshotdb, err := s.snapshotConverter.FromAPIToDB(req.Msg.Snapshot)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshot: %w", err))
+	}
+
+	volumedb, err := gorm.G[*database.Volume](s.database).Where("id = ?", snapshotdb.VolumeID).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("volume does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
+	}
+
+	// The ZFS volume only has a known location while it is published, so that
+	// is where we take the snapshot.
+	if volumedb.ServerHost == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("volume is not published"))
+	}
+
+	snapshotdb.DatasetID = database.BuildSnapshotDatasetID(volumedb.DatasetID, snapshotdb.ID)
+	snapshotdb.CapacityBytes = volumedb.CapacityBytes
+	snapshotdb.ServerHost = volumedb.ServerHost
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		// Create database entry.
+		err := gorm.G[*database.Snapshot](tx).Create(ctx, &snapshotdb)
+		if err != nil {
+			return err
+		}
+
+		executor, _, err := s.getExecutorForHost(ctx, snapshotdb.ServerHost)
+		if err != nil {
+			return err
+		}
+
+		// The snapshot may already exist if an earlier attempt failed after it
+		// was taken, in which case we adopt it.
+		names, err := zfs.With(executor).ListSnapshots(ctx, zfs.ListSnapshotsArguments{
+			Name: volumedb.DatasetID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list zfs snapshots: %w", err)
+		}
+		if slices.Contains(names, snapshotdb.DatasetID) {
+			return nil
+		}
+
+		err = zfs.With(executor).CreateSnapshot(ctx, zfs.CreateSnapshotArguments{
+			Name: snapshotdb.DatasetID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create zfs snapshot: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if code := connect.CodeOf(err); code != connect.CodeUnknown {
+			return nil, err
+		}
+		// NOTE: GORM with SQLite may not always return ErrDuplicatedKey as a
+		// wrapped error, so we also check the message.
+		if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("snapshot already exists"))
+		}
+		if strings.Contains(err.Error(), "dataset does not exist") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume dataset does not exist: %w", err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create snapshot: %w", err))
+	}
+
+	snapshotapi, err := s.snapshotConverter.FromDBToAPI(snapshotdb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshot: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.CreateSnapshotResponse{Snapshot: snapshotapi}), nil
+}
+
+func (s *VolumeService) DeleteSnapshot(ctx context.Context, req *connect.Request[zfsilov1.DeleteSnapshotRequest]) (*connect.Response[zfsilov1.DeleteSnapshotResponse], error) {
+	snapshotdb, err := gorm.G[*database.Snapshot](s.database).Where("id = ? AND hidden = ?", req.Msg.Id, false).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("snapshot does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get snapshot: %w", err))
+	}
+
+	// Snapshots taken as part of a group are deleted together with the group.
+	if snapshotdb.SnapshotGroup != "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("snapshot belongs to snapshot group %s", snapshotdb.SnapshotGroup))
+	}
+
+	// Check if snapshot is referenced by any volumes cloned from it.
+	count, err := gorm.G[*database.Volume](s.database).Where("source_snapshot = ?", snapshotdb.ID).Count(ctx, "id")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check volume references: %w", err))
+	}
+	if count > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("snapshot has %d dependent volumes", count))
+	}
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		// Destroy ZFS snapshot.
+		if snapshotdb.ServerHost != "" {
+			executor, _, err := s.getExecutorForHost(ctx, snapshotdb.ServerHost)
+			if err != nil {
+				return fmt.Errorf("failed to get producer executor: %w", err)
+			}
+			err = zfs.With(executor).DestroySnapshot(ctx, zfs.DestroySnapshotArguments{
+				Name: snapshotdb.DatasetID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to destroy zfs snapshot: %w", err)
+			}
+		}
+
+		// Delete from database.
+		_, err = gorm.G[*database.Snapshot](tx).Where("id = ?", req.Msg.Id).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		if code := connect.CodeOf(err); code != connect.CodeUnknown {
+			return nil, err
+		}
+		if strings.Contains(err.Error(), "dataset is busy") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset is busy: %w", err))
+		}
+		if strings.Contains(err.Error(), "has dependent clones") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("snapshot has dependent clones: %w", err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete snapshot: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.DeleteSnapshotResponse{}), nil
+}
+
+func (s *VolumeService) RollbackVolume(ctx context.Context, req *connect.Request[zfsilov1.RollbackVolumeRequest]) (*connect.Response[zfsilov1.RollbackVolumeResponse], error) {
+	volumedb, err := gorm.G[*database.Volume](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("volume does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
+	}
+
+	// Rolling back changes the data underneath any client, so we refuse while a
+	// client is connected to avoid corrupting a mounted filesystem.
+	switch {
+	case volumedb.IsConnected():
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is connected"))
+	case volumedb.Status != database.VolumeStatusINITIAL && volumedb.Status != database.VolumeStatusPUBLISHED:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("volume cannot be rolled back in status %s", volumedb.Status))
+	}
+
+	snapshotdb, err := gorm.G[*database.Snapshot](s.database).Where("id = ? AND hidden = ?", req.Msg.SnapshotId, false).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("snapshot does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get snapshot: %w", err))
+	}
+
+	// We check the dataset rather than the volume id as promoting a clone moves
+	// snapshots between volumes.
+	datasetID, _, _ := strings.Cut(snapshotdb.DatasetID, "@")
+	if datasetID != volumedb.DatasetID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("snapshot does not belong to volume"))
+	}
+	// A snapshot left on a server host the volume failed over from belongs to
+	// the stale copy of the volume there.
+	if slices.Contains(volumedb.FencedHosts, snapshotdb.ServerHost) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("snapshot was left on %s when the volume failed over", snapshotdb.ServerHost))
+	}
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		executor, _, err := s.getExecutorForHost(ctx, snapshotdb.ServerHost)
+		if err != nil {
+			return err
+		}
+
+		err = zfs.With(executor).RollbackSnapshot(ctx, zfs.RollbackSnapshotArguments{
+			Name:         snapshotdb.DatasetID,
+			DestroyNewer: req.Msg.DestroyNewer,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to roll back zfs volume: %w", err)
+		}
+
+		if !req.Msg.DestroyNewer {
+			return nil
+		}
+
+		// Remove the snapshots destroyed by the rollback from the database.
+		names, err := zfs.With(executor).ListSnapshots(ctx, zfs.ListSnapshotsArguments{
+			Name: volumedb.DatasetID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list zfs snapshots: %w", err)
+		}
+		snapshotdbs, err := gorm.G[*database.Snapshot](tx).Where("server_host = ?", snapshotdb.ServerHost).Find(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get snapshots from database: %w", err)
+		}
+		for _, other := range snapshotdbs {
+			if !strings.HasPrefix(other.DatasetID, volumedb.DatasetID+"@") || slices.Contains(names, other.DatasetID) {
+				continue
+			}
+			_, err = gorm.G[*database.Snapshot](tx).Where("id = ?", other.ID).Delete(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to delete snapshot %s from database: %w", other.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if code := connect.CodeOf(err); code != connect.CodeUnknown {
+			return nil, err
+		}
+		if strings.Contains(err.Error(), "more recent snapshots") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume has more recent snapshots: %w", err))
+		}
+		if strings.Contains(err.Error(), "has dependent clones") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("more recent snapshots have dependent clones: %w", err))
+		}
+		if strings.Contains(err.Error(), "dataset is busy") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset is busy: %w", err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to roll back volume: %w", err))
+	}
+
+	volumeapi, err := s.converter.FromDBToAPI(volumedb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.RollbackVolumeResponse{Volume: volumeapi}), nil
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/app/internal/command/zfs"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// findSnapshotGroupSnapshots returns the snapshots of a snapshot group in the
+// order of its volumes.
+func findSnapshotGroupSnapshots(ctx context.Context, db *gorm.DB, snapshotgroupdb *database.SnapshotGroup) ([]*database.Snapshot, error) {
+	snapshotdbs, err := gorm.G[*database.Snapshot](db).Where("snapshot_group = ?", snapshotgroupdb.ID).Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(snapshotdbs, func(a, b *database.Snapshot) int {
+		return slices.Index(snapshotgroupdb.SnapshotIDs, a.ID) - slices.Index(snapshotgroupdb.SnapshotIDs, b.ID)
+	})
+	return snapshotdbs, nil
+}
+
+func (s *VolumeService) GetSnapshotGroup(ctx context.Context, req *connect.Request[zfsilov1.GetSnapshotGroupRequest]) (*connect.Response[zfsilov1.GetSnapshotGroupResponse], error) {
+	snapshotgroupdb, err := gorm.G[*database.SnapshotGroup](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("snapshot group does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get snapshot group: %w", err))
+	}
+
+	snapshotdbs, err := findSnapshotGroupSnapshots(ctx, s.database, snapshotgroupdb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get snapshots from database: %w", err))
+	}
+
+	snapshotgroupapi, err := s.snapshotGroupConverter.FromDBToAPI(snapshotgroupdb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshot group: %w", err))
+	}
+	snapshotapis, err := s.snapshotConverter.FromDBToAPIList(snapshotdbs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshots: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.GetSnapshotGroupResponse{
+		SnapshotGroup: snapshotgroupapi,
+		Snapshots:     snapshotapis,
+	}), nil
+}
+
+// CreateSnapshotGroup snapshots several volumes at the same point in time.
+// The volumes must be published on the same server host and share a parent
+// dataset, which lets a single zfs snapshot take all of them atomically.
+func (s *VolumeService) CreateSnapshotGroup(ctx context.Context, req *connect.Request[zfsilov1.CreateSnapshotGroupRequest]) (*connect.Response[zfsilov1.CreateSnapshotGroupResponse], error) {
+	snapshotgroupdb, err := s.snapshotGroupConverter.FromAPIToDB(req.Msg.SnapshotGroup)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshot group: %w", err))
+	}
+
+	volumedbs, err := gorm.G[*database.Volume](s.database).Where("id IN ?", []string(snapshotgroupdb.VolumeIDs)).Find(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volumes: %w", err))
+	}
+	for _, volumeID := range snapshotgroupdb.VolumeIDs {
+		if !slices.ContainsFunc(volumedbs, func(volumedb *database.Volume) bool { return volumedb.ID == volumeID }) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("volume %s does not exist", volumeID))
+		}
+	}
+	slices.SortFunc(volumedbs, func(a, b *database.Volume) int {
+		return slices.Index(snapshotgroupdb.VolumeIDs, a.ID) - slices.Index(snapshotgroupdb.VolumeIDs, b.ID)
+	})
+
+	// A single zfs snapshot is only atomic within a pool, so we hold the volumes
+	// to the same server host and parent dataset.
+	serverHost := volumedbs[0].ServerHost
+	parentDatasetID := path.Dir(volumedbs[0].DatasetID)
+	for _, volumedb := range volumedbs {
+		switch {
+		case volumedb.ServerHost == "":
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume %s is not published", volumedb.ID))
+		case volumedb.ServerHost != serverHost:
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volumes must be published on the same server host"))
+		case path.Dir(volumedb.DatasetID) != parentDatasetID:
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volumes must share the same parent dataset"))
+		}
+	}
+
+	snapshotdbs := make([]*database.Snapshot, 0, len(volumedbs))
+	for _, volumedb := range volumedbs {
+		snapshotID := database.BuildGroupSnapshotID(snapshotgroupdb.ID, volumedb.ID)
+		snapshotdbs = append(snapshotdbs, &database.Snapshot{
+			ID:            snapshotID,
+			Name:          fmt.Sprintf("snapshots/%s", snapshotID),
+			VolumeID:      volumedb.ID,
+			DatasetID:     database.BuildSnapshotDatasetID(volumedb.DatasetID, snapshotID),
+			CapacityBytes: volumedb.CapacityBytes,
+			ServerHost:    volumedb.ServerHost,
+			SnapshotGroup: snapshotgroupdb.ID,
+		})
+	}
+	snapshotIDs := make([]string, 0, len(snapshotdbs))
+	names := make([]string, 0, len(snapshotdbs))
+	for _, snapshotdb := range snapshotdbs {
+		snapshotIDs = append(snapshotIDs, snapshotdb.ID)
+		names = append(names, snapshotdb.DatasetID)
+	}
+	snapshotgroupdb.SnapshotIDs = datatypes.NewJSONSlice(snapshotIDs)
+	snapshotgroupdb.ServerHost = serverHost
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		// Create database entries.
+		err := gorm.G[*database.SnapshotGroup](tx).Create(ctx, &snapshotgroupdb)
+		if err != nil {
+			return err
+		}
+		for _, snapshotdb := range snapshotdbs {
+			err := gorm.G[*database.Snapshot](tx).Create(ctx, &snapshotdb)
+			if err != nil {
+				return err
+			}
+		}
+
+		executor, _, err := s.getExecutorForHost(ctx, serverHost)
+		if err != nil {
+			return err
+		}
+
+		// The snapshots may already exist if an earlier attempt failed after they
+		// were taken, in which case we adopt them. They are taken together, so
+		// either all or none of them exist.
+		var missing []string
+		for i, volumedb := range volumedbs {
+			existing, err := zfs.With(executor).ListSnapshots(ctx, zfs.ListSnapshotsArguments{
+				Name: volumedb.DatasetID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list zfs snapshots: %w", err)
+			}
+			if !slices.Contains(existing, names[i]) {
+				missing = append(missing, names[i])
+			}
+		}
+		switch len(missing) {
+		case 0:
+			return nil
+		case len(names):
+			// okay
+		default:
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("snapshot group is partially present, missing %s", strings.Join(missing, ", ")))
+		}
+
+		err = zfs.With(executor).CreateSnapshots(ctx, zfs.CreateSnapshotsArguments{
+			Names: names,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create zfs snapshots: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if code := connect.CodeOf(err); code != connect.CodeUnknown {
+			return nil, err
+		}
+		// NOTE: GORM with SQLite may not always return ErrDuplicatedKey as a
+		// wrapped error, so we also check the message.
+		if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("snapshot group already exists"))
+		}
+		if strings.Contains(err.Error(), "dataset does not exist") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume dataset does not exist: %w", err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create snapshot group: %w", err))
+	}
+
+	snapshotgroupapi, err := s.snapshotGroupConverter.FromDBToAPI(snapshotgroupdb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshot group: %w", err))
+	}
+	snapshotapis, err := s.snapshotConverter.FromDBToAPIList(snapshotdbs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshots: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.CreateSnapshotGroupResponse{
+		SnapshotGroup: snapshotgroupapi,
+		Snapshots:     snapshotapis,
+	}), nil
+}
+
+// DeleteSnapshotGroup destroys the snapshots of a snapshot group along with
+// the group itself.
+func (s *VolumeService) DeleteSnapshotGroup(ctx context.Context, req *connect.Request[zfsilov1.DeleteSnapshotGroupRequest]) (*connect.Response[zfsilov1.DeleteSnapshotGroupResponse], error) {
+	snapshotgroupdb, err := gorm.G[*database.SnapshotGroup](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("snapshot group does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get snapshot group: %w", err))
+	}
+
+	snapshotdbs, err := findSnapshotGroupSnapshots(ctx, s.database, snapshotgroupdb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get snapshots from database: %w", err))
+	}
+
+	// Check if any snapshot is referenced by volumes cloned from it.
+	count, err := gorm.G[*database.Volume](s.database).Where("source_snapshot IN ?", []string(snapshotgroupdb.SnapshotIDs)).Count(ctx, "id")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check volume references: %w", err))
+	}
+	if count > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("snapshot group has %d dependent volumes", count))
+	}
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		// Destroy ZFS snapshots. Volumes may have moved since the group was taken,
+		// so we go by the host of each snapshot.
+		for _, snapshotdb := range snapshotdbs {
+			if snapshotdb.ServerHost == "" {
+				continue
+			}
+			executor, _, err := s.getExecutorForHost(ctx, snapshotdb.ServerHost)
+			if err != nil {
+				return fmt.Errorf("failed to get producer executor: %w", err)
+			}
+			err = zfs.With(executor).DestroySnapshot(ctx, zfs.DestroySnapshotArguments{
+				Name: snapshotdb.DatasetID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to destroy zfs snapshot: %w", err)
+			}
+		}
+
+		// Delete from database.
+		_, err := gorm.G[*database.Snapshot](tx).Where("snapshot_group = ?", snapshotgroupdb.ID).Delete(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = gorm.G[*database.SnapshotGroup](tx).Where("id = ?", snapshotgroupdb.ID).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		if code := connect.CodeOf(err); code != connect.CodeUnknown {
+			return nil, err
+		}
+		if strings.Contains(err.Error(), "dataset is busy") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset is busy: %w", err))
+		}
+		if strings.Contains(err.Error(), "has dependent clones") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("snapshot has dependent clones: %w", err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete snapshot group: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.DeleteSnapshotGroupResponse{}), nil
+}
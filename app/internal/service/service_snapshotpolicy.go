@@ -0,0 +1,388 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1/zfsilov1connect"
+	converteriface "github.com/jovulic/zfsilo/app/internal/converter/iface"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	"github.com/jovulic/zfsilo/app/internal/schedule"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/gorm"
+)
+
+const (
+	listSnapshotPoliciesDefaultPageSize = 25
+	listSnapshotPoliciesMaxPageSize     = 100
+
+	listSnapshotPolicyRunsDefaultPageSize = 25
+	listSnapshotPolicyRunsMaxPageSize     = 100
+)
+
+// applySnapshotPolicyUpdate modifies an existing SnapshotPolicy object with
+// fields from a protobuf Struct. It returns an error if any of the provided
+// fields have an incorrect type.
+func applySnapshotPolicyUpdate(
+	existingPolicy *zfsilov1.SnapshotPolicy,
+	updates *structpb.Struct,
+) error {
+	if updates == nil || len(updates.GetFields()) == 0 {
+		// Nothing to update.
+		return nil
+	}
+
+	updateMap := updates.GetFields()
+
+	// We loop over all fields explicitly handling any fields that can be
+	// updated.
+	for key, value := range updateMap {
+		// We Use a switch to explicitly handle only the mutable fields.
+		switch key {
+		case "struct":
+			nestedStruct, ok := value.GetKind().(*structpb.Value_StructValue)
+			if !ok {
+				return &FieldTypeError{
+					FieldName:    key,
+					ExpectedType: "object",
+					ActualType:   fmt.Sprintf("%T", value.GetKind()),
+				}
+			}
+			existingPolicy.Struct = nestedStruct.StructValue
+		case "schedule":
+			stringValue, ok := value.GetKind().(*structpb.Value_StringValue)
+			if !ok {
+				return &FieldTypeError{
+					FieldName:    key,
+					ExpectedType: "string",
+					ActualType:   fmt.Sprintf("%T", value.GetKind()),
+				}
+			}
+			existingPolicy.Schedule = stringValue.StringValue
+		case "keep_last", "keep_daily", "keep_weekly":
+			numValue, ok := value.GetKind().(*structpb.Value_NumberValue)
+			if !ok {
+				return &FieldTypeError{
+					FieldName:    key,
+					ExpectedType: "number",
+					ActualType:   fmt.Sprintf("%T", value.GetKind()),
+				}
+			}
+			switch key {
+			case "keep_last":
+				existingPolicy.KeepLast = int32(numValue.NumberValue)
+			case "keep_daily":
+				existingPolicy.KeepDaily = int32(numValue.NumberValue)
+			case "keep_weekly":
+				existingPolicy.KeepWeekly = int32(numValue.NumberValue)
+			}
+		default:
+			// Silently ignore immutable, read-only, or unknown fields.
+			// skip
+		}
+	}
+
+	return nil
+}
+
+// validateSnapshotPolicy checks the fields of a snapshot policy that are not
+// covered by the request validation.
+func validateSnapshotPolicy(policy *database.SnapshotPolicy) error {
+	if _, err := schedule.ParseCron(policy.Schedule); err != nil {
+		return err
+	}
+	if policy.KeepLast < 0 || policy.KeepDaily < 0 || policy.KeepWeekly < 0 {
+		return errors.New("retention counts cannot be negative")
+	}
+	return nil
+}
+
+type SnapshotPolicyService struct {
+	zfsilov1connect.UnimplementedSnapshotPolicyServiceHandler
+
+	database  *gorm.DB
+	converter converteriface.SnapshotPolicyConverter
+}
+
+func NewSnapshotPolicyService(
+	database *gorm.DB,
+	converter converteriface.SnapshotPolicyConverter,
+) *SnapshotPolicyService {
+	return &SnapshotPolicyService{
+		database:  database,
+		converter: converter,
+	}
+}
+
+func (s *SnapshotPolicyService) GetSnapshotPolicy(ctx context.Context, req *connect.Request[zfsilov1.GetSnapshotPolicyRequest]) (*connect.Response[zfsilov1.GetSnapshotPolicyResponse], error) {
+	policydb, err := gorm.G[*database.SnapshotPolicy](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("snapshot policy does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get snapshot policy: %w", err))
+	}
+
+	policyapi, err := s.converter.FromDBToAPI(policydb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshot policy: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.GetSnapshotPolicyResponse{SnapshotPolicy: policyapi}), nil
+}
+
+func (s *SnapshotPolicyService) ListSnapshotPolicies(ctx context.Context, req *connect.Request[zfsilov1.ListSnapshotPoliciesRequest]) (*connect.Response[zfsilov1.ListSnapshotPoliciesResponse], error) {
+	// Determine the offset and limit parameters.
+	var offset, limit int
+
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = listSnapshotPoliciesDefaultPageSize
+	}
+	if pageSize > listSnapshotPoliciesMaxPageSize {
+		pageSize = listSnapshotPoliciesMaxPageSize
+	}
+
+	if req.Msg.PageToken == "" {
+		offset = 0
+		limit = pageSize
+	} else {
+		pageToken, err := UnmarshalPageToken(req.Msg.PageToken)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to unmarshal page token: %w", err))
+		}
+		offset = pageToken.Offset
+		limit = pageToken.Limit
+	}
+
+	// Execute the database query.
+	policydbs, err := gorm.G[*database.SnapshotPolicy](s.database).
+		Order("create_time desc").
+		Offset(offset).
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get snapshot policies from database: %w", err))
+	}
+
+	// Convert database models to API models.
+	policyapis, err := s.converter.FromDBToAPIList(policydbs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to map database snapshot policies to API: %w", err))
+	}
+
+	// Determine the next page token.
+	var nextPageTokenString string
+	if len(policyapis) == limit {
+		nextPageToken := PageToken{
+			Offset: offset + len(policyapis),
+			Limit:  limit,
+		}
+		tokenStr, err := nextPageToken.Marshal()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to marshal next page token: %w", err))
+		}
+		nextPageTokenString = tokenStr
+	}
+
+	return connect.NewResponse(&zfsilov1.ListSnapshotPoliciesResponse{
+		SnapshotPolicies: policyapis,
+		NextPageToken:    nextPageTokenString,
+	}), nil
+}
+
+func (s *SnapshotPolicyService) CreateSnapshotPolicy(ctx context.Context, req *connect.Request[zfsilov1.CreateSnapshotPolicyRequest]) (*connect.Response[zfsilov1.CreateSnapshotPolicyResponse], error) {
+	policydb, err := s.converter.FromAPIToDB(req.Msg.SnapshotPolicy)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshot policy: %w", err))
+	}
+
+	if err := validateSnapshotPolicy(policydb); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		// Create database entry.
+		err := gorm.G[*database.SnapshotPolicy](tx).Create(ctx, &policydb)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("snapshot policy already exists"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create snapshot policy: %w", err))
+	}
+
+	policyapi, err := s.converter.FromDBToAPI(policydb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshot policy: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.CreateSnapshotPolicyResponse{SnapshotPolicy: policyapi}), nil
+}
+
+func (s *SnapshotPolicyService) UpdateSnapshotPolicy(ctx context.Context, req *connect.Request[zfsilov1.UpdateSnapshotPolicyRequest]) (*connect.Response[zfsilov1.UpdateSnapshotPolicyResponse], error) {
+	idValue := req.Msg.SnapshotPolicy.GetFields()["id"]
+	if idValue == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("snapshot policy id must be defined"))
+	}
+	id := idValue.GetStringValue()
+
+	policydb, err := gorm.G[*database.SnapshotPolicy](s.database).Where("id = ?", id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("snapshot policy does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get snapshot policy: %w", err))
+	}
+
+	policyapi, err := s.converter.FromDBToAPI(policydb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshot policy: %w", err))
+	}
+
+	err = applySnapshotPolicyUpdate(policyapi, req.Msg.SnapshotPolicy)
+	if err != nil {
+		var errField *FieldTypeError
+		if errors.As(err, &errField) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to update snapshot policy: %w", errField))
+		}
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to apply update to snapshot policy: %w", err))
+	}
+
+	policydb, err = s.converter.FromAPIToDB(policyapi)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map snapshot policy: %w", err))
+	}
+
+	if err := validateSnapshotPolicy(policydb); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// NOTE: We use Select as Updates skips zero values, and a retention count
+	// may well be updated to zero.
+	_, err = gorm.G[*database.SnapshotPolicy](s.database).
+		Where("id = ?", policydb.ID).
+		Select("struct", "schedule", "keep_last", "keep_daily", "keep_weekly").
+		Updates(ctx, policydb)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update snapshot policy in database: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.UpdateSnapshotPolicyResponse{SnapshotPolicy: policyapi}), nil
+}
+
+func (s *SnapshotPolicyService) DeleteSnapshotPolicy(ctx context.Context, req *connect.Request[zfsilov1.DeleteSnapshotPolicyRequest]) (*connect.Response[zfsilov1.DeleteSnapshotPolicyResponse], error) {
+	_, err := gorm.G[*database.SnapshotPolicy](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("snapshot policy does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get snapshot policy: %w", err))
+	}
+
+	// Check if snapshot policy is attached to any volumes.
+	count, err := gorm.G[*database.Volume](s.database).Where("snapshot_policy = ?", req.Msg.Id).Count(ctx, "id")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check volume references: %w", err))
+	}
+	if count > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("snapshot policy is still attached to %d volumes", count))
+	}
+
+	err = s.database.Transaction(func(tx *gorm.DB) error {
+		_, err := gorm.G[*database.SnapshotPolicyRun](tx).Where("snapshot_policy_id = ?", req.Msg.Id).Delete(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = gorm.G[*database.SnapshotPolicy](tx).Where("id = ?", req.Msg.Id).Delete(ctx)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete snapshot policy: %w", err))
+	}
+
+	return connect.NewResponse(&zfsilov1.DeleteSnapshotPolicyResponse{}), nil
+}
+
+func (s *SnapshotPolicyService) ListSnapshotPolicyRuns(ctx context.Context, req *connect.Request[zfsilov1.ListSnapshotPolicyRunsRequest]) (*connect.Response[zfsilov1.ListSnapshotPolicyRunsResponse], error) {
+	// Determine the offset and limit parameters.
+	var offset, limit int
+
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = listSnapshotPolicyRunsDefaultPageSize
+	}
+	if pageSize > listSnapshotPolicyRunsMaxPageSize {
+		pageSize = listSnapshotPolicyRunsMaxPageSize
+	}
+
+	if req.Msg.PageToken == "" {
+		offset = 0
+		limit = pageSize
+	} else {
+		pageToken, err := UnmarshalPageToken(req.Msg.PageToken)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to unmarshal page token: %w", err))
+		}
+		offset = pageToken.Offset
+		limit = pageToken.Limit
+	}
+
+	// Execute the database query.
+	query := gorm.G[*database.SnapshotPolicyRun](s.database).Order("run_time desc")
+	if req.Msg.SnapshotPolicyId != "" {
+		query = query.Where("snapshot_policy_id = ?", req.Msg.SnapshotPolicyId)
+	}
+	if req.Msg.VolumeId != "" {
+		query = query.Where("volume_id = ?", req.Msg.VolumeId)
+	}
+	rundbs, err := query.
+		Offset(offset).
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get snapshot policy runs from database: %w", err))
+	}
+
+	// Convert database models to API models.
+	runapis, err := s.converter.RunFromDBToAPIList(rundbs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to map database snapshot policy runs to API: %w", err))
+	}
+
+	// Determine the next page token.
+	var nextPageTokenString string
+	if len(runapis) == limit {
+		nextPageToken := PageToken{
+			Offset: offset + len(runapis),
+			Limit:  limit,
+		}
+		tokenStr, err := nextPageToken.Marshal()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to marshal next page token: %w", err))
+		}
+		nextPageTokenString = tokenStr
+	}
+
+	return connect.NewResponse(&zfsilov1.ListSnapshotPolicyRunsResponse{
+		SnapshotPolicyRuns: runapis,
+		NextPageToken:      nextPageTokenString,
+	}), nil
+}
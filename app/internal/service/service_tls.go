@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jovulic/zfsilo/app/internal/command/nvmeof"
+	"github.com/jovulic/zfsilo/app/internal/database"
+	"gorm.io/gorm"
+)
+
+// getTLSKey returns the TLS pre-shared key the client host connects to the
+// volume on the server host with, or an empty key when the volume is not
+// served over TLS. A key is generated for each pair of server and client host
+// the first time it is needed, and is kept on the server host from then on.
+func getTLSKey(ctx context.Context, db *gorm.DB, transport database.VolumeTransport, serverHost *database.Host, clientHost string) (string, error) {
+	if transport.Type != database.VolumeTransportTypeNVMEOF_TCP || transport.NVMEOF == nil || !transport.NVMEOF.TLS {
+		return "", nil
+	}
+	if key := serverHost.TLSKey(clientHost); key != "" {
+		return key, nil
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// We read the server host again as a key may have been generated for
+		// the pair, or for another client host, in the meantime.
+		hostdb, err := gorm.G[*database.Host](tx).Where("id = ?", serverHost.ID).First(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get server host: %w", err)
+		}
+		if hostdb.TLSKey(clientHost) == "" {
+			key, err := nvmeof.GenerateTLSKey()
+			if err != nil {
+				return err
+			}
+			hostdb.SetTLSKey(clientHost, key)
+			_, err = gorm.G[*database.Host](tx).
+				Where("id = ?", hostdb.ID).
+				Select("tls_keys").
+				Updates(ctx, hostdb)
+			if err != nil {
+				return fmt.Errorf("failed to update server host in database: %w", err)
+			}
+		}
+		serverHost.TLSKeys = hostdb.TLSKeys
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get tls key: %w", err)
+	}
+	return serverHost.TLSKey(clientHost), nil
+}
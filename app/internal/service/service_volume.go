@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,11 +19,13 @@ import (
 	"github.com/jovulic/zfsilo/app/internal/command/iscsi"
 	"github.com/jovulic/zfsilo/app/internal/command/literal"
 	"github.com/jovulic/zfsilo/app/internal/command/mount"
+	"github.com/jovulic/zfsilo/app/internal/command/nfs"
 	"github.com/jovulic/zfsilo/app/internal/command/nvmeof"
 	"github.com/jovulic/zfsilo/app/internal/command/zfs"
 	converteriface "github.com/jovulic/zfsilo/app/internal/converter/iface"
 	"github.com/jovulic/zfsilo/app/internal/database"
 	libcommand "github.com/jovulic/zfsilo/lib/command"
+	"github.com/jovulic/zfsilo/lib/zfsprop"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
@@ -97,6 +102,55 @@ func applyVolumeUpdate(
 				})
 			}
 			existingVolume.Options = newOptions
+		case "snapshot_policy":
+			switch kind := value.GetKind().(type) {
+			case *structpb.Value_StringValue:
+				existingVolume.SnapshotPolicy = &kind.StringValue
+			case *structpb.Value_NullValue:
+				existingVolume.SnapshotPolicy = nil
+			default:
+				return &FieldTypeError{
+					FieldName:    key,
+					ExpectedType: "string",
+					ActualType:   fmt.Sprintf("%T", value.GetKind()),
+				}
+			}
+		case "mount_options":
+			listValue, ok := value.GetKind().(*structpb.Value_ListValue)
+			if !ok {
+				return &FieldTypeError{
+					FieldName:    key,
+					ExpectedType: "list",
+					ActualType:   fmt.Sprintf("%T", value.GetKind()),
+				}
+			}
+
+			newMountOptions := make([]string, 0, len(listValue.ListValue.Values))
+			for i, v := range listValue.ListValue.Values {
+				stringValue, ok := v.GetKind().(*structpb.Value_StringValue)
+				if !ok {
+					return &FieldTypeError{
+						FieldName:    fmt.Sprintf("%s[%d]", key, i),
+						ExpectedType: "string",
+						ActualType:   fmt.Sprintf("%T", v.GetKind()),
+					}
+				}
+				newMountOptions = append(newMountOptions, stringValue.StringValue)
+			}
+			existingVolume.MountOptions = newMountOptions
+		case "standby_host":
+			switch kind := value.GetKind().(type) {
+			case *structpb.Value_StringValue:
+				existingVolume.StandbyHost = &kind.StringValue
+			case *structpb.Value_NullValue:
+				existingVolume.StandbyHost = nil
+			default:
+				return &FieldTypeError{
+					FieldName:    key,
+					ExpectedType: "string",
+					ActualType:   fmt.Sprintf("%T", value.GetKind()),
+				}
+			}
 		default:
 			// Silently ignore immutable, read-only, or unknown fields.
 			// skip
@@ -106,6 +160,19 @@ func applyVolumeUpdate(
 	return nil
 }
 
+// errVolumeNotPublished is returned when the server host of a volume is not
+// known. An unpublished volume keeps its ZFS volume, but not the host it is on.
+var errVolumeNotPublished = errors.New("volume is not published")
+
+// requireServerHost returns errVolumeNotPublished unless the server host of
+// the volume, which its ZFS volume is operated on through, is known.
+func requireServerHost(volumedb *database.Volume) error {
+	if volumedb.ServerHost == "" {
+		return errVolumeNotPublished
+	}
+	return nil
+}
+
 func getTargetID(host *database.Host, transport database.VolumeTransport, volumeID string) (string, error) {
 	switch transport.Type {
 	case database.VolumeTransportTypeISCSI:
@@ -119,12 +186,17 @@ func getTargetID(host *database.Host, transport database.VolumeTransport, volume
 	}
 }
 
-func getClientID(host *database.Host, transport database.VolumeTransport) (string, error) {
+// getClientID returns the initiator the client host connects to the volume
+// with. Over NVMe-oF, it is an NQN of the host for the volume, as nvmet keeps
+// the DH-HMAC-CHAP keys of each host NQN across all subsystems.
+func getClientID(host *database.Host, transport database.VolumeTransport, volumeID string) (string, error) {
 	switch transport.Type {
 	case database.VolumeTransportTypeISCSI:
 		return host.IQN()
 	case database.VolumeTransportTypeNVMEOF_TCP:
-		return host.NQN()
+		return host.VolumeNQN(volumeID)
+	case database.VolumeTransportTypeNFS:
+		return host.Address()
 	case database.VolumeTransportTypeUNSPECIFIED:
 		fallthrough
 	default:
@@ -136,6 +208,470 @@ func getServerConnection(host *database.Host) (string, string) {
 	return host.Role.Data().Server.Endpoint, host.Key
 }
 
+// generateCredentials sets newly generated CHAP credentials on the iSCSI or
+// NVMe-oF transport of a volume.
+func generateCredentials(transport *database.VolumeTransport) error {
+	targetPassword, err := database.GenerateCHAPSecret()
+	if err != nil {
+		return err
+	}
+	initiatorPassword, err := database.GenerateCHAPSecret()
+	if err != nil {
+		return err
+	}
+	switch {
+	case transport.ISCSI != nil:
+		transport.ISCSI.TargetPassword = targetPassword
+		transport.ISCSI.InitiatorPassword = initiatorPassword
+	case transport.NVMEOF != nil:
+		transport.NVMEOF.TargetPassword = targetPassword
+		transport.NVMEOF.InitiatorPassword = initiatorPassword
+	default:
+		return fmt.Errorf("unsupported transport for credentials: %v", transport.Type)
+	}
+	return nil
+}
+
+// getInitiatorPassword returns the password the client host authenticates to
+// the target of the volume with.
+func getInitiatorPassword(transport database.VolumeTransport, clientHost *database.Host) string {
+	if transport.ISCSI != nil && transport.ISCSI.InitiatorPassword != "" {
+		return transport.ISCSI.InitiatorPassword
+	}
+	if transport.NVMEOF != nil && transport.NVMEOF.InitiatorPassword != "" {
+		return transport.NVMEOF.InitiatorPassword
+	}
+	return clientHost.Key
+}
+
+// isHostNQN returns whether the initiator is the NQN of the client host, as
+// it is for attachments connected before each volume had an NQN of its own.
+func isHostNQN(initiator string, clientHost *database.Host) bool {
+	nqn, err := clientHost.NQN()
+	return err == nil && nqn == initiator
+}
+
+// getHostID returns the host identifier the client host connects to the
+// subsystem of an NVMe-oF volume with. An attachment that connects with the
+// NQN of its host leaves nvme-cli to use the identifier of the host.
+func getHostID(initiator string, clientHost *database.Host) string {
+	if isHostNQN(initiator, clientHost) {
+		return ""
+	}
+	return nvmeof.HostID(nvmeof.NQN(initiator))
+}
+
+// getNVMeOFPorts returns the ports subsystems are served on by the server
+// host, over TLS or not.
+func getNVMeOFPorts(host *database.Host, tls bool) []nvmeof.Port {
+	var ports []nvmeof.Port
+	for _, port := range host.NVMeOFPorts() {
+		if port.TLS != tls {
+			continue
+		}
+		ports = append(ports, nvmeof.Port{
+			ID:        port.ID,
+			Address:   port.Address,
+			ServiceID: port.ServiceID,
+			TLS:       port.TLS,
+		})
+	}
+	return ports
+}
+
+// getNVMeOFAddresses returns the addresses clients connect to subsystems on the
+// server host through, over TLS or not.
+func getNVMeOFAddresses(host *database.Host, tls bool) ([]string, error) {
+	addresses := host.NVMeOFAddresses(tls)
+	if len(addresses) == 0 {
+		if tls {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("server host has no NVMe-oF ports with TLS"))
+		}
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("server host has no NVMe-oF ports without TLS"))
+	}
+	return addresses, nil
+}
+
+// getBindAddresses returns the addresses a target served on the addresses is
+// bound to. A target served on a single address listens on every address of
+// the host instead, which lets the address be a hostname.
+func getBindAddresses(addresses []string) []string {
+	if len(addresses) < 2 {
+		return nil
+	}
+	return addresses
+}
+
+// getClientAddresses returns the addresses of the client hosts, which make up
+// the access list of an NFS export.
+func getClientAddresses(hosts []*database.Host) ([]string, error) {
+	addresses := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		address, err := host.Address()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get address of host %s: %w", host.Name, err)
+		}
+		if !slices.Contains(addresses, address) {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses, nil
+}
+
+// getExportPath returns the path the ZFS filesystem of a dataset volume is
+// mounted at on the server, which is the path it is exported at. A filesystem
+// that was received unmounted is mounted first.
+func getExportPath(ctx context.Context, executor libcommand.Executor, volumedb *database.Volume) (string, error) {
+	err := zfs.With(executor).MountFilesystem(ctx, zfs.MountFilesystemArguments{
+		Name: volumedb.DatasetID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to mount zfs filesystem: %w", err)
+	}
+	path, err := zfs.With(executor).GetProperty(ctx, zfs.GetPropertyArguments{
+		Name:        volumedb.DatasetID,
+		PropertyKey: "mountpoint",
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get zfs filesystem mountpoint: %w", err)
+	}
+	if !strings.HasPrefix(path, "/") {
+		return "", fmt.Errorf("zfs filesystem is not mounted at a path: %s", path)
+	}
+	return path, nil
+}
+
+// getReadOnlyClients returns the addresses of the client hosts the export of
+// a volume refuses writes to, which are those attached read-only.
+func getReadOnlyClients(volumedb *database.Volume) []string {
+	var addresses []string
+	for _, attachment := range volumedb.Attachments {
+		if attachment.ReadOnly && !slices.Contains(addresses, attachment.Initiator) {
+			addresses = append(addresses, attachment.Initiator)
+		}
+	}
+	return addresses
+}
+
+// getStageMountArguments returns the arguments for mounting a volume to the
+// staging path of the attachment. Block volumes are mounted from the device on
+// the client, while dataset volumes are mounted from their NFS export.
+func getStageMountArguments(volumedb *database.Volume, attachment *database.VolumeAttachment, devicePath string) mount.MountArguments {
+	mountArgs := mount.MountArguments{
+		SourcePath: devicePath,
+		TargetPath: attachment.StagingPath,
+	}
+	switch volumedb.Mode {
+	case database.VolumeModeFILESYSTEM:
+		mountArgs.FSType = volumedb.FSType.Name()
+		mountArgs.Options = []string{"defaults"}
+		if len(volumedb.MountOptions) > 0 {
+			mountArgs.Options = slices.Clone(volumedb.MountOptions)
+		}
+	case database.VolumeModeDATASET:
+		transport := volumedb.Transport.Data()
+		mountArgs.SourcePath = nfs.MountSource(transport.NFS.ServerAddress, transport.NFS.ExportPath)
+		mountArgs.FSType = "nfs"
+		mountArgs.Options = append([]string{"nfsvers=4"}, volumedb.MountOptions...)
+	case database.VolumeModeBLOCK, database.VolumeModeUNSPECIFIED:
+		fallthrough
+	default:
+		mountArgs.Options = []string{"bind"}
+	}
+	if attachment.ReadOnly {
+		mountArgs.Options = append(mountArgs.Options, "ro")
+	}
+	return mountArgs
+}
+
+// isReadOnlyTargetPath returns whether the volume is mounted read-only at the
+// target path, which it always is when the attachment is read-only.
+func isReadOnlyTargetPath(attachment *database.VolumeAttachment, targetPath string) bool {
+	return attachment.ReadOnly || slices.Contains(attachment.ReadOnlyTargetPaths, targetPath)
+}
+
+// getTargetMountArguments returns the arguments for bind mounting a volume
+// staged by the attachment to the target path.
+func getTargetMountArguments(attachment *database.VolumeAttachment, targetPath string) mount.MountArguments {
+	mountArgs := mount.MountArguments{
+		SourcePath: attachment.StagingPath,
+		TargetPath: targetPath,
+		Options:    []string{"bind"},
+	}
+	if isReadOnlyTargetPath(attachment, targetPath) {
+		mountArgs.Options = append(mountArgs.Options, "ro")
+	}
+	return mountArgs
+}
+
+var (
+	mkfsOptionPattern  = regexp.MustCompile(`^[^'\s]+$`)
+	mountOptionPattern = regexp.MustCompile(`^[a-zA-Z0-9_.=:/@+-]+$`)
+)
+
+// validateVolumeFilesystem checks that the filesystem type and the mkfs and
+// mount options apply to the mode of the volume, and that the options can be
+// passed on to the commands.
+func validateVolumeFilesystem(volumedb *database.Volume) error {
+	if volumedb.Mode != database.VolumeModeFILESYSTEM {
+		if volumedb.FSType != database.VolumeFSTypeUNSPECIFIED {
+			return errors.New("filesystem type only applies to a filesystem volume")
+		}
+		if len(volumedb.MkfsOptions) > 0 {
+			return errors.New("mkfs options only apply to a filesystem volume")
+		}
+	}
+	if volumedb.Mode == database.VolumeModeBLOCK && len(volumedb.MountOptions) > 0 {
+		return errors.New("mount options do not apply to a block volume")
+	}
+	for _, option := range volumedb.MkfsOptions {
+		if !mkfsOptionPattern.MatchString(option) {
+			return fmt.Errorf("invalid mkfs option '%s'", option)
+		}
+	}
+	for _, option := range volumedb.MountOptions {
+		if !mountOptionPattern.MatchString(option) {
+			return fmt.Errorf("invalid mount option '%s'", option)
+		}
+	}
+	return nil
+}
+
+// prepareStageDevice prepares the device of a volume on the client of the
+// attachment to be staged. The device of a read-only attachment is marked
+// read-only as well, so that the client does not attempt writes the target
+// would refuse.
+func prepareStageDevice(ctx context.Context, executor libcommand.Executor, volumedb *database.Volume, attachment *database.VolumeAttachment, devicePath string) error {
+	if attachment.ReadOnly {
+		if err := fs.With(executor).SetReadOnly(ctx, devicePath); err != nil {
+			return err
+		}
+	}
+	if volumedb.Mode == database.VolumeModeFILESYSTEM {
+		return prepareStageFilesystem(ctx, executor, volumedb, attachment, devicePath)
+	}
+	return nil
+}
+
+// prepareStageFilesystem formats the device of a filesystem volume when it
+// does not hold a filesystem yet. A device holding a filesystem other than the
+// one of the volume is refused rather than mounted as whatever it holds.
+func prepareStageFilesystem(ctx context.Context, executor libcommand.Executor, volumedb *database.Volume, attachment *database.VolumeAttachment, devicePath string) error {
+	fsType, err := fs.With(executor).GetFSType(ctx, devicePath)
+	if err != nil {
+		return fmt.Errorf("failed to get filesystem type: %w", err)
+	}
+
+	switch fsType {
+	case "":
+		if attachment.ReadOnly {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("device %s holds no filesystem and cannot be formatted when connected read-only", devicePath))
+		}
+		err = fs.With(executor).Format(ctx, fs.FormatArguments{
+			Device:        devicePath,
+			WaitForDevice: false,
+			Type:          volumedb.FSType.Name(),
+			Options:       volumedb.MkfsOptions,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to format device: %w", err)
+		}
+		return nil
+	case volumedb.FSType.Name():
+		return nil
+	default:
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("device %s holds a %s filesystem rather than %s", devicePath, fsType, volumedb.FSType.Name()))
+	}
+}
+
+// volumeDatasetType returns the type of the ZFS dataset backing a volume of
+// the mode.
+func volumeDatasetType(mode database.VolumeMode) zfsprop.DatasetType {
+	if mode == database.VolumeModeDATASET {
+		return zfsprop.DatasetTypeFilesystem
+	}
+	return zfsprop.DatasetTypeVolume
+}
+
+// validateVolumeOptions checks the options of the volume against the ZFS
+// property catalogue. On an existing volume only the options that differ from
+// its previous options are checked, as they are the ones being set.
+func validateVolumeOptions(volumedb *database.Volume, previous database.VolumeOptionList, create bool) error {
+	datasetType := volumeDatasetType(volumedb.Mode)
+	seen := make(map[string]bool)
+	for _, option := range volumedb.Options.Data() {
+		if seen[option.Key] {
+			return fmt.Errorf("property '%s' is given more than once", option.Key)
+		}
+		seen[option.Key] = true
+
+		if slices.Contains(previous, option) {
+			continue
+		}
+		if err := zfsprop.Validate(option.Key, option.Value, datasetType, create); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setZFSVolumeOptions sets the options of the volume on its ZFS volume. The
+// options that can only be set when it is created are skipped.
+func setZFSVolumeOptions(ctx context.Context, executor libcommand.Executor, volumedb *database.Volume) error {
+	for _, opt := range volumedb.Options.Data() {
+		if property, ok := zfsprop.Lookup(opt.Key); ok && property.CreateOnly {
+			continue
+		}
+		err := zfs.With(executor).SetProperty(ctx, zfs.SetPropertyArguments{
+			Name:          volumedb.DatasetID,
+			PropertyKey:   opt.Key,
+			PropertyValue: opt.Value,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set volume property %s: %w", opt.Key, err)
+		}
+	}
+	return nil
+}
+
+// createZFSVolume creates the ZFS volume backing the volume. When the volume
+// has a source snapshot, or a source volume, the ZFS volume is cloned from it
+// instead, in which case snapshotdb must be the source snapshot.
+func createZFSVolume(
+	ctx context.Context,
+	tx *gorm.DB,
+	executor libcommand.Executor,
+	volumedb *database.Volume,
+	snapshotdb *database.Snapshot,
+) error {
+	opts := make(map[string]string)
+	for _, option := range volumedb.Options.Data() {
+		opts[option.Key] = option.Value
+	}
+
+	var key []byte
+	if volumedb.Encrypted && volumedb.SourceSnapshotID() == "" {
+		var err error
+		key, err = volumedb.RawEncryptionKey()
+		if err != nil {
+			return err
+		}
+	}
+
+	if volumedb.SourceSnapshotID() == "" && volumedb.Mode == database.VolumeModeDATASET {
+		// A dataset is bounded by its quota, and reserves it unless sparse.
+		opts["refquota"] = fmt.Sprintf("%d", volumedb.CapacityBytes)
+		if !volumedb.Sparse {
+			opts["refreservation"] = fmt.Sprintf("%d", volumedb.CapacityBytes)
+		}
+		err := zfs.With(executor).CreateFilesystem(ctx, zfs.CreateFilesystemArguments{
+			Name:    volumedb.DatasetID,
+			Options: opts,
+			Key:     key,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create zfs filesystem: %w", err)
+		}
+		return nil
+	}
+
+	if volumedb.SourceSnapshotID() == "" {
+		err := zfs.With(executor).CreateVolume(ctx, zfs.CreateVolumeArguments{
+			Name:    volumedb.DatasetID,
+			Size:    uint64(volumedb.CapacityBytes),
+			Options: opts,
+			Sparse:  volumedb.Sparse,
+			Key:     key,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create zfs volume: %w", err)
+		}
+		return nil
+	}
+
+	if snapshotdb == nil || snapshotdb.ID != volumedb.SourceSnapshotID() {
+		return fmt.Errorf("source snapshot %s was not provided", volumedb.SourceSnapshotID())
+	}
+
+	// A clone is encrypted under the key of its source, which has to be
+	// loaded to clone it.
+	if err := loadZFSKey(ctx, executor, volumedb, snapshotdb.DatasetID); err != nil {
+		return err
+	}
+
+	err := zfs.With(executor).CloneSnapshot(ctx, zfs.CloneSnapshotArguments{
+		Snapshot: snapshotdb.DatasetID,
+		Name:     volumedb.DatasetID,
+		Options:  opts,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to clone zfs snapshot: %w", err)
+	}
+
+	// The clone inherits the size of the snapshot, so we grow it if the volume
+	// was requested with a larger capacity. A dataset clone does not carry the
+	// quota of its origin at all.
+	if volumedb.CapacityBytes > snapshotdb.CapacityBytes || volumedb.Mode == database.VolumeModeDATASET {
+		err = zfs.With(executor).SetProperty(ctx, zfs.SetPropertyArguments{
+			Name:          volumedb.DatasetID,
+			PropertyKey:   volumedb.SizeProperty(),
+			PropertyValue: fmt.Sprintf("%d", volumedb.CapacityBytes),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to grow cloned zfs volume: %w", err)
+		}
+	}
+
+	// Clones do not carry a reservation, so we add one back for volumes that
+	// are not sparse.
+	if !volumedb.Sparse {
+		reservation := "auto"
+		if volumedb.Mode == database.VolumeModeDATASET {
+			reservation = fmt.Sprintf("%d", volumedb.CapacityBytes)
+		}
+		err = zfs.With(executor).SetProperty(ctx, zfs.SetPropertyArguments{
+			Name:          volumedb.DatasetID,
+			PropertyKey:   "refreservation",
+			PropertyValue: reservation,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to reserve cloned zfs volume: %w", err)
+		}
+	}
+
+	if !volumedb.Promote {
+		return nil
+	}
+
+	originDatasetID, _, _ := strings.Cut(snapshotdb.DatasetID, "@")
+	err = zfs.With(executor).PromoteClone(ctx, zfs.PromoteCloneArguments{
+		Name: volumedb.DatasetID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to promote cloned zfs volume: %w", err)
+	}
+
+	// Promotion moves the origin snapshot, and any that precede it, over to the
+	// promoted volume. We follow them so the snapshots remain addressable.
+	names, err := zfs.With(executor).ListSnapshots(ctx, zfs.ListSnapshotsArguments{
+		Name: volumedb.DatasetID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list promoted zfs snapshots: %w", err)
+	}
+	for _, name := range names {
+		_, snapshotName, _ := strings.Cut(name, "@")
+		_, err := gorm.G[*database.Snapshot](tx).
+			Where("dataset_id = ?", database.BuildSnapshotDatasetID(originDatasetID, snapshotName)).
+			Update(ctx, "dataset_id", name)
+		if err != nil {
+			return fmt.Errorf("failed to update promoted snapshot %s in database: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
 func indexOf(slice []string, val string) int {
 	for i, item := range slice {
 		if item == val {
@@ -148,23 +684,35 @@ func indexOf(slice []string, val string) int {
 type VolumeService struct {
 	zfsilov1connect.UnimplementedVolumeServiceHandler
 
-	database        *gorm.DB
-	converter       converteriface.VolumeConverter
-	executorFactory *command.ExecutorFactory
-	syncer          *VolumeSyncer
+	database               *gorm.DB
+	converter              converteriface.VolumeConverter
+	snapshotConverter      converteriface.SnapshotConverter
+	snapshotGroupConverter converteriface.SnapshotGroupConverter
+	executorFactory        *command.ExecutorFactory
+	syncer                 *VolumeSyncer
+	replicator             *Replicator
+	exporter               *Exporter
 }
 
 func NewVolumeService(
 	database *gorm.DB,
 	converter converteriface.VolumeConverter,
+	snapshotConverter converteriface.SnapshotConverter,
+	snapshotGroupConverter converteriface.SnapshotGroupConverter,
 	executorFactory *command.ExecutorFactory,
 	syncer *VolumeSyncer,
+	replicator *Replicator,
+	exporter *Exporter,
 ) *VolumeService {
 	return &VolumeService{
-		database:        database,
-		converter:       converter,
-		executorFactory: executorFactory,
-		syncer:          syncer,
+		database:               database,
+		converter:              converter,
+		snapshotConverter:      snapshotConverter,
+		snapshotGroupConverter: snapshotGroupConverter,
+		executorFactory:        executorFactory,
+		syncer:                 syncer,
+		replicator:             replicator,
+		exporter:               exporter,
 	}
 }
 
@@ -259,21 +807,178 @@ func (s *VolumeService) CreateVolume(ctx context.Context, req *connect.Request[z
 
 	volumedb.Status = database.VolumeStatusINITIAL
 
+	if err := validateVolumeOptions(volumedb, nil, true); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validateVolumeFilesystem(volumedb); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// keySource is the volume a cloned volume shares its encryption key with.
+	var keySource *database.Volume
+
+	// Verify the source snapshot exists and fits in the volume.
+	if volumedb.SourceSnapshot != "" {
+		snapshotdb, err := gorm.G[*database.Snapshot](s.database).Where("id = ?", volumedb.SourceSnapshot).First(ctx)
+		switch {
+		case err == nil:
+			// okay
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("source snapshot does not exist"))
+		default:
+			return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get source snapshot: %w", err))
+		}
+		if volumedb.CapacityBytes < snapshotdb.CapacityBytes {
+			return nil, connect.NewError(connect.CodeOutOfRange, fmt.Errorf("volume capacity must be at least the source snapshot capacity of %d bytes", snapshotdb.CapacityBytes))
+		}
+		keySource, err = gorm.G[*database.Volume](s.database).Where("id = ?", snapshotdb.VolumeID).First(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get source snapshot volume: %w", err))
+		}
+	}
+
+	// Verify the snapshot policy exists.
+	if volumedb.SnapshotPolicy != "" {
+		_, err := gorm.G[*database.SnapshotPolicy](s.database).Where("id = ?", volumedb.SnapshotPolicy).First(ctx)
+		switch {
+		case err == nil:
+			// okay
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("snapshot policy does not exist"))
+		default:
+			return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get snapshot policy: %w", err))
+		}
+	}
+
+	// Verify the standby host can keep a copy of the volume.
+	if volumedb.StandbyHost != "" {
+		if err := s.validateStandbyHost(ctx, volumedb); err != nil {
+			return nil, err
+		}
+	}
+
+	// Verify the source volume exists and fits in the volume. The source has to
+	// have been published for its ZFS volume to exist, which is where we take
+	// the snapshot the volume is cloned from.
+	var sourcedb *database.Volume
+	if volumedb.SourceVolume != "" {
+		if volumedb.SourceSnapshot != "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume cannot have both a source snapshot and a source volume"))
+		}
+		sourcedb, err = gorm.G[*database.Volume](s.database).Where("id = ?", volumedb.SourceVolume).First(ctx)
+		switch {
+		case err == nil:
+			// okay
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("source volume does not exist"))
+		default:
+			return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get source volume: %w", err))
+		}
+		if sourcedb.ServerHost == "" {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("source volume is not published"))
+		}
+		if volumedb.Mode != sourcedb.Mode {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume mode must match the source volume mode"))
+		}
+		if volumedb.CapacityBytes < sourcedb.CapacityBytes {
+			return nil, connect.NewError(connect.CodeOutOfRange, fmt.Errorf("volume capacity must be at least the source volume capacity of %d bytes", sourcedb.CapacityBytes))
+		}
+		keySource = sourcedb
+	}
+
+	// A clone is encrypted with the key of its source, otherwise an encrypted
+	// volume gets a key of its own.
+	switch {
+	case keySource != nil:
+		if volumedb.Encrypted != keySource.Encrypted {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume encryption must match the source volume encryption"))
+		}
+		volumedb.EncryptionKey = keySource.EncryptionKey
+	case volumedb.Encrypted:
+		volumedb.EncryptionKey, err = database.GenerateEncryptionKey()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	// A clone holds the filesystem of its source rather than being formatted,
+	// so it takes on the filesystem type of its source.
+	if volumedb.Mode == database.VolumeModeFILESYSTEM {
+		switch {
+		case keySource != nil && volumedb.FSType == database.VolumeFSTypeUNSPECIFIED:
+			volumedb.FSType = keySource.FSType
+		case keySource != nil && volumedb.FSType.Name() != keySource.FSType.Name():
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume filesystem type must match the source volume filesystem type"))
+		}
+		if volumedb.FSType == database.VolumeFSTypeUNSPECIFIED {
+			volumedb.FSType = database.VolumeFSTypeEXT4
+		}
+	}
+
 	err = s.database.Transaction(func(tx *gorm.DB) error {
 		// Create database entry.
 		err := gorm.G[*database.Volume](tx).Create(ctx, &volumedb)
 		if err != nil {
 			return err
 		}
+
+		// The standby copy is kept by a replication that starts syncing once
+		// the volume is published.
+		if volumedb.StandbyHost != "" {
+			repldb := buildStandbyReplication(volumedb)
+			err = gorm.G[*database.Replication](tx).Create(ctx, &repldb)
+			if err != nil {
+				return err
+			}
+		}
+
+		if sourcedb == nil {
+			return nil
+		}
+
+		// Take a hidden snapshot of the source volume for the volume to be
+		// cloned from. It is tracked so that the source volume cannot be
+		// destroyed while the volume depends on it.
+		snapshotID := volumedb.SourceSnapshotID()
+		snapshotdb := &database.Snapshot{
+			ID:            snapshotID,
+			Name:          fmt.Sprintf("snapshots/%s", snapshotID),
+			VolumeID:      sourcedb.ID,
+			DatasetID:     database.BuildSnapshotDatasetID(sourcedb.DatasetID, snapshotID),
+			CapacityBytes: sourcedb.CapacityBytes,
+			ServerHost:    sourcedb.ServerHost,
+			Hidden:        true,
+		}
+		err = gorm.G[*database.Snapshot](tx).Create(ctx, &snapshotdb)
+		if err != nil {
+			return err
+		}
+
+		executor, _, err := s.getExecutorForHost(ctx, snapshotdb.ServerHost)
+		if err != nil {
+			return err
+		}
+		err = zfs.With(executor).CreateSnapshot(ctx, zfs.CreateSnapshotArguments{
+			Name: snapshotdb.DatasetID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create zfs snapshot of source volume: %w", err)
+		}
 		return nil
 	})
 	if err != nil {
+		if code := connect.CodeOf(err); code != connect.CodeUnknown {
+			return nil, err
+		}
 		// Check for specific database errors to return correct connect codes.
 		// NOTE: GORM with SQLite may not always return ErrDuplicatedKey as a
 		// wrapped error, so we also check the message.
 		if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "UNIQUE constraint failed") {
 			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("volume already exists"))
 		}
+		if strings.Contains(err.Error(), "dataset does not exist") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("source volume dataset does not exist: %w", err))
+		}
 		// Return internal error for other DB errors.
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create volume: %w", err))
 	}
@@ -303,6 +1008,9 @@ func (s *VolumeService) UpdateVolume(ctx context.Context, req *connect.Request[z
 		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
 	}
 
+	previousStandbyHost := volumedb.StandbyHost
+	previousOptions := volumedb.Options.Data()
+
 	volumeapi, err := s.converter.FromDBToAPI(volumedb)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
@@ -314,12 +1022,39 @@ func (s *VolumeService) UpdateVolume(ctx context.Context, req *connect.Request[z
 		if errors.As(err, &errField) {
 			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to update volume: %w", errField))
 		}
-		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to apply update to volume: %w", err))
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to apply update to volume: %w", err))
+	}
+
+	volumedb, err = s.converter.FromAPIToDB(volumeapi)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
+	}
+
+	if err := validateVolumeOptions(volumedb, previousOptions, false); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validateVolumeFilesystem(volumedb); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Verify the snapshot policy exists when one is being attached.
+	if volumedb.SnapshotPolicy != "" {
+		_, err := gorm.G[*database.SnapshotPolicy](s.database).Where("id = ?", volumedb.SnapshotPolicy).First(ctx)
+		switch {
+		case err == nil:
+			// okay
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("snapshot policy does not exist"))
+		default:
+			return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get snapshot policy: %w", err))
+		}
 	}
 
-	volumedb, err = s.converter.FromAPIToDB(volumeapi)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
+	// Verify the standby host can keep a copy of the volume when it changes.
+	if volumedb.StandbyHost != "" && volumedb.StandbyHost != previousStandbyHost {
+		if err := s.validateStandbyHost(ctx, volumedb); err != nil {
+			return nil, err
+		}
 	}
 
 	// NOTE: We do not perform the update in a transaction as we have not written
@@ -330,94 +1065,75 @@ func (s *VolumeService) UpdateVolume(ctx context.Context, req *connect.Request[z
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update volume in database: %w", err))
 	}
 
-	// If the volume has a publish host, we update the ZFS properties.
-	if volumedb.ServerHost != "" {
-		executor, _, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
+	// Updates skips zero values, so detaching the snapshot policy is written
+	// separately.
+	if _, ok := req.Msg.Volume.GetFields()["snapshot_policy"]; ok && volumedb.SnapshotPolicy == "" {
+		_, err = gorm.G[*database.Volume](s.database).Where("id = ?", volumedb.ID).Update(ctx, "snapshot_policy", "")
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get producer executor: %w", err))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to detach snapshot policy in database: %w", err))
 		}
+	}
 
-		// We update the size of the volume by zfs set.
-		err = zfs.With(executor).SetProperty(ctx, zfs.SetPropertyArguments{
-			Name:          volumedb.DatasetID,
-			PropertyKey:   "volsize",
-			PropertyValue: fmt.Sprintf("%d", volumedb.CapacityBytes),
-		})
+	// Clearing the mount options is written separately for the same reason.
+	if _, ok := req.Msg.Volume.GetFields()["mount_options"]; ok && len(volumedb.MountOptions) == 0 {
+		_, err = gorm.G[*database.Volume](s.database).Where("id = ?", volumedb.ID).Update(ctx, "mount_options", datatypes.JSONSlice[string]{})
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update volume size on producer: %w", err))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to clear mount options in database: %w", err))
 		}
+	}
 
-		// We update the options by zfs set.
-		for _, opt := range volumedb.Options.Data() {
-			err = zfs.With(executor).SetProperty(ctx, zfs.SetPropertyArguments{
-				Name:          volumedb.DatasetID,
-				PropertyKey:   opt.Key,
-				PropertyValue: opt.Value,
-			})
+	// A new standby host starts over with a full copy of the volume.
+	if volumedb.StandbyHost != previousStandbyHost {
+		if volumedb.StandbyHost == "" {
+			_, err = gorm.G[*database.Volume](s.database).Where("id = ?", volumedb.ID).Update(ctx, "standby_host", "")
 			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update volume property %s on producer: %w", opt.Key, err))
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to clear standby host in database: %w", err))
 			}
 		}
-	}
-
-	// We check if the volume has been connected, and if it has, we need to issue
-	// a refresh on the consumer.
-	if volumedb.IsConnected() && volumedb.ClientHost != "" {
-		consumeExecutor, _, err := s.getExecutorForHost(ctx, volumedb.ClientHost)
-		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get consumer executor: %w", err))
+		if err := s.removeStandby(ctx, volumedb.ID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if volumedb.StandbyHost != "" {
+			repldb := buildStandbyReplication(volumedb)
+			err = gorm.G[*database.Replication](s.database).Create(ctx, &repldb)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create standby replication in database: %w", err))
+			}
 		}
+	}
 
-		_, publishHost, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
+	// If the volume has a publish host, we update the ZFS properties.
+	if volumedb.ServerHost != "" {
+		executor, _, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get publish host: %w", err))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get producer executor: %w", err))
 		}
 
-		transport := volumedb.Transport.Data()
-
-		targetID, err := getTargetID(publishHost, transport, volumedb.ID)
+		// We update the size of the volume by zfs set.
+		err = zfs.With(executor).SetProperty(ctx, zfs.SetPropertyArguments{
+			Name:          volumedb.DatasetID,
+			PropertyKey:   volumedb.SizeProperty(),
+			PropertyValue: fmt.Sprintf("%d", volumedb.CapacityBytes),
+		})
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get target ID: %w", err))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update volume size on producer: %w", err))
 		}
 
-		targetAddress, _ := getServerConnection(publishHost)
-
-		switch transport.Type {
-		case database.VolumeTransportTypeISCSI:
-			err = iscsi.With(consumeExecutor).RescanTarget(ctx, iscsi.RescanTargetArguments{
-				TargetIQN:     iscsi.IQN(targetID),
-				TargetAddress: targetAddress,
-			})
-		case database.VolumeTransportTypeNVMEOF_TCP:
-			err = nvmeof.With(consumeExecutor).RescanTarget(ctx, nvmeof.RescanTargetArguments{
-				TargetNQN: nvmeof.NQN(targetID),
-			})
-		case database.VolumeTransportTypeUNSPECIFIED:
-			fallthrough
-		default:
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no transport specified on volume"))
-		}
+		// We update the options by zfs set.
+		err = setZFSVolumeOptions(ctx, executor, volumedb)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to perform rescan on consumer: %w", err))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update volume options on producer: %w", err))
 		}
+	}
 
-		// If the mode is filesystem we also need to resize the filesystem.
-		if volumedb.Mode == database.VolumeModeFILESYSTEM {
-			devicePattern, err := volumedb.DevicePathClient(targetAddress, targetID)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get device path: %w", err))
-			}
-			devicePath, err := fs.With(consumeExecutor).ResolveDevice(ctx, devicePattern)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resolve device path %s: %w", devicePattern, err))
-			}
-			err = fs.With(consumeExecutor).Resize(ctx, fs.ResizeArguments{
-				Device: devicePath,
-			})
-			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to perform resize on consumer: %w", err))
-			}
+	// If the volume has been connected, the device and filesystem on the
+	// consumer are grown to the new size. The quota of a dataset applies to its
+	// clients right away.
+	if _, err := s.expandClientVolume(ctx, volumedb); err != nil {
+		if code := connect.CodeOf(err); code != connect.CodeUnknown {
+			return nil, err
 		}
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	return connect.NewResponse(&zfsilov1.UpdateVolumeResponse{Volume: volumeapi}), nil
@@ -443,6 +1159,38 @@ func (s *VolumeService) DeleteVolume(ctx context.Context, req *connect.Request[z
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is mounted"))
 	}
 
+	// Check if volume is referenced by any snapshots.
+	count, err := gorm.G[*database.Snapshot](s.database).Where("volume_id = ? AND hidden = ?", volumedb.ID, false).Count(ctx, "id")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check snapshot references: %w", err))
+	}
+	if count > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume is still referenced by %d snapshots", count))
+	}
+
+	// Check if volume is referenced by any volumes cloned from it.
+	count, err = gorm.G[*database.Volume](s.database).Where("source_volume = ?", volumedb.ID).Count(ctx, "id")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check clone references: %w", err))
+	}
+	if count > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume has %d dependent clones", count))
+	}
+
+	// Check if volume is being replicated. The standby copy goes with the
+	// volume.
+	count, err = gorm.G[*database.Replication](s.database).Where("volume_id = ? AND standby = ?", volumedb.ID, false).Count(ctx, "id")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check replication references: %w", err))
+	}
+	if count > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume is still replicated by %d replications", count))
+	}
+
+	if err := s.removeStandby(ctx, volumedb.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	err = s.database.Transaction(func(tx *gorm.DB) error {
 		// Destroy ZFS volume if it was created.
 		if volumedb.ServerHost != "" {
@@ -458,6 +1206,32 @@ func (s *VolumeService) DeleteVolume(ctx context.Context, req *connect.Request[z
 			}
 		}
 
+		// Destroy the hidden snapshot the volume was cloned from.
+		if volumedb.SourceVolume != "" {
+			snapshotdb, err := gorm.G[*database.Snapshot](tx).Where("id = ?", volumedb.SourceSnapshotID()).First(ctx)
+			switch {
+			case err == nil:
+				executor, _, err := s.getExecutorForHost(ctx, snapshotdb.ServerHost)
+				if err != nil {
+					return fmt.Errorf("failed to get producer executor: %w", err)
+				}
+				err = zfs.With(executor).DestroySnapshot(ctx, zfs.DestroySnapshotArguments{
+					Name: snapshotdb.DatasetID,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to destroy zfs snapshot of source volume: %w", err)
+				}
+				_, err = gorm.G[*database.Snapshot](tx).Where("id = ?", snapshotdb.ID).Delete(ctx)
+				if err != nil {
+					return err
+				}
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				// okay
+			default:
+				return fmt.Errorf("failed to get source volume snapshot: %w", err)
+			}
+		}
+
 		// Delete from database.
 		_, err = gorm.G[*database.Volume](tx).Where("id = ?", req.Msg.Id).Delete(ctx)
 		if err != nil {
@@ -473,6 +1247,9 @@ func (s *VolumeService) DeleteVolume(ctx context.Context, req *connect.Request[z
 		if strings.Contains(err.Error(), "dataset is busy") {
 			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset is busy: %w", err))
 		}
+		if strings.Contains(err.Error(), "has children") || strings.Contains(err.Error(), "has dependent clones") {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset has dependents: %w", err))
+		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete volume: %w", err))
 	}
 
@@ -503,17 +1280,57 @@ func (s *VolumeService) PublishVolume(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is mounted"))
 	}
 
-	// Use requested transport or default to ISCSI
+	// The standby host only takes over the volume through a failover, and a
+	// fenced host holds a stale copy of the volume until it has been fenced.
+	switch {
+	case volumedb.StandbyHost != "" && req.Msg.ServerHost == volumedb.StandbyHost:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume cannot be published on its standby host"))
+	case slices.Contains(volumedb.FencedHosts, req.Msg.ServerHost):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("server host still holds a stale copy of the volume"))
+	}
+
+	// A cloned volume has to live alongside its source snapshot.
+	var snapshotdb *database.Snapshot
+	if volumedb.SourceSnapshotID() != "" {
+		snapshotdb, err = gorm.G[*database.Snapshot](s.database).Where("id = ?", volumedb.SourceSnapshotID()).First(ctx)
+		switch {
+		case err == nil:
+			// okay
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("source snapshot does not exist"))
+		default:
+			return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get source snapshot: %w", err))
+		}
+		if snapshotdb.ServerHost != req.Msg.ServerHost {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume must be published on %s where its source snapshot resides", snapshotdb.ServerHost))
+		}
+	}
+
+	// Use requested transport or default to ISCSI, or NFS for a dataset.
 	var transport database.VolumeTransport
 	switch req.Msg.Transport {
 	case zfsilov1.Volume_TRANSPORT_ISCSI:
 		transport.Type = database.VolumeTransportTypeISCSI
 	case zfsilov1.Volume_TRANSPORT_NVMEOF_TCP:
 		transport.Type = database.VolumeTransportTypeNVMEOF_TCP
+	case zfsilov1.Volume_TRANSPORT_NFS:
+		transport.Type = database.VolumeTransportTypeNFS
 	case zfsilov1.Volume_TRANSPORT_UNSPECIFIED:
 		fallthrough
 	default:
 		transport.Type = database.VolumeTransportTypeISCSI
+		if volumedb.Mode == database.VolumeModeDATASET {
+			transport.Type = database.VolumeTransportTypeNFS
+		}
+	}
+
+	// A dataset can only be shared over NFS, and only a dataset has a
+	// filesystem to share.
+	if (transport.Type == database.VolumeTransportTypeNFS) != (volumedb.Mode == database.VolumeModeDATASET) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("transport %s is not supported for volume mode %s", transport.Type, volumedb.Mode))
+	}
+	if req.Msg.Tls && transport.Type != database.VolumeTransportTypeNVMEOF_TCP {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("tls is not supported for transport %s", transport.Type))
 	}
 	volumedb.Transport = datatypes.NewJSONType(transport)
 	volumedb.ServerHost = req.Msg.ServerHost
@@ -524,30 +1341,51 @@ func (s *VolumeService) PublishVolume(ctx context.Context, req *connect.Request[
 		if err != nil {
 			return err
 		}
+		if !host.AllowsDataset(volumedb.DatasetID) {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("server host does not allow dataset %s", volumedb.DatasetID))
+		}
 
 		transport := volumedb.Transport.Data()
-		targetID, err := getTargetID(host, transport, volumedb.ID)
-		if err != nil {
-			return fmt.Errorf("failed to generate target ID: %w", err)
+		var targetID string
+		if transport.Type != database.VolumeTransportTypeNFS {
+			targetID, err = getTargetID(host, transport, volumedb.ID)
+			if err != nil {
+				return fmt.Errorf("failed to generate target ID: %w", err)
+			}
 		}
 
-		targetAddress, targetPassword := getServerConnection(host)
+		targetAddress, _ := getServerConnection(host)
+		targetAddresses := host.DataAddresses()
 		switch transport.Type {
 		case database.VolumeTransportTypeISCSI:
 			transport.ISCSI = &database.VolumeTransportISCSI{
-				TargetAddress:  targetAddress,
-				TargetIQN:      targetID,
-				TargetPassword: targetPassword,
+				TargetAddress:   targetAddresses[0],
+				TargetAddresses: targetAddresses,
+				TargetIQN:       targetID,
 			}
 		case database.VolumeTransportTypeNVMEOF_TCP:
+			nvmeofAddresses, err := getNVMeOFAddresses(host, req.Msg.Tls)
+			if err != nil {
+				return err
+			}
 			transport.NVMEOF = &database.VolumeTransportNVMEOF{
-				TargetAddress:  targetAddress,
-				TargetNQN:      targetID,
-				TargetPassword: targetPassword,
+				TargetAddress:   nvmeofAddresses[0],
+				TargetAddresses: nvmeofAddresses,
+				TargetNQN:       targetID,
+				TLS:             req.Msg.Tls,
+			}
+		case database.VolumeTransportTypeNFS:
+			transport.NFS = &database.VolumeTransportNFS{
+				ServerAddress: targetAddress,
 			}
 		case database.VolumeTransportTypeUNSPECIFIED:
 			return fmt.Errorf("no transport specified for publish")
 		}
+		if transport.Type != database.VolumeTransportTypeNFS {
+			if err := generateCredentials(&transport); err != nil {
+				return err
+			}
+		}
 		volumedb.Transport = datatypes.NewJSONType(transport)
 
 		_, err = gorm.G[*database.Volume](tx).Updates(ctx, volumedb)
@@ -563,20 +1401,38 @@ func (s *VolumeService) PublishVolume(ctx context.Context, req *connect.Request[
 			return fmt.Errorf("failed to check zfs volume: %w", err)
 		}
 		if !exists {
-			opts := make(map[string]string)
-			for _, option := range volumedb.Options.Data() {
-				opts[option.Key] = option.Value
+			err = createZFSVolume(ctx, tx, executor, volumedb, snapshotdb)
+			if err != nil {
+				return err
 			}
-			err = zfs.With(executor).CreateVolume(ctx, zfs.CreateVolumeArguments{
-				Name:    volumedb.DatasetID,
-				Size:    uint64(volumedb.CapacityBytes),
-				Options: opts,
-				Sparse:  volumedb.Sparse,
+		} else {
+			// The volume may have been expanded while it was not published.
+			value, err := zfs.With(executor).GetProperty(ctx, zfs.GetPropertyArguments{
+				Name:        volumedb.DatasetID,
+				PropertyKey: volumedb.SizeProperty(),
 			})
 			if err != nil {
-				return fmt.Errorf("failed to create zfs volume: %w", err)
+				return fmt.Errorf("failed to get zfs volume size: %w", err)
+			}
+			sizeBytes, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse zfs volume size: %w", err)
+			}
+			if sizeBytes < volumedb.CapacityBytes {
+				err = zfs.With(executor).SetProperty(ctx, zfs.SetPropertyArguments{
+					Name:          volumedb.DatasetID,
+					PropertyKey:   volumedb.SizeProperty(),
+					PropertyValue: fmt.Sprintf("%d", volumedb.CapacityBytes),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to update zfs volume size: %w", err)
+				}
 			}
 		}
+		err = loadZFSKey(ctx, executor, volumedb, volumedb.DatasetID)
+		if err != nil {
+			return err
+		}
 
 		switch transport.Type {
 		case database.VolumeTransportTypeISCSI:
@@ -584,13 +1440,27 @@ func (s *VolumeService) PublishVolume(ctx context.Context, req *connect.Request[
 				VolumeID:   volumedb.ID,
 				DevicePath: fmt.Sprintf("/dev/zvol/%s", volumedb.DatasetID),
 				TargetIQN:  iscsi.IQN(targetID),
+				Portals:    getBindAddresses(targetAddresses),
 			})
 		case database.VolumeTransportTypeNVMEOF_TCP:
 			err = nvmeof.With(executor).PublishVolume(ctx, nvmeof.PublishVolumeArguments{
 				VolumeID:   volumedb.ID,
 				DevicePath: fmt.Sprintf("/dev/zvol/%s", volumedb.DatasetID),
 				TargetNQN:  nvmeof.NQN(targetID),
+				Ports:      getNVMeOFPorts(host, transport.NVMEOF.TLS),
 			})
+		case database.VolumeTransportTypeNFS:
+			// The dataset is exported once a client connects, as an export
+			// without clients would be open to any host.
+			transport.NFS.ExportPath, err = getExportPath(ctx, executor, volumedb)
+			if err != nil {
+				return err
+			}
+			volumedb.Transport = datatypes.NewJSONType(transport)
+			_, err = gorm.G[*database.Volume](tx).Updates(ctx, volumedb)
+			if err != nil {
+				return fmt.Errorf("failed to update volume in database: %w", err)
+			}
 		case database.VolumeTransportTypeUNSPECIFIED:
 			fallthrough
 		default:
@@ -649,9 +1519,12 @@ func (s *VolumeService) UnpublishVolume(ctx context.Context, req *connect.Reques
 		}
 
 		previousTransport := volumedb.Transport.Data()
-		targetID, err := getTargetID(host, previousTransport, volumedb.ID)
-		if err != nil {
-			return fmt.Errorf("failed to generate target ID: %w", err)
+		var targetID string
+		if previousTransport.Type != database.VolumeTransportTypeNFS {
+			targetID, err = getTargetID(host, previousTransport, volumedb.ID)
+			if err != nil {
+				return fmt.Errorf("failed to generate target ID: %w", err)
+			}
 		}
 
 		volumedb.ServerHost = ""
@@ -673,6 +1546,10 @@ func (s *VolumeService) UnpublishVolume(ctx context.Context, req *connect.Reques
 			err = nvmeof.With(executor).UnpublishVolume(ctx, nvmeof.UnpublishVolumeArguments{
 				TargetNQN: nvmeof.NQN(targetID),
 			})
+		case database.VolumeTransportTypeNFS:
+			err = nfs.With(executor).Unexport(ctx, nfs.UnexportArguments{
+				VolumeID: volumedb.ID,
+			})
 		case database.VolumeTransportTypeUNSPECIFIED:
 			fallthrough
 		default:
@@ -708,73 +1585,81 @@ func (s *VolumeService) ConnectVolume(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
 	}
 
-	switch {
-	case !volumedb.IsPublished():
+	if !volumedb.IsPublished() {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not published"))
-	case volumedb.IsConnected():
+	}
+	// nvmet has no way to refuse the writes of a host to a namespace, so a
+	// read-only connection could not be enforced on the target.
+	if req.Msg.ReadOnly && volumedb.Transport.Data().Type == database.VolumeTransportTypeNVMEOF_TCP {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume published over NVMe-oF cannot be connected read-only"))
+	}
+	if attachment, ok := volumedb.Attachment(req.Msg.ClientHost); ok {
+		if attachment.ReadOnly != req.Msg.ReadOnly {
+			if attachment.ReadOnly {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("volume is connected read-only to host"))
+			}
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("volume is connected read-write to host"))
+		}
 		volumeapi, err := s.converter.FromDBToAPI(volumedb)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
 		}
 		return connect.NewResponse(&zfsilov1.ConnectVolumeResponse{Volume: volumeapi}), nil
-	case volumedb.IsMounted():
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is mounted"))
 	}
 
-	volumedb.ClientHost = req.Msg.ClientHost
-	volumedb.Status = database.VolumeStatusCONNECTED
-
 	err = s.database.Transaction(func(tx *gorm.DB) error {
-		_, err = gorm.G[*database.Volume](tx).Updates(ctx, volumedb)
+		producerExecutor, producerHost, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
 		if err != nil {
-			return fmt.Errorf("failed to update volume in database: %w", err)
+			return err
 		}
 
-		producerExecutor, _, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
+		consumerExecutor, connectHost, err := s.getExecutorForHost(ctx, req.Msg.ClientHost)
 		if err != nil {
 			return err
 		}
 
-		consumerExecutor, connectHost, err := s.getExecutorForHost(ctx, volumedb.ClientHost)
+		transport := volumedb.Transport.Data()
+		tlsKey, err := getTLSKey(ctx, tx, transport, producerHost, req.Msg.ClientHost)
 		if err != nil {
 			return err
 		}
-
-		transport := volumedb.Transport.Data()
-		var targetID, targetAddress, targetPassword string
+		var targetID, targetPassword string
+		var targetAddresses []string
 		switch transport.Type {
 		case database.VolumeTransportTypeISCSI:
 			targetID = transport.ISCSI.TargetIQN
-			targetAddress = transport.ISCSI.TargetAddress
+			targetAddresses = transport.ISCSI.Portals()
 			targetPassword = transport.ISCSI.TargetPassword
 		case database.VolumeTransportTypeNVMEOF_TCP:
 			targetID = transport.NVMEOF.TargetNQN
-			targetAddress = transport.NVMEOF.TargetAddress
+			targetAddresses = transport.NVMEOF.Ports()
 			targetPassword = transport.NVMEOF.TargetPassword
 		case database.VolumeTransportTypeUNSPECIFIED:
 			return fmt.Errorf("no transport specified for volume connection")
 		}
 
-		clientID, err := getClientID(connectHost, transport)
+		clientID, err := getClientID(connectHost, transport, volumedb.ID)
 		if err != nil {
 			return fmt.Errorf("failed to get client ID: %w", err)
 		}
 
-		consumerPassword := connectHost.Key
+		consumerPassword := getInitiatorPassword(transport, connectHost)
 
-		switch transport.Type {
-		case database.VolumeTransportTypeISCSI:
-			transport.ISCSI.InitiatorIQN = clientID
-			transport.ISCSI.InitiatorPassword = consumerPassword
-		case database.VolumeTransportTypeNVMEOF_TCP:
-			transport.NVMEOF.InitiatorNQN = clientID
-			transport.NVMEOF.InitiatorPassword = consumerPassword
-		case database.VolumeTransportTypeUNSPECIFIED:
-			return fmt.Errorf("no transport specified for initiator connection")
+		if transport.Type == database.VolumeTransportTypeNFS && !slices.Contains(transport.NFS.ClientAddresses, clientID) {
+			transport.NFS.ClientAddresses = append(transport.NFS.ClientAddresses, clientID)
 		}
 		volumedb.Transport = datatypes.NewJSONType(transport)
+		volumedb.Attachments = append(volumedb.Attachments, database.VolumeAttachment{
+			ClientHost: req.Msg.ClientHost,
+			Initiator:  clientID,
+			ReadOnly:   req.Msg.ReadOnly,
+		})
+		volumedb.UpdateStatus()
 
-		_, err = gorm.G[*database.Volume](tx).Updates(ctx, volumedb)
+		_, err = gorm.G[*database.Volume](tx).
+			Where("id = ?", volumedb.ID).
+			Select("status", "transport", "attachments").
+			Updates(ctx, volumedb)
 		if err != nil {
 			return fmt.Errorf("failed to update volume in database: %w", err)
 		}
@@ -787,13 +1672,14 @@ func (s *VolumeService) ConnectVolume(ctx context.Context, req *connect.Request[
 				TargetPassword:    targetPassword,
 				InitiatorIQN:      iscsi.IQN(clientID),
 				InitiatorPassword: consumerPassword,
+				ReadOnly:          req.Msg.ReadOnly,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to authorize client: %w", err)
 			}
 
 			err = iscsi.With(consumerExecutor).ConnectTarget(ctx, iscsi.ConnectTargetArguments{
-				TargetAddress:     targetAddress,
+				TargetAddresses:   targetAddresses,
 				TargetIQN:         iscsi.IQN(targetID),
 				TargetPassword:    targetPassword,
 				InitiatorIQN:      iscsi.IQN(clientID),
@@ -806,17 +1692,29 @@ func (s *VolumeService) ConnectVolume(ctx context.Context, req *connect.Request[
 				TargetPassword:    targetPassword,
 				InitiatorNQN:      nvmeof.NQN(clientID),
 				InitiatorPassword: consumerPassword,
+				TLSKey:            tlsKey,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to authorize client: %w", err)
 			}
 
 			err = nvmeof.With(consumerExecutor).ConnectTarget(ctx, nvmeof.ConnectTargetArguments{
-				TargetAddress:     targetAddress,
+				TargetAddresses:   targetAddresses,
 				TargetNQN:         nvmeof.NQN(targetID),
 				TargetPassword:    targetPassword,
 				InitiatorNQN:      nvmeof.NQN(clientID),
+				HostID:            getHostID(clientID, connectHost),
 				InitiatorPassword: consumerPassword,
+				TLSKey:            tlsKey,
+			})
+		case database.VolumeTransportTypeNFS:
+			// Authorize client on the producer side. The client mounts the
+			// export directly when the volume is staged.
+			err = nfs.With(producerExecutor).Export(ctx, nfs.ExportArguments{
+				VolumeID:        volumedb.ID,
+				Path:            transport.NFS.ExportPath,
+				Clients:         transport.NFS.ClientAddresses,
+				ReadOnlyClients: getReadOnlyClients(volumedb),
 			})
 		case database.VolumeTransportTypeUNSPECIFIED:
 			fallthrough
@@ -857,18 +1755,21 @@ func (s *VolumeService) DisconnectVolume(ctx context.Context, req *connect.Reque
 		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
 	}
 
-	switch {
-	case !volumedb.IsPublished():
+	if !volumedb.IsPublished() {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not published"))
-	case !volumedb.IsConnected():
+	}
+	attachment, ok := volumedb.Attachment(req.Msg.ClientHost)
+	switch {
+	case !ok:
 		volumeapi, err := s.converter.FromDBToAPI(volumedb)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
 		}
 		return connect.NewResponse(&zfsilov1.DisconnectVolumeResponse{Volume: volumeapi}), nil
-	case volumedb.IsMounted():
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is mounted"))
+	case attachment.IsMounted():
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is mounted on host"))
 	}
+	clientID := attachment.Initiator
 
 	err = s.database.Transaction(func(tx *gorm.DB) error {
 		producerExecutor, _, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
@@ -876,46 +1777,40 @@ func (s *VolumeService) DisconnectVolume(ctx context.Context, req *connect.Reque
 			return err
 		}
 
-		consumerExecutor, connectHost, err := s.getExecutorForHost(ctx, volumedb.ClientHost)
+		consumerExecutor, _, err := s.getExecutorForHost(ctx, req.Msg.ClientHost)
 		if err != nil {
 			return err
 		}
 
 		transport := volumedb.Transport.Data()
-		var targetID, targetAddress string
+		var targetID string
 		switch transport.Type {
 		case database.VolumeTransportTypeISCSI:
 			targetID = transport.ISCSI.TargetIQN
-			targetAddress = transport.ISCSI.TargetAddress
 		case database.VolumeTransportTypeNVMEOF_TCP:
 			targetID = transport.NVMEOF.TargetNQN
-			targetAddress = transport.NVMEOF.TargetAddress
 		case database.VolumeTransportTypeUNSPECIFIED:
 			return fmt.Errorf("no transport specified for volume staging")
 		}
 
-		clientID, err := getClientID(connectHost, transport)
-		if err != nil {
-			return fmt.Errorf("failed to get client ID: %w", err)
-		}
+		volumedb.Detach(req.Msg.ClientHost)
+		volumedb.UpdateStatus()
 
-		// Clear initiator details
-		switch transport.Type {
-		case database.VolumeTransportTypeISCSI:
-			transport.ISCSI.InitiatorIQN = ""
-			transport.ISCSI.InitiatorPassword = ""
-		case database.VolumeTransportTypeNVMEOF_TCP:
-			transport.NVMEOF.InitiatorNQN = ""
-			transport.NVMEOF.InitiatorPassword = ""
-		case database.VolumeTransportTypeUNSPECIFIED:
-			return fmt.Errorf("no transport specified for initiator detail clearing")
+		// The export stays open to the address while another attachment
+		// still connects from it.
+		if transport.Type == database.VolumeTransportTypeNFS && !slices.ContainsFunc(volumedb.Attachments, func(attachment database.VolumeAttachment) bool {
+			return attachment.Initiator == clientID
+		}) {
+			transport.NFS.ClientAddresses = slices.DeleteFunc(transport.NFS.ClientAddresses, func(address string) bool {
+				return address == clientID
+			})
 		}
 		volumedb.Transport = datatypes.NewJSONType(transport)
 
-		volumedb.ClientHost = ""
-		volumedb.Status = database.VolumeStatusPUBLISHED
-
-		_, err = gorm.G[*database.Volume](tx).Updates(ctx, volumedb)
+		_, err = gorm.G[*database.Volume](tx).
+			Where("id = ?", volumedb.ID).
+			Select("status", "transport", "attachments").
+			Updates(ctx, volumedb)
 		if err != nil {
 			return fmt.Errorf("failed to update volume in database: %w", err)
 		}
@@ -923,8 +1818,7 @@ func (s *VolumeService) DisconnectVolume(ctx context.Context, req *connect.Reque
 		switch transport.Type {
 		case database.VolumeTransportTypeISCSI:
 			err = iscsi.With(consumerExecutor).DisconnectTarget(ctx, iscsi.DisconnectTargetArguments{
-				TargetIQN:     iscsi.IQN(targetID),
-				TargetAddress: targetAddress,
+				TargetIQN: iscsi.IQN(targetID),
 			})
 			if err != nil {
 				return fmt.Errorf("failed to disconnect volume: %w", err)
@@ -948,6 +1842,15 @@ func (s *VolumeService) DisconnectVolume(ctx context.Context, req *connect.Reque
 				TargetNQN:    nvmeof.NQN(targetID),
 				InitiatorNQN: nvmeof.NQN(clientID),
 			})
+		case database.VolumeTransportTypeNFS:
+			// Unauthorize client on the producer side. The client holds no
+			// connection outside of its staging mount.
+			err = nfs.With(producerExecutor).Export(ctx, nfs.ExportArguments{
+				VolumeID:        volumedb.ID,
+				Path:            transport.NFS.ExportPath,
+				Clients:         transport.NFS.ClientAddresses,
+				ReadOnlyClients: getReadOnlyClients(volumedb),
+			})
 		case database.VolumeTransportTypeUNSPECIFIED:
 			fallthrough
 		default:
@@ -988,24 +1891,26 @@ func (s *VolumeService) StageVolume(ctx context.Context, req *connect.Request[zf
 		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
 	}
 
-	switch {
-	case !volumedb.IsPublished():
+	if !volumedb.IsPublished() {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not published"))
-	case !volumedb.IsConnected():
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not connected"))
-	case volumedb.IsStaged():
-		if volumedb.StagingPath == req.Msg.StagingPath {
+	}
+	attachment, ok := volumedb.Attachment(req.Msg.ClientHost)
+	switch {
+	case !ok:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not connected to host"))
+	case attachment.IsStaged():
+		if attachment.StagingPath == req.Msg.StagingPath {
 			volumeapi, err := s.converter.FromDBToAPI(volumedb)
 			if err != nil {
 				return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
 			}
 			return connect.NewResponse(&zfsilov1.StageVolumeResponse{Volume: volumeapi}), nil
 		}
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("volume is already staged at %s", volumedb.StagingPath))
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("volume is already staged at %s", attachment.StagingPath))
 	}
 
-	volumedb.StagingPath = req.Msg.StagingPath
-	volumedb.Status = database.VolumeStatusSTAGED
+	attachment.StagingPath = req.Msg.StagingPath
+	volumedb.UpdateStatus()
 
 	err = s.database.Transaction(func(tx *gorm.DB) error {
 		_, err = gorm.G[*database.Volume](tx).Updates(ctx, volumedb)
@@ -1013,7 +1918,7 @@ func (s *VolumeService) StageVolume(ctx context.Context, req *connect.Request[zf
 			return fmt.Errorf("failed to update volume in database: %w", err)
 		}
 
-		consumerExecutor, _, err := s.getExecutorForHost(ctx, volumedb.ClientHost)
+		consumerExecutor, _, err := s.getExecutorForHost(ctx, attachment.ClientHost)
 		if err != nil {
 			return err
 		}
@@ -1027,61 +1932,42 @@ func (s *VolumeService) StageVolume(ctx context.Context, req *connect.Request[zf
 		case database.VolumeTransportTypeNVMEOF_TCP:
 			targetID = transport.NVMEOF.TargetNQN
 			targetAddress = transport.NVMEOF.TargetAddress
+		case database.VolumeTransportTypeNFS:
+			// okay
 		case database.VolumeTransportTypeUNSPECIFIED:
 			return fmt.Errorf("no transport specified for volume staging")
 		}
 
-		// Wait for block device to appear on the client side.
-		devicePattern, err := volumedb.DevicePathClient(targetAddress, targetID)
-		if err != nil {
-			return fmt.Errorf("failed to get device path pattern: %w", err)
-		}
-		devicePath, err := fs.With(consumerExecutor).WaitForDevice(ctx, fs.WaitForDeviceArguments{
-			Device:  devicePattern,
-			Timeout: 30 * time.Second,
-		})
-		if err != nil {
-			return fmt.Errorf("failed to wait for block device %s: %w", devicePattern, err)
-		}
-
-		if volumedb.Mode == database.VolumeModeFILESYSTEM {
-			// Check if filesystem exists.
-			fsType, err := fs.With(consumerExecutor).GetFSType(ctx, devicePath)
+		// Wait for block device to appear on the client side. A dataset is
+		// mounted from its export instead, with no device or filesystem to
+		// prepare.
+		var devicePath string
+		if transport.Type != database.VolumeTransportTypeNFS {
+			devicePattern, err := volumedb.DevicePathClient(targetAddress, targetID)
 			if err != nil {
-				return fmt.Errorf("failed to get filesystem type: %w", err)
+				return fmt.Errorf("failed to get device path pattern: %w", err)
+			}
+			devicePath, err = fs.With(consumerExecutor).WaitForDevice(ctx, fs.WaitForDeviceArguments{
+				Device:  devicePattern,
+				Timeout: 30 * time.Second,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to wait for block device %s: %w", devicePattern, err)
 			}
 
-			if fsType == "" {
-				// Format the device.
-				err = fs.With(consumerExecutor).Format(ctx, fs.FormatArguments{
-					Device:        devicePath,
-					WaitForDevice: false,
-				})
-				if err != nil {
-					return fmt.Errorf("failed to format device: %w", err)
-				}
+			if err := prepareStageDevice(ctx, consumerExecutor, volumedb, attachment, devicePath); err != nil {
+				return err
 			}
 		}
 
 		// Create staging path.
-		_, err = literal.With(consumerExecutor).Run(ctx, fmt.Sprintf("mkdir -m 0750 -p %s", volumedb.StagingPath))
+		_, err = literal.With(consumerExecutor).Run(ctx, fmt.Sprintf("mkdir -m 0750 -p %s", attachment.StagingPath))
 		if err != nil {
 			return fmt.Errorf("failed to create staging path: %w", err)
 		}
 
 		// Mount volume to staging path.
-		mountArgs := mount.MountArguments{
-			SourcePath: devicePath,
-			TargetPath: volumedb.StagingPath,
-		}
-		if volumedb.Mode == database.VolumeModeFILESYSTEM {
-			mountArgs.FSType = "ext4"
-			mountArgs.Options = []string{"defaults"}
-		} else {
-			mountArgs.Options = []string{"bind"}
-		}
-
-		err = mount.With(consumerExecutor).Mount(ctx, mountArgs)
+		err = mount.With(consumerExecutor).Mount(ctx, getStageMountArguments(volumedb, attachment, devicePath))
 		if err != nil {
 			return fmt.Errorf("failed to mount volume to staging path: %w", err)
 		}
@@ -1117,28 +2003,32 @@ func (s *VolumeService) UnstageVolume(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
 	}
 
+	attachment, ok := volumedb.Attachment(req.Msg.ClientHost)
 	switch {
-	case !volumedb.IsStaged():
+	case !ok || !attachment.IsStaged():
 		volumeapi, err := s.converter.FromDBToAPI(volumedb)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
 		}
 		return connect.NewResponse(&zfsilov1.UnstageVolumeResponse{Volume: volumeapi}), nil
-	case volumedb.IsMounted():
+	case attachment.IsMounted():
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is still mounted to one or more target paths"))
 	}
 
 	err = s.database.Transaction(func(tx *gorm.DB) error {
-		previousStagingPath := volumedb.StagingPath
-		volumedb.StagingPath = ""
-		volumedb.Status = database.VolumeStatusCONNECTED
-
-		_, err = gorm.G[*database.Volume](tx).Updates(ctx, volumedb)
+		previousStagingPath := attachment.StagingPath
+		attachment.StagingPath = ""
+		volumedb.UpdateStatus()
+
+		_, err = gorm.G[*database.Volume](tx).
+			Where("id = ?", volumedb.ID).
+			Select("status", "attachments").
+			Updates(ctx, volumedb)
 		if err != nil {
 			return fmt.Errorf("failed to update volume in database: %w", err)
 		}
 
-		consumerExecutor, _, err := s.getExecutorForHost(ctx, volumedb.ClientHost)
+		consumerExecutor, _, err := s.getExecutorForHost(ctx, attachment.ClientHost)
 		if err != nil {
 			return err
 		}
@@ -1173,17 +2063,22 @@ func (s *VolumeService) MountVolume(ctx context.Context, req *connect.Request[zf
 		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
 	}
 
-	switch {
-	case !volumedb.IsPublished():
+	if !volumedb.IsPublished() {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not published"))
-	case !volumedb.IsConnected():
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not connected"))
-	case !volumedb.IsStaged():
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not staged"))
+	}
+	attachment, ok := volumedb.Attachment(req.Msg.ClientHost)
+	switch {
+	case !ok:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not connected to host"))
+	case !attachment.IsStaged():
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not staged on host"))
 	}
 
 	// Check if already mounted to this path.
-	if indexOf(volumedb.TargetPaths, req.Msg.MountPath) != -1 {
+	if indexOf(attachment.TargetPaths, req.Msg.MountPath) != -1 {
+		if !attachment.ReadOnly && slices.Contains(attachment.ReadOnlyTargetPaths, req.Msg.MountPath) != req.Msg.ReadOnly {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume is already mounted at %s with a different access", req.Msg.MountPath))
+		}
 		volumeapi, err := s.converter.FromDBToAPI(volumedb)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to map volume: %w", err))
@@ -1191,8 +2086,11 @@ func (s *VolumeService) MountVolume(ctx context.Context, req *connect.Request[zf
 		return connect.NewResponse(&zfsilov1.MountVolumeResponse{Volume: volumeapi}), nil
 	}
 
-	volumedb.TargetPaths = append(volumedb.TargetPaths, req.Msg.MountPath)
-	volumedb.Status = database.VolumeStatusMOUNTED
+	attachment.TargetPaths = append(attachment.TargetPaths, req.Msg.MountPath)
+	if req.Msg.ReadOnly {
+		attachment.ReadOnlyTargetPaths = append(attachment.ReadOnlyTargetPaths, req.Msg.MountPath)
+	}
+	volumedb.UpdateStatus()
 
 	err = s.database.Transaction(func(tx *gorm.DB) error {
 		_, err = gorm.G[*database.Volume](tx).Updates(ctx, volumedb)
@@ -1200,7 +2098,7 @@ func (s *VolumeService) MountVolume(ctx context.Context, req *connect.Request[zf
 			return fmt.Errorf("failed to update volume in database: %w", err)
 		}
 
-		consumerExecutor, _, err := s.getExecutorForHost(ctx, volumedb.ClientHost)
+		consumerExecutor, _, err := s.getExecutorForHost(ctx, attachment.ClientHost)
 		if err != nil {
 			return err
 		}
@@ -1219,16 +2117,13 @@ func (s *VolumeService) MountVolume(ctx context.Context, req *connect.Request[zf
 		}
 
 		// Perform bind mount from staging path to mount path.
-		err = mount.With(consumerExecutor).Mount(ctx, mount.MountArguments{
-			SourcePath: volumedb.StagingPath,
-			TargetPath: req.Msg.MountPath,
-			Options:    []string{"bind"},
-		})
+		err = mount.With(consumerExecutor).Mount(ctx, getTargetMountArguments(attachment, req.Msg.MountPath))
 		if err != nil {
 			return fmt.Errorf("failed to bind mount volume: %w", err)
 		}
 
-		if volumedb.Mode == database.VolumeModeFILESYSTEM {
+		// The mode of a read-only mount cannot be changed.
+		if !isReadOnlyTargetPath(attachment, req.Msg.MountPath) && (volumedb.Mode == database.VolumeModeFILESYSTEM || volumedb.Mode == database.VolumeModeDATASET) {
 			// TODO: I should properly expose the volume to non-root users.
 			_, err = literal.With(consumerExecutor).Run(ctx, fmt.Sprintf("chmod 0777 %s", req.Msg.MountPath))
 			if err != nil {
@@ -1267,7 +2162,11 @@ func (s *VolumeService) UnmountVolume(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
 	}
 
-	index := indexOf(volumedb.TargetPaths, req.Msg.MountPath)
+	attachment, ok := volumedb.Attachment(req.Msg.ClientHost)
+	index := -1
+	if ok {
+		index = indexOf(attachment.TargetPaths, req.Msg.MountPath)
+	}
 	if index == -1 {
 		// Already unmounted from this path or never mounted.
 		volumeapi, err := s.converter.FromDBToAPI(volumedb)
@@ -1278,18 +2177,22 @@ func (s *VolumeService) UnmountVolume(ctx context.Context, req *connect.Request[
 	}
 
 	// Remove from list.
-	volumedb.TargetPaths = append(volumedb.TargetPaths[:index], volumedb.TargetPaths[index+1:]...)
-	if len(volumedb.TargetPaths) == 0 {
-		volumedb.Status = database.VolumeStatusSTAGED
-	}
+	attachment.TargetPaths = append(attachment.TargetPaths[:index], attachment.TargetPaths[index+1:]...)
+	attachment.ReadOnlyTargetPaths = slices.DeleteFunc(attachment.ReadOnlyTargetPaths, func(path string) bool {
+		return path == req.Msg.MountPath
+	})
+	volumedb.UpdateStatus()
 
 	err = s.database.Transaction(func(tx *gorm.DB) error {
-		_, err = gorm.G[*database.Volume](tx).Updates(ctx, volumedb)
+		_, err = gorm.G[*database.Volume](tx).
+			Where("id = ?", volumedb.ID).
+			Select("status", "attachments").
+			Updates(ctx, volumedb)
 		if err != nil {
 			return fmt.Errorf("failed to update volume in database: %w", err)
 		}
 
-		consumerExecutor, _, err := s.getExecutorForHost(ctx, volumedb.ClientHost)
+		consumerExecutor, _, err := s.getExecutorForHost(ctx, attachment.ClientHost)
 		if err != nil {
 			return err
 		}
@@ -1332,16 +2235,24 @@ func (s *VolumeService) StatsVolume(ctx context.Context, req *connect.Request[zf
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not published"))
 	case !volumedb.IsConnected():
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not connected"))
-	case !volumedb.IsStaged():
+	}
+
+	// Every staging path sees the same volume, so the stats are taken from
+	// the first attachment that staged it.
+	index := slices.IndexFunc(volumedb.Attachments, func(attachment database.VolumeAttachment) bool {
+		return attachment.IsStaged()
+	})
+	if index == -1 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("volume is not staged"))
 	}
+	attachment := &volumedb.Attachments[index]
 
 	producerExecutor, _, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
 	if err != nil {
 		return nil, err
 	}
 
-	consumerExecutor, _, err := s.getExecutorForHost(ctx, volumedb.ClientHost)
+	consumerExecutor, _, err := s.getExecutorForHost(ctx, attachment.ClientHost)
 	if err != nil {
 		return nil, err
 	}
@@ -1349,19 +2260,19 @@ func (s *VolumeService) StatsVolume(ctx context.Context, req *connect.Request[zf
 	var usage []*zfsilov1.StatsVolumeResponse_Stats_Usage
 	switch volumedb.Mode {
 	case database.VolumeModeBLOCK:
-		var values []int64
-		for _, prop := range []string{"used", "usedds"} {
-			valueString, err := zfs.With(producerExecutor).GetProperty(ctx, zfs.GetPropertyArguments{
-				Name:        volumedb.DatasetID,
-				PropertyKey: prop,
-			})
-			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get stats property %s: %w", prop, err))
-			}
+		properties, err := zfs.With(producerExecutor).GetProperties(ctx, zfs.GetPropertiesArguments{
+			Name:       volumedb.DatasetID,
+			Properties: []string{"used", "usedbydataset"},
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get stats properties: %w", err))
+		}
 
-			value, err := strconv.ParseInt(valueString, 10, 64)
+		var values []int64
+		for _, prop := range []string{"used", "usedbydataset"} {
+			value, err := properties.Int(prop)
 			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse property %s: %w", prop, err))
+				return nil, connect.NewError(connect.CodeInternal, err)
 			}
 			values = append(values, value)
 		}
@@ -1371,9 +2282,9 @@ func (s *VolumeService) StatsVolume(ctx context.Context, req *connect.Request[zf
 			Available: values[0] - values[1],
 			Unit:      zfsilov1.StatsVolumeResponse_Stats_Usage_UNIT_BYTES,
 		})
-	case database.VolumeModeFILESYSTEM:
+	case database.VolumeModeFILESYSTEM, database.VolumeModeDATASET:
 		// Use staging path for stats.
-		statsPath := volumedb.StagingPath
+		statsPath := attachment.StagingPath
 
 		valueString, err := literal.With(consumerExecutor).Run(ctx, fmt.Sprintf(
 			"df -BK '%s' --output=size,used,avail,itotal,iused,iavail | sed 1d",
@@ -1439,6 +2350,55 @@ func (s *VolumeService) StatsVolume(ctx context.Context, req *connect.Request[zf
 	}}), nil
 }
 
+func (s *VolumeService) GetVolumeProperties(ctx context.Context, req *connect.Request[zfsilov1.GetVolumePropertiesRequest]) (*connect.Response[zfsilov1.GetVolumePropertiesResponse], error) {
+	volumedb, err := gorm.G[*database.Volume](s.database).Where("id = ?", req.Msg.Id).First(ctx)
+	switch {
+	case err == nil:
+		// okay
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("volume does not exist"))
+	default:
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("failed to get volume: %w", err))
+	}
+
+	if err := requireServerHost(volumedb); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	executor, _, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := zfs.With(executor).GetProperties(ctx, zfs.GetPropertiesArguments{
+		Name:       volumedb.DatasetID,
+		Properties: req.Msg.Names,
+	})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "invalid property"):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case strings.Contains(err.Error(), "dataset does not exist"):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("volume dataset does not exist: %w", err))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get volume properties: %w", err))
+	}
+
+	names := slices.Sorted(maps.Keys(properties))
+	propertyapis := make([]*zfsilov1.VolumeProperty, 0, len(names))
+	for _, name := range names {
+		property := properties[name]
+		propertyapis = append(propertyapis, &zfsilov1.VolumeProperty{
+			Name:          name,
+			Value:         property.Value,
+			Source:        converteriface.ConvertVolumePropertySourceToAPI(string(property.Source)),
+			InheritedFrom: property.InheritedFrom,
+		})
+	}
+
+	return connect.NewResponse(&zfsilov1.GetVolumePropertiesResponse{Properties: propertyapis}), nil
+}
+
 func (s *VolumeService) SyncVolume(ctx context.Context, req *connect.Request[zfsilov1.SyncVolumeRequest]) (*connect.Response[zfsilov1.SyncVolumeResponse], error) {
 	volumedb, err := gorm.G[*database.Volume](s.database).Where("id = ?", req.Msg.Id).First(ctx)
 	switch {
@@ -2,17 +2,23 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/jovulic/zfsilo/app/internal/command"
 	"github.com/jovulic/zfsilo/app/internal/command/fs"
 	"github.com/jovulic/zfsilo/app/internal/command/iscsi"
 	"github.com/jovulic/zfsilo/app/internal/command/literal"
 	"github.com/jovulic/zfsilo/app/internal/command/mount"
+	"github.com/jovulic/zfsilo/app/internal/command/nfs"
 	"github.com/jovulic/zfsilo/app/internal/command/nvmeof"
 	"github.com/jovulic/zfsilo/app/internal/command/zfs"
 	"github.com/jovulic/zfsilo/app/internal/database"
 	libcommand "github.com/jovulic/zfsilo/lib/command"
+	ulid "github.com/oklog/ulid/v2"
 	slogctx "github.com/veqryn/slog-context"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
@@ -34,6 +40,10 @@ func NewVolumeSyncer(
 }
 
 func (s *VolumeSyncer) Sync(ctx context.Context, volumedb *database.Volume) error {
+	if err := s.syncFence(ctx, volumedb); err != nil {
+		return fmt.Errorf("failed to sync fence: %w", err)
+	}
+
 	if err := s.syncZFS(ctx, volumedb); err != nil {
 		return fmt.Errorf("failed to sync zfs: %w", err)
 	}
@@ -42,16 +52,21 @@ func (s *VolumeSyncer) Sync(ctx context.Context, volumedb *database.Volume) erro
 		return fmt.Errorf("failed to sync publish: %w", err)
 	}
 
-	if err := s.syncConnect(ctx, volumedb); err != nil {
-		return fmt.Errorf("failed to sync connect: %w", err)
-	}
+	// Each client host the volume is attached to is reconciled on its own.
+	for i := range volumedb.Attachments {
+		attachment := &volumedb.Attachments[i]
 
-	if err := s.syncStage(ctx, volumedb); err != nil {
-		return fmt.Errorf("failed to sync stage: %w", err)
-	}
+		if err := s.syncConnect(ctx, volumedb, attachment); err != nil {
+			return fmt.Errorf("failed to sync connect on host %s: %w", attachment.ClientHost, err)
+		}
+
+		if err := s.syncStage(ctx, volumedb, attachment); err != nil {
+			return fmt.Errorf("failed to sync stage on host %s: %w", attachment.ClientHost, err)
+		}
 
-	if err := s.syncMount(ctx, volumedb); err != nil {
-		return fmt.Errorf("failed to sync mount: %w", err)
+		if err := s.syncMount(ctx, volumedb, attachment); err != nil {
+			return fmt.Errorf("failed to sync mount on host %s: %w", attachment.ClientHost, err)
+		}
 	}
 
 	return nil
@@ -73,6 +88,104 @@ func (s *VolumeSyncer) getExecutorForHost(ctx context.Context, hostID string) (l
 	return executor, host, nil
 }
 
+// syncFence fences the server hosts the volume has failed over from. A fenced
+// host has its target for the volume removed and its stale copy of the volume
+// renamed aside, rather than destroyed, so that it is never exported again but
+// remains available to recover from. Hosts that cannot be reached are left to
+// a later sync.
+func (s *VolumeSyncer) syncFence(ctx context.Context, volumedb *database.Volume) error {
+	if len(volumedb.FencedHosts) == 0 {
+		return nil
+	}
+
+	remaining := datatypes.JSONSlice[string]{}
+	for _, hostID := range volumedb.FencedHosts {
+		err := s.fenceHost(ctx, volumedb, hostID)
+		switch {
+		case err == nil:
+			slogctx.Info(ctx, "fenced server host during sync", "volumeId", volumedb.ID, "hostId", hostID)
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			// A host that no longer exists has nothing left to fence.
+		default:
+			slogctx.Warn(ctx, "failed to fence server host during sync", "volumeId", volumedb.ID, "hostId", hostID, slogctx.Err(err))
+			remaining = append(remaining, hostID)
+		}
+	}
+	if len(remaining) == len(volumedb.FencedHosts) {
+		return nil
+	}
+
+	_, err := gorm.G[*database.Volume](s.database).Where("id = ?", volumedb.ID).Update(ctx, "fenced_hosts", remaining)
+	if err != nil {
+		return fmt.Errorf("failed to update fenced hosts: %w", err)
+	}
+	volumedb.FencedHosts = remaining
+	return nil
+}
+
+func (s *VolumeSyncer) fenceHost(ctx context.Context, volumedb *database.Volume, hostID string) error {
+	executor, host, err := s.getExecutorForHost(ctx, hostID)
+	if err != nil {
+		return err
+	}
+
+	// We do not know which transport the volume was last published with on
+	// the host, so we check for either target.
+	if iqn, err := host.VolumeIQN(volumedb.ID); err == nil {
+		_, err := literal.With(executor).Run(ctx, fmt.Sprintf("ls -d /sys/kernel/config/target/iscsi/%s", iqn))
+		if err == nil {
+			err := iscsi.With(executor).UnpublishVolume(ctx, iscsi.UnpublishVolumeArguments{
+				VolumeID:  volumedb.ID,
+				TargetIQN: iscsi.IQN(iqn),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to unpublish iscsi volume: %w", err)
+			}
+		}
+	}
+	if nqn, err := host.VolumeNQN(volumedb.ID); err == nil {
+		_, err := literal.With(executor).Run(ctx, fmt.Sprintf("ls -d /sys/kernel/config/nvmet/subsystems/%s", nqn))
+		if err == nil {
+			err := nvmeof.With(executor).UnpublishVolume(ctx, nvmeof.UnpublishVolumeArguments{
+				TargetNQN: nvmeof.NQN(nqn),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to unpublish nvmeof volume: %w", err)
+			}
+		}
+	}
+
+	exists, err := zfs.With(executor).VolumeExists(ctx, zfs.VolumeExistsArguments{
+		Name: volumedb.DatasetID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check volume existence: %w", err)
+	}
+	if !exists {
+		return nil
+	}
+
+	// The snapshots left on the host when the volume failed over are renamed
+	// along with the stale copy.
+	fencedDatasetID := database.BuildFencedDatasetID(volumedb.DatasetID, strings.ToLower(ulid.Make().String()))
+	return s.database.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&database.Snapshot{}).
+			Where("volume_id = ? AND server_host = ? AND dataset_id LIKE ?", volumedb.ID, host.Name, volumedb.DatasetID+"@%").
+			Update("dataset_id", gorm.Expr("? || substr(dataset_id, ?)", fencedDatasetID, len(volumedb.DatasetID)+1)).Error
+		if err != nil {
+			return fmt.Errorf("failed to update snapshots in database: %w", err)
+		}
+		err = zfs.With(executor).RenameVolume(ctx, zfs.RenameVolumeArguments{
+			Name:    volumedb.DatasetID,
+			NewName: fencedDatasetID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to rename stale zfs volume: %w", err)
+		}
+		return nil
+	})
+}
+
 func (s *VolumeSyncer) syncZFS(ctx context.Context, volumedb *database.Volume) error {
 	if volumedb.ServerHost == "" {
 		return nil
@@ -89,28 +202,25 @@ func (s *VolumeSyncer) syncZFS(ctx context.Context, volumedb *database.Volume) e
 		return fmt.Errorf("failed to check volume existence: %w", err)
 	}
 
+	// The keys of encrypted volumes are not loaded when the server host
+	// boots.
 	if exists {
-		return nil
+		return loadZFSKey(ctx, executor, volumedb, volumedb.DatasetID)
+	}
+
+	var snapshotdb *database.Snapshot
+	if volumedb.SourceSnapshotID() != "" {
+		snapshotdb, err = gorm.G[*database.Snapshot](s.database).Where("id = ?", volumedb.SourceSnapshotID()).First(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get source snapshot %s: %w", volumedb.SourceSnapshotID(), err)
+		}
 	}
 
 	// Create ZFS volume.
 	// NOTE: We only check for volume existence currently. In the future we might
 	// want to also verify size etc.
-	opts := make(map[string]string)
-	for _, option := range volumedb.Options.Data() {
-		opts[option.Key] = option.Value
-	}
-	err = zfs.With(executor).CreateVolume(ctx, zfs.CreateVolumeArguments{
-		Name:    volumedb.DatasetID,
-		Size:    uint64(volumedb.CapacityBytes),
-		Options: opts,
-		Sparse:  volumedb.Sparse,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to create zfs volume: %w", err)
-	}
-
-	return nil
+	slogctx.Info(ctx, "creating zfs volume during sync", "volumeId", volumedb.ID)
+	return createZFSVolume(ctx, s.database, executor, volumedb, snapshotdb)
 }
 
 func (s *VolumeSyncer) syncPublish(ctx context.Context, volumedb *database.Volume) error {
@@ -122,6 +232,10 @@ func (s *VolumeSyncer) syncPublish(ctx context.Context, volumedb *database.Volum
 		return err
 	}
 
+	if volumedb.Transport.Data().Type == database.VolumeTransportTypeNFS {
+		return s.syncExport(ctx, executor, volumedb)
+	}
+
 	getTargetID := func(volumedb *database.Volume, host *database.Host) string {
 		transport := volumedb.Transport.Data()
 		switch transport.Type {
@@ -168,6 +282,7 @@ func (s *VolumeSyncer) syncPublish(ctx context.Context, volumedb *database.Volum
 					VolumeID:   volumedb.ID,
 					DevicePath: volumedb.DevicePathZFS(),
 					TargetIQN:  iscsi.IQN(targetID),
+					Portals:    getBindAddresses(transport.ISCSI.Portals()),
 				})
 				if err != nil {
 					return fmt.Errorf("failed to publish iscsi volume: %w", err)
@@ -177,6 +292,7 @@ func (s *VolumeSyncer) syncPublish(ctx context.Context, volumedb *database.Volum
 					VolumeID:   volumedb.ID,
 					DevicePath: volumedb.DevicePathZFS(),
 					TargetNQN:  nvmeof.NQN(targetID),
+					Ports:      getNVMeOFPorts(host, transport.NVMEOF.TLS),
 				})
 				if err != nil {
 					return fmt.Errorf("failed to publish nvmeof volume: %w", err)
@@ -186,6 +302,10 @@ func (s *VolumeSyncer) syncPublish(ctx context.Context, volumedb *database.Volum
 			default:
 				return fmt.Errorf("no transport specified on volume")
 			}
+		} else if transport.Type == database.VolumeTransportTypeNVMEOF_TCP {
+			if err := s.syncPorts(ctx, executor, host, targetID, transport.NVMEOF.TLS); err != nil {
+				return err
+			}
 		}
 	} else {
 		isPublished := checkPublished(transport, targetID)
@@ -218,15 +338,100 @@ func (s *VolumeSyncer) syncPublish(ctx context.Context, volumedb *database.Volum
 	return nil
 }
 
-func (s *VolumeSyncer) syncConnect(ctx context.Context, volumedb *database.Volume) error {
-	if volumedb.ServerHost == "" || volumedb.ClientHost == "" {
+// syncPorts reconciles the ports a published subsystem is bound to with the
+// ports of its server host, binding the missing ones and unbinding the rest.
+func (s *VolumeSyncer) syncPorts(ctx context.Context, executor libcommand.Executor, host *database.Host, targetID string, tls bool) error {
+	expected := getNVMeOFPorts(host, tls)
+	actual, err := nvmeof.With(executor).ListPorts(ctx, nvmeof.ListPortsArguments{
+		TargetNQN: nvmeof.NQN(targetID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list nvmeof ports: %w", err)
+	}
+
+	for _, port := range expected {
+		if slices.Contains(actual, port) {
+			continue
+		}
+		slogctx.Info(ctx, "binding nvmeof port during sync", "targetId", targetID, "portId", port.ID)
+		err := nvmeof.With(executor).BindPort(ctx, nvmeof.BindPortArguments{
+			TargetNQN: nvmeof.NQN(targetID),
+			Port:      port,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to bind nvmeof port: %w", err)
+		}
+	}
+	for _, port := range actual {
+		if slices.ContainsFunc(expected, func(p nvmeof.Port) bool { return p.ID == port.ID }) {
+			continue
+		}
+		slogctx.Info(ctx, "unbinding nvmeof port during sync", "targetId", targetID, "portId", port.ID)
+		err := nvmeof.With(executor).UnbindPort(ctx, nvmeof.UnbindPortArguments{
+			TargetNQN: nvmeof.NQN(targetID),
+			PortID:    port.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to unbind nvmeof port: %w", err)
+		}
+	}
+	return nil
+}
+
+// syncExport reconciles the NFS export of a dataset volume with the clients it
+// is connected to.
+func (s *VolumeSyncer) syncExport(ctx context.Context, executor libcommand.Executor, volumedb *database.Volume) error {
+	var path string
+	var clients []string
+	if transport := volumedb.Transport.Data(); volumedb.IsPublished() && transport.NFS != nil {
+		path = transport.NFS.ExportPath
+		clients = transport.NFS.ClientAddresses
+	}
+
+	readOnlyClients := getReadOnlyClients(volumedb)
+
+	var expected string
+	if len(clients) > 0 {
+		expected = nfs.BuildExport(path, clients, readOnlyClients, nil)
+	}
+	actual, err := nfs.With(executor).GetExport(ctx, nfs.GetExportArguments{
+		VolumeID: volumedb.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get nfs export: %w", err)
+	}
+	if actual == expected {
+		return nil
+	}
+
+	slogctx.Info(ctx, "exporting volume during sync", "volumeId", volumedb.ID, "clients", clients)
+	err = nfs.With(executor).Export(ctx, nfs.ExportArguments{
+		VolumeID:        volumedb.ID,
+		Path:            path,
+		Clients:         clients,
+		ReadOnlyClients: readOnlyClients,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export nfs volume: %w", err)
+	}
+	return nil
+}
+
+func (s *VolumeSyncer) syncConnect(ctx context.Context, volumedb *database.Volume, attachment *database.VolumeAttachment) error {
+	if volumedb.ServerHost == "" {
+		return nil
+	}
+
+	// NFS clients are authorized by the export, which is reconciled along with
+	// the publish, and hold no connection outside of their staging mount.
+	if volumedb.Transport.Data().Type == database.VolumeTransportTypeNFS {
 		return nil
 	}
 	publishExecutor, publishHost, err := s.getExecutorForHost(ctx, volumedb.ServerHost)
 	if err != nil {
 		return err
 	}
-	connectExecutor, connectHost, err := s.getExecutorForHost(ctx, volumedb.ClientHost)
+	connectExecutor, connectHost, err := s.getExecutorForHost(ctx, attachment.ClientHost)
 	if err != nil {
 		return err
 	}
@@ -274,27 +479,50 @@ func (s *VolumeSyncer) syncConnect(ctx context.Context, volumedb *database.Volum
 		_, err := literal.With(publishExecutor).Run(ctx, fmt.Sprintf("ls -d %s", path))
 		return err == nil
 	}
-	checkConnected := func(transport datatypes.JSONType[database.VolumeTransport], targetID string) bool {
+	// checkConnected returns whether the client has any connection to the
+	// target, and whether it has one through every address.
+	checkConnected := func(transport datatypes.JSONType[database.VolumeTransport], targetID string, targetAddresses []string) (bool, bool) {
 		var cmd string
 		switch transport.Data().Type {
 		case database.VolumeTransportTypeISCSI:
-			cmd = fmt.Sprintf("iscsiadm -m session | grep -q %s", targetID)
+			cmd = fmt.Sprintf("iscsiadm -m session | grep -c %s", targetID)
 		case database.VolumeTransportTypeNVMEOF_TCP:
-			cmd = fmt.Sprintf("nvme list-subsys -n %s", targetID)
+			cmd = fmt.Sprintf("nvme list-subsys -n %s | grep -c traddr=", targetID)
 		case database.VolumeTransportTypeUNSPECIFIED:
-			return false
+			return false, false
 		default:
-			return false
+			return false, false
 		}
-		_, err := literal.With(connectExecutor).Run(ctx, cmd)
-		return err == nil
+		out, err := literal.With(connectExecutor).Run(ctx, cmd)
+		if err != nil {
+			return false, false
+		}
+		count, _ := strconv.Atoi(strings.TrimSpace(out))
+		return count > 0, count >= len(targetAddresses)
 	}
 
 	targetID := getTargetID(volumedb, publishHost)
 	clientID := getClientID(volumedb.Transport, connectHost)
-	targetAddress := publishHost.Connection.Data().Remote.Address
-	targetPassword := publishHost.Key
-	initiatorPassword := connectHost.Key
+	// The attachment keeps the initiator it was connected with, which over
+	// NVMe-oF is an NQN of the client host for the volume.
+	if attachment.Initiator != "" {
+		clientID = attachment.Initiator
+	}
+	var targetAddresses []string
+	var targetPassword string
+	switch transport := volumedb.Transport.Data(); {
+	case transport.ISCSI != nil:
+		targetAddresses = transport.ISCSI.Portals()
+		targetPassword = transport.ISCSI.TargetPassword
+	case transport.NVMEOF != nil:
+		targetAddresses = transport.NVMEOF.Ports()
+		targetPassword = transport.NVMEOF.TargetPassword
+	}
+	initiatorPassword := getInitiatorPassword(volumedb.Transport.Data(), connectHost)
+	tlsKey, err := getTLSKey(ctx, s.database, volumedb.Transport.Data(), publishHost, connectHost.Name)
+	if err != nil {
+		return err
+	}
 
 	if volumedb.IsConnected() {
 		// Reconcile authorization.
@@ -308,6 +536,7 @@ func (s *VolumeSyncer) syncConnect(ctx context.Context, volumedb *database.Volum
 					InitiatorIQN:      iscsi.IQN(clientID),
 					InitiatorPassword: initiatorPassword,
 					TargetPassword:    targetPassword,
+					ReadOnly:          attachment.ReadOnly,
 				})
 				if err != nil {
 					return fmt.Errorf("failed to authorize iscsi client: %w", err)
@@ -318,6 +547,7 @@ func (s *VolumeSyncer) syncConnect(ctx context.Context, volumedb *database.Volum
 					InitiatorNQN:      nvmeof.NQN(clientID),
 					InitiatorPassword: initiatorPassword,
 					TargetPassword:    targetPassword,
+					TLSKey:            tlsKey,
 				})
 				if err != nil {
 					return fmt.Errorf("failed to authorize nvmeof client: %w", err)
@@ -329,15 +559,16 @@ func (s *VolumeSyncer) syncConnect(ctx context.Context, volumedb *database.Volum
 			}
 		}
 
-		// Reconcile connection.
-		isConnected := checkConnected(volumedb.Transport, targetID)
+		// Reconcile connection. Paths that were lost are connected again, as
+		// connecting skips the addresses that are still connected.
+		_, isConnected := checkConnected(volumedb.Transport, targetID, targetAddresses)
 		if !isConnected {
 			slogctx.Info(ctx, "connecting volume during sync", "volumeId", volumedb.ID)
 			switch volumedb.Transport.Data().Type {
 			case database.VolumeTransportTypeISCSI:
 				err := iscsi.With(connectExecutor).ConnectTarget(ctx, iscsi.ConnectTargetArguments{
 					TargetIQN:         iscsi.IQN(targetID),
-					TargetAddress:     targetAddress,
+					TargetAddresses:   targetAddresses,
 					InitiatorIQN:      iscsi.IQN(clientID),
 					InitiatorPassword: initiatorPassword,
 					TargetPassword:    targetPassword,
@@ -348,10 +579,12 @@ func (s *VolumeSyncer) syncConnect(ctx context.Context, volumedb *database.Volum
 			case database.VolumeTransportTypeNVMEOF_TCP:
 				err := nvmeof.With(connectExecutor).ConnectTarget(ctx, nvmeof.ConnectTargetArguments{
 					TargetNQN:         nvmeof.NQN(targetID),
-					TargetAddress:     targetAddress,
+					TargetAddresses:   targetAddresses,
 					InitiatorNQN:      nvmeof.NQN(clientID),
+					HostID:            getHostID(clientID, connectHost),
 					InitiatorPassword: initiatorPassword,
 					TargetPassword:    targetPassword,
+					TLSKey:            tlsKey,
 				})
 				if err != nil {
 					return fmt.Errorf("failed to connect nvmeof volume: %w", err)
@@ -364,14 +597,13 @@ func (s *VolumeSyncer) syncConnect(ctx context.Context, volumedb *database.Volum
 		}
 	} else {
 		// Reconcile connection.
-		isConnected := checkConnected(volumedb.Transport, targetID)
+		isConnected, _ := checkConnected(volumedb.Transport, targetID, targetAddresses)
 		if isConnected {
 			slogctx.Info(ctx, "disconnecting volume during sync", "volumeId", volumedb.ID)
 			switch volumedb.Transport.Data().Type {
 			case database.VolumeTransportTypeISCSI:
 				err := iscsi.With(connectExecutor).DisconnectTarget(ctx, iscsi.DisconnectTargetArguments{
-					TargetIQN:     iscsi.IQN(targetID),
-					TargetAddress: targetAddress,
+					TargetIQN: iscsi.IQN(targetID),
 				})
 				if err != nil {
 					return fmt.Errorf("failed to disconnect iscsi volume: %w", err)
@@ -422,8 +654,8 @@ func (s *VolumeSyncer) syncConnect(ctx context.Context, volumedb *database.Volum
 	return nil
 }
 
-func (s *VolumeSyncer) syncStage(ctx context.Context, volumedb *database.Volume) error {
-	if volumedb.ServerHost == "" || volumedb.ClientHost == "" || volumedb.StagingPath == "" {
+func (s *VolumeSyncer) syncStage(ctx context.Context, volumedb *database.Volume, attachment *database.VolumeAttachment) error {
+	if volumedb.ServerHost == "" || attachment.StagingPath == "" {
 		return nil
 	}
 
@@ -432,7 +664,7 @@ func (s *VolumeSyncer) syncStage(ctx context.Context, volumedb *database.Volume)
 		return err
 	}
 	_ = publishExecutor
-	connectExecutor, _, err := s.getExecutorForHost(ctx, volumedb.ClientHost)
+	connectExecutor, _, err := s.getExecutorForHost(ctx, attachment.ClientHost)
 	if err != nil {
 		return err
 	}
@@ -442,10 +674,10 @@ func (s *VolumeSyncer) syncStage(ctx context.Context, volumedb *database.Volume)
 		return isMounted
 	}
 
-	if volumedb.IsStaged() {
-		isMounted := checkMounted(volumedb.StagingPath)
+	if attachment.IsStaged() {
+		isMounted := checkMounted(attachment.StagingPath)
 		if !isMounted {
-			slogctx.Info(ctx, "staging volume during sync", "volumeId", volumedb.ID, "stagingPath", volumedb.StagingPath)
+			slogctx.Info(ctx, "staging volume during sync", "volumeId", volumedb.ID, "clientHost", attachment.ClientHost, "stagingPath", attachment.StagingPath)
 
 			getTargetID := func(volumedb *database.Volume, host *database.Host) string {
 				switch volumedb.Transport.Data().Type {
@@ -461,60 +693,42 @@ func (s *VolumeSyncer) syncStage(ctx context.Context, volumedb *database.Volume)
 					return ""
 				}
 			}
-			targetID := getTargetID(volumedb, publishHost)
-			targetAddress := publishHost.Connection.Data().Remote.Address
-			devicePattern, err := volumedb.DevicePathClient(targetAddress, targetID)
-			if err != nil {
-				return fmt.Errorf("failed to get device path: %w", err)
-			}
-			devicePath, err := fs.With(connectExecutor).ResolveDevice(ctx, devicePattern)
-			if err != nil {
-				return fmt.Errorf("failed to resolve device path: %w", err)
-			}
 
-			if volumedb.Mode == database.VolumeModeFILESYSTEM {
-				fsType, err := fs.With(connectExecutor).GetFSType(ctx, devicePath)
+			// A dataset is mounted from its export, with no device to resolve.
+			var devicePath string
+			if volumedb.Transport.Data().Type != database.VolumeTransportTypeNFS {
+				targetID := getTargetID(volumedb, publishHost)
+				targetAddress := publishHost.Connection.Data().Remote.Address
+				devicePattern, err := volumedb.DevicePathClient(targetAddress, targetID)
+				if err != nil {
+					return fmt.Errorf("failed to get device path: %w", err)
+				}
+				devicePath, err = fs.With(connectExecutor).ResolveDevice(ctx, devicePattern)
 				if err != nil {
-					return fmt.Errorf("failed to get filesystem type: %w", err)
+					return fmt.Errorf("failed to resolve device path: %w", err)
 				}
-				if fsType == "" {
-					err = fs.With(connectExecutor).Format(ctx, fs.FormatArguments{
-						Device:        devicePath,
-						WaitForDevice: false,
-					})
-					if err != nil {
-						return fmt.Errorf("failed to format device: %w", err)
-					}
+
+				if err := prepareStageDevice(ctx, connectExecutor, volumedb, attachment, devicePath); err != nil {
+					return err
 				}
 			}
 
-			_, err = literal.With(connectExecutor).Run(ctx, fmt.Sprintf("mkdir -m 0750 -p %s", volumedb.StagingPath))
+			_, err = literal.With(connectExecutor).Run(ctx, fmt.Sprintf("mkdir -m 0750 -p %s", attachment.StagingPath))
 			if err != nil {
 				return fmt.Errorf("failed to create staging path: %w", err)
 			}
 
-			mountArgs := mount.MountArguments{
-				SourcePath: devicePath,
-				TargetPath: volumedb.StagingPath,
-			}
-			if volumedb.Mode == database.VolumeModeFILESYSTEM {
-				mountArgs.FSType = "ext4"
-				mountArgs.Options = []string{"defaults"}
-			} else {
-				mountArgs.Options = []string{"bind"}
-			}
-
-			err = mount.With(connectExecutor).Mount(ctx, mountArgs)
+			err = mount.With(connectExecutor).Mount(ctx, getStageMountArguments(volumedb, attachment, devicePath))
 			if err != nil {
 				return fmt.Errorf("failed to stage volume: %w", err)
 			}
 		}
 	} else {
-		isMounted := checkMounted(volumedb.StagingPath)
+		isMounted := checkMounted(attachment.StagingPath)
 		if isMounted {
-			slogctx.Info(ctx, "unstaging volume during sync", "volumeId", volumedb.ID)
+			slogctx.Info(ctx, "unstaging volume during sync", "volumeId", volumedb.ID, "clientHost", attachment.ClientHost)
 			err := mount.With(connectExecutor).Umount(ctx, mount.UmountArguments{
-				Path: volumedb.StagingPath,
+				Path: attachment.StagingPath,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to unstage volume: %w", err)
@@ -525,12 +739,12 @@ func (s *VolumeSyncer) syncStage(ctx context.Context, volumedb *database.Volume)
 	return nil
 }
 
-func (s *VolumeSyncer) syncMount(ctx context.Context, volumedb *database.Volume) error {
-	if volumedb.ClientHost == "" || volumedb.StagingPath == "" {
+func (s *VolumeSyncer) syncMount(ctx context.Context, volumedb *database.Volume, attachment *database.VolumeAttachment) error {
+	if attachment.StagingPath == "" {
 		return nil
 	}
 
-	connectExecutor, _, err := s.getExecutorForHost(ctx, volumedb.ClientHost)
+	connectExecutor, _, err := s.getExecutorForHost(ctx, attachment.ClientHost)
 	if err != nil {
 		return err
 	}
@@ -541,10 +755,10 @@ func (s *VolumeSyncer) syncMount(ctx context.Context, volumedb *database.Volume)
 	}
 
 	// Reconcile TargetPaths.
-	for _, targetPath := range volumedb.TargetPaths {
+	for _, targetPath := range attachment.TargetPaths {
 		isMounted := checkMounted(targetPath)
 		if !isMounted {
-			slogctx.Info(ctx, "mounting volume during sync", "volumeId", volumedb.ID, "targetPath", targetPath)
+			slogctx.Info(ctx, "mounting volume during sync", "volumeId", volumedb.ID, "clientHost", attachment.ClientHost, "targetPath", targetPath)
 
 			if volumedb.Mode == database.VolumeModeBLOCK {
 				_, err := literal.With(connectExecutor).Run(ctx, fmt.Sprintf("install -m 0644 /dev/null %s", targetPath))
@@ -558,16 +772,12 @@ func (s *VolumeSyncer) syncMount(ctx context.Context, volumedb *database.Volume)
 				}
 			}
 
-			err := mount.With(connectExecutor).Mount(ctx, mount.MountArguments{
-				SourcePath: volumedb.StagingPath,
-				TargetPath: targetPath,
-				Options:    []string{"bind"},
-			})
+			err := mount.With(connectExecutor).Mount(ctx, getTargetMountArguments(attachment, targetPath))
 			if err != nil {
 				return fmt.Errorf("failed to bind mount volume: %w", err)
 			}
 
-			if volumedb.Mode == database.VolumeModeFILESYSTEM {
+			if !isReadOnlyTargetPath(attachment, targetPath) && (volumedb.Mode == database.VolumeModeFILESYSTEM || volumedb.Mode == database.VolumeModeDATASET) {
 				_, err = literal.With(connectExecutor).Run(ctx, fmt.Sprintf("chmod 0777 %s", targetPath))
 				if err != nil {
 					return fmt.Errorf("failed to chmod mount path: %w", err)
@@ -576,8 +786,8 @@ func (s *VolumeSyncer) syncMount(ctx context.Context, volumedb *database.Volume)
 		}
 	}
 
-	if !volumedb.IsMounted() {
-		for _, targetPath := range volumedb.TargetPaths {
+	if !attachment.IsMounted() {
+		for _, targetPath := range attachment.TargetPaths {
 			isMounted := checkMounted(targetPath)
 			if isMounted {
 				slogctx.Info(ctx, "unmounting volume during sync", "volumeId", volumedb.ID, "targetPath", targetPath)
@@ -17,6 +17,7 @@ import (
 	"github.com/jovulic/zfsilo/app/internal/command"
 	"github.com/jovulic/zfsilo/app/internal/config"
 	converteriface "github.com/jovulic/zfsilo/app/internal/converter/iface"
+	"github.com/jovulic/zfsilo/app/internal/objectstore"
 	"github.com/jovulic/zfsilo/lib/selfcert"
 	"github.com/samber/lo"
 	"github.com/skovtunenko/graterm"
@@ -29,6 +30,13 @@ var WireSet = wire.NewSet(
 	WireVolumeSyncer,
 	WireVolumeService,
 	WireHostService,
+	WirePoolService,
+	WireSnapshotPolicyService,
+	WireSnapshotScheduler,
+	WirePoolMonitor,
+	WireReplicator,
+	WireReplicationService,
+	WireExporter,
 	WireServer,
 )
 
@@ -42,8 +50,99 @@ func WireService(
 func WireHostService(
 	database *gorm.DB,
 	converter converteriface.HostConverter,
+	executorFactory *command.ExecutorFactory,
 ) *HostService {
-	return NewHostService(database, converter)
+	return NewHostService(database, converter, executorFactory)
+}
+
+func WirePoolService(
+	database *gorm.DB,
+	executorFactory *command.ExecutorFactory,
+) *PoolService {
+	return NewPoolService(database, executorFactory)
+}
+
+func WireSnapshotPolicyService(
+	database *gorm.DB,
+	converter converteriface.SnapshotPolicyConverter,
+) *SnapshotPolicyService {
+	return NewSnapshotPolicyService(database, converter)
+}
+
+func WireSnapshotScheduler(
+	ctx context.Context,
+	term *graterm.Terminator,
+	database *gorm.DB,
+	executorFactory *command.ExecutorFactory,
+) *SnapshotScheduler {
+	scheduler := NewSnapshotScheduler(database, executorFactory)
+
+	ctx, cancel := context.WithCancel(ctx)
+	scheduler.Start(ctx)
+	term.
+		WithOrder(4).
+		WithName("snapshot-scheduler").
+		Register(time.Minute, func(ctx context.Context) {
+			cancel()
+		})
+
+	return scheduler
+}
+
+func WirePoolMonitor(
+	ctx context.Context,
+	term *graterm.Terminator,
+	database *gorm.DB,
+	executorFactory *command.ExecutorFactory,
+) *PoolMonitor {
+	monitor := NewPoolMonitor(database, executorFactory)
+
+	ctx, cancel := context.WithCancel(ctx)
+	monitor.Start(ctx)
+	term.
+		WithOrder(4).
+		WithName("pool-monitor").
+		Register(time.Minute, func(ctx context.Context) {
+			cancel()
+		})
+
+	return monitor
+}
+
+func WireReplicator(
+	ctx context.Context,
+	term *graterm.Terminator,
+	database *gorm.DB,
+	executorFactory *command.ExecutorFactory,
+) *Replicator {
+	replicator := NewReplicator(database, executorFactory)
+
+	ctx, cancel := context.WithCancel(ctx)
+	replicator.Start(ctx)
+	term.
+		WithOrder(4).
+		WithName("replicator").
+		Register(time.Minute, func(ctx context.Context) {
+			cancel()
+		})
+
+	return replicator
+}
+
+func WireReplicationService(
+	database *gorm.DB,
+	converter converteriface.ReplicationConverter,
+	replicator *Replicator,
+) *ReplicationService {
+	return NewReplicationService(database, converter, replicator)
+}
+
+func WireExporter(
+	database *gorm.DB,
+	executorFactory *command.ExecutorFactory,
+	store *objectstore.Client,
+) *Exporter {
+	return NewExporter(database, executorFactory, store)
 }
 
 func WireVolumeSyncer(
@@ -56,10 +155,14 @@ func WireVolumeSyncer(
 func WireVolumeService(
 	database *gorm.DB,
 	converter converteriface.VolumeConverter,
+	snapshotConverter converteriface.SnapshotConverter,
+	snapshotGroupConverter converteriface.SnapshotGroupConverter,
 	executorFactory *command.ExecutorFactory,
 	syncer *VolumeSyncer,
+	replicator *Replicator,
+	exporter *Exporter,
 ) *VolumeService {
-	return NewVolumeService(database, converter, executorFactory, syncer)
+	return NewVolumeService(database, converter, snapshotConverter, snapshotGroupConverter, executorFactory, syncer, replicator, exporter)
 }
 
 func WireServer(
@@ -69,6 +172,9 @@ func WireServer(
 	service *Service,
 	volumeService *VolumeService,
 	hostService *HostService,
+	poolService *PoolService,
+	snapshotPolicyService *SnapshotPolicyService,
+	replicationService *ReplicationService,
 ) (*http.Server, error) {
 	cert, err := selfcert.GenerateCertificate()
 	if err != nil {
@@ -138,12 +244,54 @@ func WireServer(
 			mux.Handle(path, handler)
 		}
 
+		// Register pool service.
+		{
+			path, handler := zfsilov1connect.NewPoolServiceHandler(
+				poolService,
+				connect.WithInterceptors(
+					logInterceptor,
+					authnzInterceptor,
+					validateInterceptor,
+				),
+			)
+			mux.Handle(path, handler)
+		}
+
+		// Register snapshot policy service.
+		{
+			path, handler := zfsilov1connect.NewSnapshotPolicyServiceHandler(
+				snapshotPolicyService,
+				connect.WithInterceptors(
+					logInterceptor,
+					authnzInterceptor,
+					validateInterceptor,
+				),
+			)
+			mux.Handle(path, handler)
+		}
+
+		// Register replication service.
+		{
+			path, handler := zfsilov1connect.NewReplicationServiceHandler(
+				replicationService,
+				connect.WithInterceptors(
+					logInterceptor,
+					authnzInterceptor,
+					validateInterceptor,
+				),
+			)
+			mux.Handle(path, handler)
+		}
+
 		// Register grpc health.
 		{
 			checker := grpchealth.NewStaticChecker(
 				zfsilov1connect.ServiceName,
 				zfsilov1connect.VolumeServiceName,
 				zfsilov1connect.HostServiceName,
+				zfsilov1connect.PoolServiceName,
+				zfsilov1connect.SnapshotPolicyServiceName,
+				zfsilov1connect.ReplicationServiceName,
 			)
 			mux.Handle(grpchealth.NewHandler(checker,
 				connect.WithInterceptors(
@@ -159,6 +307,9 @@ func WireServer(
 			zfsilov1connect.ServiceName,
 			zfsilov1connect.VolumeServiceName,
 			zfsilov1connect.HostServiceName,
+			zfsilov1connect.PoolServiceName,
+			zfsilov1connect.SnapshotPolicyServiceName,
+			zfsilov1connect.ReplicationServiceName,
 			grpchealth.HealthV1ServiceName,
 		)
 		mux.Handle(grpcreflect.NewHandlerV1(reflector))
@@ -9,26 +9,33 @@ import (
 
 	"github.com/jovulic/zfsilo/app/internal/config"
 	"github.com/jovulic/zfsilo/app/internal/database"
+	"github.com/jovulic/zfsilo/app/internal/service"
 	slogctx "github.com/veqryn/slog-context"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
 type App struct {
-	server *http.Server
-	db     *gorm.DB
-	conf   config.Config
+	server    *http.Server
+	scheduler *service.SnapshotScheduler
+	monitor   *service.PoolMonitor
+	db        *gorm.DB
+	conf      config.Config
 }
 
 func NewApp(
 	server *http.Server,
+	scheduler *service.SnapshotScheduler,
+	monitor *service.PoolMonitor,
 	db *gorm.DB,
 	conf config.Config,
 ) *App {
 	return &App{
-		server: server,
-		db:     db,
-		conf:   conf,
+		server:    server,
+		scheduler: scheduler,
+		monitor:   monitor,
+		db:        db,
+		conf:      conf,
 	}
 }
 
@@ -48,11 +55,24 @@ func SyncHosts(ctx context.Context, db *gorm.DB, conf config.Config) error {
 		case "SERVER":
 			role.Type = database.HostRoleTypeServer
 			role.Server = &database.HostRoleServer{
-				Endpoint: cfgHost.Endpoint,
+				Endpoint:       cfgHost.Endpoint,
+				DataAddresses:  cfgHost.DataAddresses,
+				Datasets:       cfgHost.Datasets,
+				ScrubSchedules: cfgHost.ScrubSchedules,
+			}
+			for _, port := range cfgHost.NVMeOFPorts {
+				role.Server.NVMeOFPorts = append(role.Server.NVMeOFPorts, database.HostNVMeOFPort{
+					ID:        port.ID,
+					Address:   port.Address,
+					ServiceID: port.ServiceID,
+					TLS:       port.TLS,
+				})
 			}
 		case "CLIENT":
 			role.Type = database.HostRoleTypeClient
-			role.Client = &database.HostRoleClient{}
+			role.Client = &database.HostRoleClient{
+				Endpoint: cfgHost.Endpoint,
+			}
 		}
 
 		host := &database.Host{
@@ -91,6 +111,19 @@ func SyncHosts(ctx context.Context, db *gorm.DB, conf config.Config) error {
 				}
 				return err
 			}
+			// The credentials of volumes are derived from the key of their
+			// hosts when they are published, so a key changed in config does
+			// not reach them by itself.
+			if existing.Key != host.Key {
+				var count int64
+				err := tx.Model(&database.Volume{}).Where("server_host = ? OR standby_host = ? OR EXISTS (SELECT 1 FROM json_each(attachments) WHERE json_extract(value, '$.clientHost') = ?)", host.Name, host.Name, host.Name).Count(&count).Error
+				if err != nil {
+					return fmt.Errorf("failed to check volume references of host %s: %w", id, err)
+				}
+				if count > 0 {
+					slogctx.Warn(ctx, "host key changed in config, rotate the host key to update its volumes", slog.String("hostId", id), slog.Int64("volumes", count))
+				}
+			}
 			return tx.Model(&existing).Updates(host).Error
 		})
 		if err != nil {
@@ -109,7 +142,10 @@ func SyncHosts(ctx context.Context, db *gorm.DB, conf config.Config) error {
 		if _, ok := configHostIDs[host.ID]; !ok {
 			// Check if referenced by volumes.
 			var count int64
-			db.Model(&database.Volume{}).Where("server_host = ? OR client_host = ?", host.Name, host.Name).Count(&count)
+			err := db.Model(&database.Volume{}).Where("server_host = ? OR standby_host = ? OR EXISTS (SELECT 1 FROM json_each(attachments) WHERE json_extract(value, '$.clientHost') = ?)", host.Name, host.Name, host.Name).Count(&count).Error
+			if err != nil {
+				return fmt.Errorf("failed to check volume references of host %s: %w", host.ID, err)
+			}
 			if count > 0 {
 				slogctx.Error(ctx, "host is missing from config but still referenced by volumes, skipping deletion", slog.String("hostId", host.ID))
 				continue
@@ -10,6 +10,7 @@ import (
 	"github.com/jovulic/zfsilo/app/internal/config"
 	"github.com/jovulic/zfsilo/app/internal/converter"
 	"github.com/jovulic/zfsilo/app/internal/database"
+	"github.com/jovulic/zfsilo/app/internal/objectstore"
 	"github.com/jovulic/zfsilo/app/internal/service"
 	"github.com/skovtunenko/graterm"
 )
@@ -19,6 +20,6 @@ func WireApp(
 	conf config.Config,
 	term *graterm.Terminator,
 ) (*App, error) {
-	wire.Build(service.WireSet, database.WireSet, converter.WireSet, command.WireSet, NewApp)
+	wire.Build(service.WireSet, database.WireSet, converter.WireSet, command.WireSet, objectstore.WireSet, NewApp)
 	return new(App), nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/jovulic/zfsilo/app/internal/config"
 	"github.com/jovulic/zfsilo/app/internal/converter"
 	"github.com/jovulic/zfsilo/app/internal/database"
+	"github.com/jovulic/zfsilo/app/internal/objectstore"
 	"github.com/jovulic/zfsilo/app/internal/service"
 	"github.com/skovtunenko/graterm"
 )
@@ -27,13 +28,28 @@ func WireApp(ctx context.Context, conf config.Config, term *graterm.Terminator)
 	serviceService := service.WireService(db, executorFactory)
 	volumeConverter := converter.WireVolumeConverter()
 	volumeSyncer := service.WireVolumeSyncer(db, executorFactory)
-	volumeService := service.WireVolumeService(db, volumeConverter, executorFactory, volumeSyncer)
+	snapshotConverter := converter.WireSnapshotConverter()
+	replicator := service.WireReplicator(ctx, term, db, executorFactory)
+	client, err := objectstore.WireClient(conf)
+	if err != nil {
+		return nil, err
+	}
+	exporter := service.WireExporter(db, executorFactory, client)
+	snapshotGroupConverter := converter.WireSnapshotGroupConverter()
+	volumeService := service.WireVolumeService(db, volumeConverter, snapshotConverter, snapshotGroupConverter, executorFactory, volumeSyncer, replicator, exporter)
 	hostConverter := converter.WireHostConverter()
-	hostService := service.WireHostService(db, hostConverter)
-	server, err := service.WireServer(ctx, conf, term, serviceService, volumeService, hostService)
+	hostService := service.WireHostService(db, hostConverter, executorFactory)
+	poolService := service.WirePoolService(db, executorFactory)
+	snapshotPolicyConverter := converter.WireSnapshotPolicyConverter()
+	snapshotPolicyService := service.WireSnapshotPolicyService(db, snapshotPolicyConverter)
+	replicationConverter := converter.WireReplicationConverter()
+	replicationService := service.WireReplicationService(db, replicationConverter, replicator)
+	server, err := service.WireServer(ctx, conf, term, serviceService, volumeService, hostService, poolService, snapshotPolicyService, replicationService)
 	if err != nil {
 		return nil, err
 	}
-	app := NewApp(server, db, conf)
+	snapshotScheduler := service.WireSnapshotScheduler(ctx, term, db, executorFactory)
+	poolMonitor := service.WirePoolMonitor(ctx, term, db, executorFactory)
+	app := NewApp(server, snapshotScheduler, poolMonitor, db, conf)
 	return app, nil
 }
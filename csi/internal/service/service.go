@@ -17,6 +17,7 @@ import (
 	"github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1/zfsilov1connect"
 	"github.com/jovulic/zfsilo/csi/internal/extvar"
 	"github.com/jovulic/zfsilo/lib/structutil"
+	"github.com/jovulic/zfsilo/lib/zfsprop"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -35,11 +36,18 @@ func (dict Parameters) ParentDatasetID() string {
 	return dict["parent_dataset_id"]
 }
 
-func (dict Parameters) Options() []ParameterOption {
+// Options returns the ZFS options given as parameters, validating them for a
+// dataset of the type, either on creation or on an existing dataset.
+func (dict Parameters) Options(datasetType zfsprop.DatasetType, create bool) ([]ParameterOption, error) {
 	// NOTE: Options are defined as parameters that have an o_ prefix.
 	var options []ParameterOption
 	for key, value := range dict {
 		if key, ok := strings.CutPrefix(key, "o_"); ok {
+			// The errors of the catalogue name the property, and are the same
+			// the application returns for the option.
+			if err := zfsprop.Validate(key, value, datasetType, create); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
 			option := ParameterOption{
 				Key:   key,
 				Value: value,
@@ -47,7 +55,7 @@ func (dict Parameters) Options() []ParameterOption {
 			options = append(options, option)
 		}
 	}
-	return options
+	return options, nil
 }
 
 func (dict Parameters) Sparse() bool {
@@ -55,11 +63,35 @@ func (dict Parameters) Sparse() bool {
 	return value == "true"
 }
 
+func (dict Parameters) Encrypted() bool {
+	value := dict["encrypted"]
+	return value == "true"
+}
+
+// MkfsOptions returns the options passed to mkfs when a filesystem volume is
+// formatted, given as a space separated list.
+func (dict Parameters) MkfsOptions() []string {
+	return strings.Fields(dict["mkfs_options"])
+}
+
+// TLS returns whether clients connect to the volume over NVMe/TCP with TLS.
+func (dict Parameters) TLS() bool {
+	value := dict["tls"]
+	return value == "true"
+}
+
+func (dict Parameters) Promote() bool {
+	value := dict["promote"]
+	return value == "true"
+}
+
 func (dict Parameters) Transport() (zfsilov1.Volume_Transport, error) {
 	val := dict["transport"]
 	switch strings.ToLower(val) {
 	case "nvmeof", "nvmeof_tcp":
 		return zfsilov1.Volume_TRANSPORT_NVMEOF_TCP, nil
+	case "nfs":
+		return zfsilov1.Volume_TRANSPORT_NFS, nil
 	case "", "iscsi":
 		return zfsilov1.Volume_TRANSPORT_ISCSI, nil
 	default:
@@ -79,6 +111,7 @@ type CSIServiceConfig struct {
 type CSIService struct {
 	csi.UnimplementedIdentityServer
 	csi.UnimplementedControllerServer
+	csi.UnimplementedGroupControllerServer
 	csi.UnimplementedNodeServer
 
 	secret        string
@@ -165,6 +198,13 @@ func (s *CSIService) GetPluginCapabilities(context.Context, *csi.GetPluginCapabi
 					},
 				},
 			},
+			{
+				Type: &csi.PluginCapability_Service_{
+					Service: &csi.PluginCapability_Service{
+						Type: csi.PluginCapability_Service_GROUP_CONTROLLER_SERVICE,
+					},
+				},
+			},
 			{
 				Type: &csi.PluginCapability_VolumeExpansion_{
 					VolumeExpansion: &csi.PluginCapability_VolumeExpansion{
@@ -195,22 +235,141 @@ func (s *CSIService) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequ
 	id := toVolumeID(name)
 	datasetID := toDatasetID(name, params.ParentDatasetID())
 
-	// Determine mode. Default to filesystem if not specified.
+	transport, err := params.Transport()
+	if err != nil {
+		return nil, err
+	}
+	if params.TLS() && transport != zfsilov1.Volume_TRANSPORT_NVMEOF_TCP {
+		return nil, status.Error(codes.InvalidArgument, "tls is only supported with the nvmeof transport")
+	}
+
+	// Determine mode. Default to filesystem if not specified, or a dataset
+	// when shared over NFS.
 	mode := zfsilov1.Volume_MODE_FILESYSTEM
+	if transport == zfsilov1.Volume_TRANSPORT_NFS {
+		mode = zfsilov1.Volume_MODE_DATASET
+	}
 	for _, cap := range req.GetVolumeCapabilities() {
 		if cap.GetBlock() != nil {
+			if transport == zfsilov1.Volume_TRANSPORT_NFS {
+				return nil, status.Error(codes.InvalidArgument, "block volumes cannot use the nfs transport")
+			}
 			mode = zfsilov1.Volume_MODE_BLOCK
 			break
 		}
 	}
+	// Each node mounts a filesystem on its own, so only a dataset shared over
+	// NFS can be mounted by several writers. Raw block devices are left to
+	// the workload to coordinate. Readers are refused over NVMe-oF, whose
+	// target cannot refuse the writes of a node.
+	for _, cap := range req.GetVolumeCapabilities() {
+		if isMultiWriter(cap) && cap.GetMount() != nil && transport != zfsilov1.Volume_TRANSPORT_NFS {
+			return nil, status.Error(codes.InvalidArgument, "multi node writers of a mount volume require the nfs transport")
+		}
+		if isReaderOnly(cap) && transport == zfsilov1.Volume_TRANSPORT_NVMEOF_TCP {
+			return nil, status.Error(codes.InvalidArgument, "reader only access modes are not supported with the nvmeof transport")
+		}
+	}
+
+	// The filesystem type and mount flags of the mount capabilities are kept
+	// on the volume, where they are used when it is staged.
+	fsType := zfsilov1.Volume_FS_TYPE_UNSPECIFIED
+	var mountOptions []string
+	if mode != zfsilov1.Volume_MODE_BLOCK {
+		for _, cap := range req.GetVolumeCapabilities() {
+			mount := cap.GetMount()
+			if mount == nil {
+				continue
+			}
+			capFSType, err := toFSType(mount.GetFsType())
+			if err != nil {
+				return nil, err
+			}
+			if capFSType != zfsilov1.Volume_FS_TYPE_UNSPECIFIED {
+				if fsType != zfsilov1.Volume_FS_TYPE_UNSPECIFIED && fsType != capFSType {
+					return nil, status.Error(codes.InvalidArgument, "volume capabilities request different filesystem types")
+				}
+				fsType = capFSType
+			}
+			for _, flag := range mount.GetMountFlags() {
+				if !slices.Contains(mountOptions, flag) {
+					mountOptions = append(mountOptions, flag)
+				}
+			}
+		}
+	}
+	var mkfsOptions []string
+	if mode == zfsilov1.Volume_MODE_FILESYSTEM {
+		mkfsOptions = params.MkfsOptions()
+	} else {
+		fsType = zfsilov1.Volume_FS_TYPE_UNSPECIFIED
+	}
+
+	volContext := make(map[string]string)
+	if storageHost := params["storage_host"]; storageHost != "" {
+		volContext["storage_host"] = storageHost
+	}
+	if params.TLS() {
+		volContext["tls"] = "true"
+	}
 
-	// Determine capacity. Defaults to 1GB.
+	// Determine the content source. A volume restored from a snapshot, or
+	// cloned from another volume, must live on the same host as its source.
+	var sourceSnapshot *zfsilov1.Snapshot
+	if snapshotSource := req.GetVolumeContentSource().GetSnapshot(); snapshotSource != nil {
+		getResp, err := s.volumeClient.GetSnapshot(ctx, connect.NewRequest(&zfsilov1.GetSnapshotRequest{Id: snapshotSource.GetSnapshotId()}))
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound || isErrorID(err) {
+				return nil, status.Errorf(codes.NotFound, "source snapshot %s not found", snapshotSource.GetSnapshotId())
+			}
+			return nil, mapError(err)
+		}
+		sourceSnapshot = getResp.Msg.Snapshot
+		if sourceSnapshot.ServerHost != nil && *sourceSnapshot.ServerHost != "" {
+			volContext["storage_host"] = *sourceSnapshot.ServerHost
+		}
+	}
+	var sourceVolume *zfsilov1.Volume
+	if volumeSource := req.GetVolumeContentSource().GetVolume(); volumeSource != nil {
+		getResp, err := s.volumeClient.GetVolume(ctx, connect.NewRequest(&zfsilov1.GetVolumeRequest{Id: volumeSource.GetVolumeId()}))
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound || isErrorID(err) {
+				return nil, status.Errorf(codes.NotFound, "source volume %s not found", volumeSource.GetVolumeId())
+			}
+			return nil, mapError(err)
+		}
+		sourceVolume = getResp.Msg.Volume
+		if sourceVolume.ServerHost != nil && *sourceVolume.ServerHost != "" {
+			volContext["storage_host"] = *sourceVolume.ServerHost
+		}
+	}
+
+	// Determine capacity. Defaults to the source capacity or 1GB.
 	capacityBytes := req.GetCapacityRange().GetRequiredBytes()
 	if capacityBytes == 0 {
 		capacityBytes = 1 * 1024 * 1024 * 1024
+		if sourceSnapshot != nil {
+			capacityBytes = sourceSnapshot.CapacityBytes
+		}
+		if sourceVolume != nil {
+			capacityBytes = sourceVolume.CapacityBytes
+		}
+	}
+	if sourceSnapshot != nil && capacityBytes < sourceSnapshot.CapacityBytes {
+		return nil, status.Errorf(codes.OutOfRange, "requested capacity %d is smaller than source snapshot capacity %d", capacityBytes, sourceSnapshot.CapacityBytes)
+	}
+	if sourceVolume != nil && capacityBytes < sourceVolume.CapacityBytes {
+		return nil, status.Errorf(codes.OutOfRange, "requested capacity %d is smaller than source volume capacity %d", capacityBytes, sourceVolume.CapacityBytes)
 	}
 
-	options := params.Options()
+	datasetType := zfsprop.DatasetTypeVolume
+	if mode == zfsilov1.Volume_MODE_DATASET {
+		datasetType = zfsprop.DatasetTypeFilesystem
+	}
+	options, err := params.Options(datasetType, true)
+	if err != nil {
+		return nil, err
+	}
 	zfsOptions := make([]*zfsilov1.Volume_Option, 0, len(options))
 	for _, opt := range options {
 		zfsOptions = append(zfsOptions, &zfsilov1.Volume_Option{
@@ -219,27 +378,31 @@ func (s *CSIService) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequ
 		})
 	}
 
-	transport, err := params.Transport()
-	if err != nil {
-		return nil, err
+	volume := &zfsilov1.Volume{
+		Id:            id,
+		Name:          toVolumeName(name),
+		DatasetId:     datasetID,
+		Mode:          mode,
+		CapacityBytes: capacityBytes,
+		Sparse:        proto.Bool(params.Sparse()),
+		Encrypted:     proto.Bool(params.Encrypted()),
+		Options:       zfsOptions,
+		Transport:     transport.Enum(),
+		FsType:        fsType,
+		MkfsOptions:   mkfsOptions,
+		MountOptions:  mountOptions,
 	}
-
-	volContext := make(map[string]string)
-	if storageHost := params["storage_host"]; storageHost != "" {
-		volContext["storage_host"] = storageHost
+	if sourceSnapshot != nil {
+		volume.SourceSnapshot = proto.String(sourceSnapshot.Id)
+		volume.Promote = proto.Bool(params.Promote())
+	}
+	if sourceVolume != nil {
+		volume.SourceVolume = proto.String(sourceVolume.Id)
+		volume.Promote = proto.Bool(params.Promote())
 	}
 
 	resp, err := s.volumeClient.CreateVolume(ctx, connect.NewRequest(&zfsilov1.CreateVolumeRequest{
-		Volume: &zfsilov1.Volume{
-			Id:            id,
-			Name:          toVolumeName(name),
-			DatasetId:     datasetID,
-			Mode:          mode,
-			CapacityBytes: capacityBytes,
-			Sparse:        proto.Bool(params.Sparse()),
-			Options:       zfsOptions,
-			Transport:     transport.Enum(),
-		},
+		Volume: volume,
 	}))
 	if err != nil {
 		if connect.CodeOf(err) == connect.CodeAlreadyExists {
@@ -251,12 +414,13 @@ func (s *CSIService) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequ
 			}
 
 			vol := getResp.Msg.Volume
-			if vol.CapacityBytes == capacityBytes && vol.DatasetId == datasetID {
+			if vol.CapacityBytes == capacityBytes && vol.DatasetId == datasetID && vol.GetSourceSnapshot() == volume.GetSourceSnapshot() && vol.GetSourceVolume() == volume.GetSourceVolume() {
 				return &csi.CreateVolumeResponse{
 					Volume: &csi.Volume{
 						VolumeId:      id,
 						CapacityBytes: vol.CapacityBytes,
 						VolumeContext: volContext,
+						ContentSource: req.GetVolumeContentSource(),
 					},
 				}, nil
 			}
@@ -270,6 +434,7 @@ func (s *CSIService) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequ
 			VolumeId:      id,
 			CapacityBytes: resp.Msg.Volume.CapacityBytes,
 			VolumeContext: volContext,
+			ContentSource: req.GetVolumeContentSource(),
 		},
 	}, nil
 }
@@ -319,26 +484,23 @@ func (s *CSIService) ControllerPublishVolume(ctx context.Context, req *csi.Contr
 		Id:         id,
 		Transport:  transport,
 		ServerHost: req.GetVolumeContext()["storage_host"],
+		Tls:        Parameters(req.GetVolumeContext()).TLS(),
 	}))
 	if err != nil {
 		return nil, mapErrorID(err)
 	}
 
-	// Connect (associate with node and login).
-	connectResp, err := s.volumeClient.ConnectVolume(ctx, connect.NewRequest(&zfsilov1.ConnectVolumeRequest{
+	// Connect (associate with node and login). A volume can be connected to
+	// several nodes at once, each with its own attachment.
+	_, err = s.volumeClient.ConnectVolume(ctx, connect.NewRequest(&zfsilov1.ConnectVolumeRequest{
 		Id:         id,
 		ClientHost: nodeID,
+		ReadOnly:   req.GetReadonly() || isReaderOnly(req.GetVolumeCapability()),
 	}))
 	if err != nil {
 		return nil, mapError(err)
 	}
 
-	// Verify it's connected to the right node ID.
-	actualClientHost := connectResp.Msg.Volume.ClientHost
-	if actualClientHost != nil && *actualClientHost != "" && *actualClientHost != nodeID {
-		return nil, status.Errorf(codes.FailedPrecondition, "volume %s is already connected to another node: %s", id, *actualClientHost)
-	}
-
 	return &csi.ControllerPublishVolumeResponse{}, nil
 }
 
@@ -361,25 +523,28 @@ func (s *CSIService) ControllerUnpublishVolume(ctx context.Context, req *csi.Con
 
 	vol := getResp.Msg.Volume
 
-	// Check node id. If it is published to a different node, it's already
-	// "unpublished".
-	connectHost := vol.ClientHost
-	if nodeID != "" && connectHost != nil && *connectHost != "" {
-		if *connectHost != nodeID {
+	// Disconnect the node, or every node when none is given. While the
+	// volume is attached to other nodes, a node it is not attached to is
+	// already "unpublished".
+	hosts := attachedHosts(vol)
+	if nodeID != "" && len(hosts) > 0 {
+		if findAttachment(vol, nodeID) == nil {
 			return &csi.ControllerUnpublishVolumeResponse{}, nil
 		}
+		hosts = []string{nodeID}
 	}
-
-	// Disconnect if connected.
-	if vol.Status >= zfsilov1.Volume_STATUS_CONNECTED {
-		_, err := s.volumeClient.DisconnectVolume(ctx, connect.NewRequest(&zfsilov1.DisconnectVolumeRequest{Id: id}))
+	for _, host := range hosts {
+		_, err := s.volumeClient.DisconnectVolume(ctx, connect.NewRequest(&zfsilov1.DisconnectVolumeRequest{
+			Id:         id,
+			ClientHost: host,
+		}))
 		if err != nil {
 			return nil, mapError(err)
 		}
 	}
 
-	// Unpublish if published.
-	if vol.Status >= zfsilov1.Volume_STATUS_PUBLISHED {
+	// Unpublish if published and no other node remains attached.
+	if vol.Status >= zfsilov1.Volume_STATUS_PUBLISHED && len(hosts) == len(vol.Attachments) {
 		_, err := s.volumeClient.UnpublishVolume(ctx, connect.NewRequest(&zfsilov1.UnpublishVolumeRequest{Id: id}))
 		if err != nil {
 			return nil, mapError(err)
@@ -414,12 +579,24 @@ func (s *CSIService) ValidateVolumeCapabilities(ctx context.Context, req *csi.Va
 			}
 		}
 		if cap.GetMount() != nil {
-			if vol.Mode != zfsilov1.Volume_MODE_FILESYSTEM {
+			if vol.Mode != zfsilov1.Volume_MODE_FILESYSTEM && vol.Mode != zfsilov1.Volume_MODE_DATASET {
 				return &csi.ValidateVolumeCapabilitiesResponse{
 					Message: fmt.Sprintf("volume %s is not in filesystem mode", id),
 				}, nil
 			}
 		}
+		if isMultiWriter(cap) && cap.GetMount() != nil {
+			if vol.Mode != zfsilov1.Volume_MODE_DATASET {
+				return &csi.ValidateVolumeCapabilitiesResponse{
+					Message: fmt.Sprintf("volume %s is not in dataset mode", id),
+				}, nil
+			}
+		}
+		if isReaderOnly(cap) && vol.GetTransport() == zfsilov1.Volume_TRANSPORT_NVMEOF_TCP {
+			return &csi.ValidateVolumeCapabilitiesResponse{
+				Message: fmt.Sprintf("volume %s cannot be connected read-only over nvmeof", id),
+			}, nil
+		}
 	}
 
 	return &csi.ValidateVolumeCapabilitiesResponse{
@@ -449,10 +626,7 @@ func (s *CSIService) ListVolumes(ctx context.Context, req *csi.ListVolumesReques
 
 	entries := make([]*csi.ListVolumesResponse_Entry, 0, len(resp.Msg.Volumes))
 	for _, vol := range resp.Msg.Volumes {
-		var publishedNodeIds []string
-		if vol.ClientHost != nil && *vol.ClientHost != "" {
-			publishedNodeIds = []string{*vol.ClientHost}
-		}
+		publishedNodeIds := attachedHosts(vol)
 
 		entries = append(entries, &csi.ListVolumesResponse_Entry{
 			Volume: &csi.Volume{
@@ -476,13 +650,16 @@ func (s *CSIService) GetCapacity(ctx context.Context, req *csi.GetCapacityReques
 		return nil, err
 	}
 
-	resp, err := s.serviceClient.GetCapacity(ctx, connect.NewRequest(&zfsilov1.GetCapacityRequest{}))
+	resp, err := s.serviceClient.GetCapacity(ctx, connect.NewRequest(&zfsilov1.GetCapacityRequest{
+		ParentDatasetId: Parameters(req.GetParameters()).ParentDatasetID(),
+	}))
 	if err != nil {
 		return nil, mapError(err)
 	}
 
 	return &csi.GetCapacityResponse{
 		AvailableCapacity: resp.Msg.AvailableCapacityBytes,
+		MaximumVolumeSize: wrapperspb.Int64(resp.Msg.MaximumVolumeSizeBytes),
 	}, nil
 }
 
@@ -503,6 +680,13 @@ func (s *CSIService) ControllerGetCapabilities(ctx context.Context, req *csi.Con
 					},
 				},
 			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_PUBLISH_READONLY,
+					},
+				},
+			},
 			{
 				Type: &csi.ControllerServiceCapability_Rpc{
 					Rpc: &csi.ControllerServiceCapability_RPC{
@@ -531,24 +715,276 @@ func (s *CSIService) ControllerGetCapabilities(ctx context.Context, req *csi.Con
 					},
 				},
 			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_CREATE_DELETE_SNAPSHOT,
+					},
+				},
+			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS,
+					},
+				},
+			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
+					},
+				},
+			},
 		},
 	}, nil
 }
 
 func (s *CSIService) CreateSnapshot(ctx context.Context, req *csi.CreateSnapshotRequest) (*csi.CreateSnapshotResponse, error) {
-	return nil, status.Errorf(codes.InvalidArgument, "method CreateSnapshot not supported")
+	if err := validateCreateSnapshotRequest(req); err != nil {
+		return nil, err
+	}
+
+	name := req.GetName()
+	id := toSnapshotID(name)
+	sourceVolumeID := req.GetSourceVolumeId()
+
+	resp, err := s.volumeClient.CreateSnapshot(ctx, connect.NewRequest(&zfsilov1.CreateSnapshotRequest{
+		Snapshot: &zfsilov1.Snapshot{
+			Id:       id,
+			Name:     toSnapshotName(name),
+			VolumeId: sourceVolumeID,
+		},
+	}))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeAlreadyExists {
+			// Check if the snapshot already exists and is compatible.
+			getResp, getErr := s.volumeClient.GetSnapshot(ctx, connect.NewRequest(&zfsilov1.GetSnapshotRequest{Id: id}))
+			if getErr != nil {
+				// Return original "already exists" error if GetSnapshot fails.
+				return nil, mapError(err)
+			}
+
+			snap := getResp.Msg.Snapshot
+			if snap.VolumeId == sourceVolumeID {
+				return &csi.CreateSnapshotResponse{
+					Snapshot: toCSISnapshot(snap),
+				}, nil
+			}
+			return nil, status.Error(codes.AlreadyExists, "snapshot already exists with different source volume")
+		}
+		return nil, mapErrorID(err)
+	}
+
+	return &csi.CreateSnapshotResponse{
+		Snapshot: toCSISnapshot(resp.Msg.Snapshot),
+	}, nil
 }
 
 func (s *CSIService) DeleteSnapshot(ctx context.Context, req *csi.DeleteSnapshotRequest) (*csi.DeleteSnapshotResponse, error) {
-	return nil, status.Errorf(codes.InvalidArgument, "method DeleteSnapshot not supported")
+	if err := validateDeleteSnapshotRequest(req); err != nil {
+		return nil, err
+	}
+
+	id := req.GetSnapshotId()
+
+	_, err := s.volumeClient.DeleteSnapshot(ctx, connect.NewRequest(&zfsilov1.DeleteSnapshotRequest{
+		Id: id,
+	}))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound || isErrorID(err) {
+			return &csi.DeleteSnapshotResponse{}, nil
+		}
+		return nil, mapError(err)
+	}
+
+	return &csi.DeleteSnapshotResponse{}, nil
 }
 
 func (s *CSIService) ListSnapshots(ctx context.Context, req *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
-	return nil, status.Errorf(codes.InvalidArgument, "method ListSnapshots not supported")
+	if err := validateListSnapshotsRequest(req); err != nil {
+		return nil, err
+	}
+
+	// If a snapshot id is given, we return at most that one snapshot.
+	if id := req.GetSnapshotId(); id != "" {
+		getResp, err := s.volumeClient.GetSnapshot(ctx, connect.NewRequest(&zfsilov1.GetSnapshotRequest{Id: id}))
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound || isErrorID(err) {
+				return &csi.ListSnapshotsResponse{}, nil
+			}
+			return nil, mapError(err)
+		}
+
+		snap := getResp.Msg.Snapshot
+		if sourceVolumeID := req.GetSourceVolumeId(); sourceVolumeID != "" && snap.VolumeId != sourceVolumeID {
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+		return &csi.ListSnapshotsResponse{
+			Entries: []*csi.ListSnapshotsResponse_Entry{
+				{Snapshot: toCSISnapshot(snap)},
+			},
+		}, nil
+	}
+
+	zreq := &zfsilov1.ListSnapshotsRequest{
+		PageSize:  req.GetMaxEntries(),
+		PageToken: req.GetStartingToken(),
+		VolumeId:  req.GetSourceVolumeId(),
+	}
+
+	resp, err := s.volumeClient.ListSnapshots(ctx, connect.NewRequest(zreq))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeInvalidArgument {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		return nil, mapError(err)
+	}
+
+	entries := make([]*csi.ListSnapshotsResponse_Entry, 0, len(resp.Msg.Snapshots))
+	for _, snap := range resp.Msg.Snapshots {
+		entries = append(entries, &csi.ListSnapshotsResponse_Entry{
+			Snapshot: toCSISnapshot(snap),
+		})
+	}
+
+	return &csi.ListSnapshotsResponse{
+		Entries:   entries,
+		NextToken: resp.Msg.NextPageToken,
+	}, nil
 }
 
 func (s *CSIService) GetSnapshot(ctx context.Context, req *csi.GetSnapshotRequest) (*csi.GetSnapshotResponse, error) {
-	return nil, status.Errorf(codes.InvalidArgument, "method GetSnapshot not supported")
+	if err := validateGetSnapshotRequest(req); err != nil {
+		return nil, err
+	}
+
+	id := req.GetSnapshotId()
+
+	resp, err := s.volumeClient.GetSnapshot(ctx, connect.NewRequest(&zfsilov1.GetSnapshotRequest{Id: id}))
+	if err != nil {
+		if isErrorID(err) {
+			return nil, status.Error(codes.NotFound, "snapshot id not found (invalid format)")
+		}
+		return nil, mapError(err)
+	}
+
+	return &csi.GetSnapshotResponse{
+		Snapshot: toCSISnapshot(resp.Msg.Snapshot),
+	}, nil
+}
+
+func (s *CSIService) GroupControllerGetCapabilities(ctx context.Context, req *csi.GroupControllerGetCapabilitiesRequest) (*csi.GroupControllerGetCapabilitiesResponse, error) {
+	return &csi.GroupControllerGetCapabilitiesResponse{
+		Capabilities: []*csi.GroupControllerServiceCapability{
+			{
+				Type: &csi.GroupControllerServiceCapability_Rpc{
+					Rpc: &csi.GroupControllerServiceCapability_RPC{
+						Type: csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (s *CSIService) CreateVolumeGroupSnapshot(ctx context.Context, req *csi.CreateVolumeGroupSnapshotRequest) (*csi.CreateVolumeGroupSnapshotResponse, error) {
+	if err := validateCreateVolumeGroupSnapshotRequest(req); err != nil {
+		return nil, err
+	}
+
+	name := req.GetName()
+	id := toSnapshotGroupID(name)
+	sourceVolumeIDs := req.GetSourceVolumeIds()
+
+	resp, err := s.volumeClient.CreateSnapshotGroup(ctx, connect.NewRequest(&zfsilov1.CreateSnapshotGroupRequest{
+		SnapshotGroup: &zfsilov1.SnapshotGroup{
+			Id:        id,
+			Name:      toSnapshotGroupName(name),
+			VolumeIds: sourceVolumeIDs,
+		},
+	}))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeAlreadyExists {
+			// Check if the group snapshot already exists and is compatible.
+			getResp, getErr := s.volumeClient.GetSnapshotGroup(ctx, connect.NewRequest(&zfsilov1.GetSnapshotGroupRequest{Id: id}))
+			if getErr != nil {
+				// Return original "already exists" error if GetSnapshotGroup fails.
+				return nil, mapError(err)
+			}
+
+			group := getResp.Msg.SnapshotGroup
+			if sameElements(group.VolumeIds, sourceVolumeIDs) {
+				return &csi.CreateVolumeGroupSnapshotResponse{
+					GroupSnapshot: toCSIVolumeGroupSnapshot(group, getResp.Msg.Snapshots),
+				}, nil
+			}
+			return nil, status.Error(codes.AlreadyExists, "group snapshot already exists with different source volumes")
+		}
+		return nil, mapErrorID(err)
+	}
+
+	return &csi.CreateVolumeGroupSnapshotResponse{
+		GroupSnapshot: toCSIVolumeGroupSnapshot(resp.Msg.SnapshotGroup, resp.Msg.Snapshots),
+	}, nil
+}
+
+func (s *CSIService) DeleteVolumeGroupSnapshot(ctx context.Context, req *csi.DeleteVolumeGroupSnapshotRequest) (*csi.DeleteVolumeGroupSnapshotResponse, error) {
+	if err := validateDeleteVolumeGroupSnapshotRequest(req); err != nil {
+		return nil, err
+	}
+
+	id := req.GetGroupSnapshotId()
+
+	getResp, err := s.volumeClient.GetSnapshotGroup(ctx, connect.NewRequest(&zfsilov1.GetSnapshotGroupRequest{Id: id}))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound || isErrorID(err) {
+			return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+		}
+		return nil, mapError(err)
+	}
+
+	// The whole group is deleted, so we refuse if the CO expects a different
+	// set of snapshots to go.
+	if snapshotIDs := req.GetSnapshotIds(); len(snapshotIDs) > 0 && !sameElements(getResp.Msg.SnapshotGroup.SnapshotIds, snapshotIDs) {
+		return nil, status.Error(codes.InvalidArgument, "snapshot ids do not match the group snapshot")
+	}
+
+	_, err = s.volumeClient.DeleteSnapshotGroup(ctx, connect.NewRequest(&zfsilov1.DeleteSnapshotGroupRequest{
+		Id: id,
+	}))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+		}
+		return nil, mapError(err)
+	}
+
+	return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+}
+
+func (s *CSIService) GetVolumeGroupSnapshot(ctx context.Context, req *csi.GetVolumeGroupSnapshotRequest) (*csi.GetVolumeGroupSnapshotResponse, error) {
+	if err := validateGetVolumeGroupSnapshotRequest(req); err != nil {
+		return nil, err
+	}
+
+	id := req.GetGroupSnapshotId()
+
+	resp, err := s.volumeClient.GetSnapshotGroup(ctx, connect.NewRequest(&zfsilov1.GetSnapshotGroupRequest{Id: id}))
+	if err != nil {
+		if isErrorID(err) {
+			return nil, status.Error(codes.NotFound, "group snapshot id not found (invalid format)")
+		}
+		return nil, mapError(err)
+	}
+
+	if snapshotIDs := req.GetSnapshotIds(); len(snapshotIDs) > 0 && !sameElements(resp.Msg.SnapshotGroup.SnapshotIds, snapshotIDs) {
+		return nil, status.Error(codes.InvalidArgument, "snapshot ids do not match the group snapshot")
+	}
+
+	return &csi.GetVolumeGroupSnapshotResponse{
+		GroupSnapshot: toCSIVolumeGroupSnapshot(resp.Msg.SnapshotGroup, resp.Msg.Snapshots),
+	}, nil
 }
 
 func (s *CSIService) ControllerExpandVolume(ctx context.Context, req *csi.ControllerExpandVolumeRequest) (*csi.ControllerExpandVolumeResponse, error) {
@@ -579,24 +1015,16 @@ func (s *CSIService) ControllerExpandVolume(ctx context.Context, req *csi.Contro
 		}, nil
 	}
 
-	// Perform expansion via update volume.
-	updateStruct, err := structpb.NewStruct(map[string]any{
-		"id":             id,
-		"capacity_bytes": float64(requiredBytes),
-	})
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	updateResp, err := s.volumeClient.UpdateVolume(ctx, connect.NewRequest(&zfsilov1.UpdateVolumeRequest{
-		Volume: updateStruct,
+	expandResp, err := s.volumeClient.ExpandVolume(ctx, connect.NewRequest(&zfsilov1.ExpandVolumeRequest{
+		Id:            id,
+		CapacityBytes: requiredBytes,
 	}))
 	if err != nil {
 		return nil, mapError(err)
 	}
 
 	return &csi.ControllerExpandVolumeResponse{
-		CapacityBytes:         updateResp.Msg.Volume.CapacityBytes,
+		CapacityBytes:         expandResp.Msg.Volume.CapacityBytes,
 		NodeExpansionRequired: true,
 	}, nil
 }
@@ -614,10 +1042,7 @@ func (s *CSIService) ControllerGetVolume(ctx context.Context, req *csi.Controlle
 	}
 
 	vol := resp.Msg.Volume
-	var publishedNodeIds []string
-	if vol.ClientHost != nil && *vol.ClientHost != "" {
-		publishedNodeIds = []string{*vol.ClientHost}
-	}
+	publishedNodeIds := attachedHosts(vol)
 
 	return &csi.ControllerGetVolumeResponse{
 		Volume: &csi.Volume{
@@ -637,7 +1062,12 @@ func (s *CSIService) ControllerModifyVolume(ctx context.Context, req *csi.Contro
 
 	id := req.GetVolumeId()
 	mutableParams := req.GetMutableParameters()
-	options := Parameters(mutableParams).Options()
+	// The options replace those of an existing volume, which may be of either
+	// dataset type.
+	options, err := Parameters(mutableParams).Options(zfsprop.DatasetTypeAll, false)
+	if err != nil {
+		return nil, err
+	}
 
 	// Convert options to backend format (list of objects with key/value).
 	zfsOptions := make([]any, 0, len(options))
@@ -683,19 +1113,59 @@ func (s *CSIService) NodeStageVolume(ctx context.Context, req *csi.NodeStageVolu
 	}
 	vol := getResp.Msg.Volume
 
-	// If already staged, check if path matches.
-	if vol.Status >= zfsilov1.Volume_STATUS_STAGED {
-		if vol.StagingPath != nil && *vol.StagingPath == stagingTargetPath {
+	// If already staged on this node, check if path matches.
+	attachment := findAttachment(vol, s.hostID)
+	if attachment != nil && attachment.StagingPath != "" {
+		if attachment.StagingPath == stagingTargetPath {
 			return &csi.NodeStageVolumeResponse{}, nil
 		}
-		return nil, status.Errorf(codes.FailedPrecondition, "volume %s is already staged at %s", id, *vol.StagingPath)
+		return nil, status.Errorf(codes.FailedPrecondition, "volume %s is already staged at %s", id, attachment.StagingPath)
+	}
+
+	// The volume is formatted with its own filesystem type, which the
+	// capability has to agree with.
+	if mount := req.GetVolumeCapability().GetMount(); mount != nil && vol.Mode == zfsilov1.Volume_MODE_FILESYSTEM {
+		capFSType, err := toFSType(mount.GetFsType())
+		if err != nil {
+			return nil, err
+		}
+		volFSType := vol.FsType
+		if volFSType == zfsilov1.Volume_FS_TYPE_UNSPECIFIED {
+			volFSType = zfsilov1.Volume_FS_TYPE_EXT4
+		}
+		if capFSType != zfsilov1.Volume_FS_TYPE_UNSPECIFIED && capFSType != volFSType {
+			return nil, status.Errorf(codes.FailedPrecondition, "volume %s has filesystem type %s, not %s", id, volFSType, capFSType)
+		}
+	}
+
+	// The mount flags of the capability replace the mount options of the
+	// volume, which are what it is staged with.
+	if mount := req.GetVolumeCapability().GetMount(); mount != nil && vol.Mode != zfsilov1.Volume_MODE_BLOCK && !sameElements(mount.GetMountFlags(), vol.MountOptions) {
+		mountOptions := make([]any, 0, len(mount.GetMountFlags()))
+		for _, flag := range mount.GetMountFlags() {
+			mountOptions = append(mountOptions, flag)
+		}
+		updateStruct, err := structpb.NewStruct(map[string]any{
+			"id":            id,
+			"mount_options": mountOptions,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		_, err = s.volumeClient.UpdateVolume(ctx, connect.NewRequest(&zfsilov1.UpdateVolumeRequest{
+			Volume: updateStruct,
+		}))
+		if err != nil {
+			return nil, mapError(err)
+		}
 	}
 
 	// Ensure connected to this node.
-	if vol.Status < zfsilov1.Volume_STATUS_CONNECTED || vol.ClientHost == nil || *vol.ClientHost != s.hostID {
+	if attachment == nil {
 		_, err := s.volumeClient.ConnectVolume(ctx, connect.NewRequest(&zfsilov1.ConnectVolumeRequest{
 			Id:         id,
 			ClientHost: s.hostID,
+			ReadOnly:   isReaderOnly(req.GetVolumeCapability()),
 		}))
 		if err != nil {
 			return nil, mapError(err)
@@ -706,6 +1176,7 @@ func (s *CSIService) NodeStageVolume(ctx context.Context, req *csi.NodeStageVolu
 	_, err = s.volumeClient.StageVolume(ctx, connect.NewRequest(&zfsilov1.StageVolumeRequest{
 		Id:          id,
 		StagingPath: stagingTargetPath,
+		ClientHost:  s.hostID,
 	}))
 	if err != nil {
 		return nil, mapError(err)
@@ -730,24 +1201,29 @@ func (s *CSIService) NodeUnstageVolume(ctx context.Context, req *csi.NodeUnstage
 		return nil, mapError(err)
 	}
 
-	vol := getResp.Msg.Volume
+	attachment := findAttachment(getResp.Msg.Volume, s.hostID)
+	if attachment == nil {
+		return &csi.NodeUnstageVolumeResponse{}, nil
+	}
 
-	// Unstage if staged.
-	if vol.Status >= zfsilov1.Volume_STATUS_STAGED {
-		_, err := s.volumeClient.UnstageVolume(ctx, connect.NewRequest(&zfsilov1.UnstageVolumeRequest{Id: id}))
+	// Unstage if staged on this node.
+	if attachment.StagingPath != "" {
+		_, err := s.volumeClient.UnstageVolume(ctx, connect.NewRequest(&zfsilov1.UnstageVolumeRequest{
+			Id:         id,
+			ClientHost: s.hostID,
+		}))
 		if err != nil {
 			return nil, mapError(err)
 		}
 	}
 
-	// Disconnect if connected to this node.
-	if vol.Status >= zfsilov1.Volume_STATUS_CONNECTED && vol.ClientHost != nil {
-		if *vol.ClientHost == s.hostID {
-			_, err := s.volumeClient.DisconnectVolume(ctx, connect.NewRequest(&zfsilov1.DisconnectVolumeRequest{Id: id}))
-			if err != nil {
-				return nil, mapError(err)
-			}
-		}
+	// Disconnect from this node.
+	_, err = s.volumeClient.DisconnectVolume(ctx, connect.NewRequest(&zfsilov1.DisconnectVolumeRequest{
+		Id:         id,
+		ClientHost: s.hostID,
+	}))
+	if err != nil {
+		return nil, mapError(err)
 	}
 
 	return &csi.NodeUnstageVolumeResponse{}, nil
@@ -763,8 +1239,10 @@ func (s *CSIService) NodePublishVolume(ctx context.Context, req *csi.NodePublish
 
 	// Mount volume.
 	_, err := s.volumeClient.MountVolume(ctx, connect.NewRequest(&zfsilov1.MountVolumeRequest{
-		Id:        id,
-		MountPath: targetPath,
+		Id:         id,
+		MountPath:  targetPath,
+		ReadOnly:   req.GetReadonly() || isReaderOnly(req.GetVolumeCapability()),
+		ClientHost: s.hostID,
 	}))
 	if err != nil {
 		return nil, mapError(err)
@@ -783,8 +1261,9 @@ func (s *CSIService) NodeUnpublishVolume(ctx context.Context, req *csi.NodeUnpub
 
 	// Unmount volume.
 	_, err := s.volumeClient.UnmountVolume(ctx, connect.NewRequest(&zfsilov1.UnmountVolumeRequest{
-		Id:        id,
-		MountPath: targetPath,
+		Id:         id,
+		MountPath:  targetPath,
+		ClientHost: s.hostID,
 	}))
 	if err != nil {
 		if connect.CodeOf(err) == connect.CodeNotFound || isErrorID(err) {
@@ -810,9 +1289,8 @@ func (s *CSIService) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVol
 		return nil, mapErrorID(err)
 	}
 
-	vol := getResp.Msg.Volume
-	found := slices.Contains(vol.TargetPaths, volumePath)
-	if !found {
+	attachment := findAttachment(getResp.Msg.Volume, s.hostID)
+	if attachment == nil || !slices.Contains(attachment.TargetPaths, volumePath) {
 		return nil, status.Errorf(codes.NotFound, "volume %s is not mounted at %s", id, volumePath)
 	}
 
@@ -845,16 +1323,25 @@ func (s *CSIService) NodeExpandVolume(ctx context.Context, req *csi.NodeExpandVo
 
 	id := req.GetVolumeId()
 
-	// Fetch current volume status to get the updated capacity.
-	// NOTE: The actual expansion (iscsi rescan and fs resize) is handled by the
-	// UpdateVolume call which is triggered by ControllerExpandVolume.
 	resp, err := s.volumeClient.GetVolume(ctx, connect.NewRequest(&zfsilov1.GetVolumeRequest{Id: id}))
 	if err != nil {
 		return nil, mapErrorID(err)
 	}
 
+	// The volume has already been grown by ControllerExpandVolume, so this
+	// grows the device and filesystem on the node to its capacity and reports
+	// the size the node ended up with.
+	capacityBytes := max(req.GetCapacityRange().GetRequiredBytes(), resp.Msg.Volume.CapacityBytes)
+	expandResp, err := s.volumeClient.ExpandVolume(ctx, connect.NewRequest(&zfsilov1.ExpandVolumeRequest{
+		Id:            id,
+		CapacityBytes: capacityBytes,
+	}))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
 	return &csi.NodeExpandVolumeResponse{
-		CapacityBytes: resp.Msg.Volume.CapacityBytes,
+		CapacityBytes: expandResp.Msg.SizeBytes,
 	}, nil
 }
 
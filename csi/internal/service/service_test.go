@@ -54,22 +54,23 @@ func wipeBackend(ctx context.Context, address, secret string) {
 
 	for _, vol := range resp.Msg.Volumes {
 		// Try to tear down if needed.
-		if vol.Status >= zfsilov1.Volume_STATUS_MOUNTED {
-			for _, path := range vol.TargetPaths {
+		for _, attachment := range vol.Attachments {
+			for _, path := range attachment.TargetPaths {
 				_, _ = client.UnmountVolume(ctx, connect.NewRequest(&zfsilov1.UnmountVolumeRequest{
-					Id:        vol.Id,
-					MountPath: path,
+					Id:         vol.Id,
+					MountPath:  path,
+					ClientHost: attachment.ClientHost,
+				}))
+			}
+			if attachment.StagingPath != "" {
+				_, _ = client.UnstageVolume(ctx, connect.NewRequest(&zfsilov1.UnstageVolumeRequest{
+					Id:         vol.Id,
+					ClientHost: attachment.ClientHost,
 				}))
 			}
-		}
-		if vol.Status >= zfsilov1.Volume_STATUS_STAGED {
-			_, _ = client.UnstageVolume(ctx, connect.NewRequest(&zfsilov1.UnstageVolumeRequest{
-				Id: vol.Id,
-			}))
-		}
-		if vol.Status >= zfsilov1.Volume_STATUS_CONNECTED {
 			_, _ = client.DisconnectVolume(ctx, connect.NewRequest(&zfsilov1.DisconnectVolumeRequest{
-				Id: vol.Id,
+				Id:         vol.Id,
+				ClientHost: attachment.ClientHost,
 			}))
 		}
 		if vol.Status >= zfsilov1.Volume_STATUS_PUBLISHED {
@@ -95,7 +96,7 @@ func TestCSISanity(t *testing.T) {
 }
 
 var _ = Describe("CSIService Sanity", func() {
-	for _, transport := range []string{"iscsi", "nvmeof"} {
+	for _, transport := range []string{"iscsi", "nvmeof", "nfs"} {
 		Context(fmt.Sprintf("with %s transport", transport), Ordered, func() {
 			var (
 				srv             *service.CSIService
@@ -1,5 +1,15 @@
 package service
 
+import (
+	"slices"
+	"strings"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	zfsilov1 "github.com/jovulic/zfsilo/api/gen/go/zfsilo/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
 func toVolumeID(name string) string {
 	return "vol_" + name
 }
@@ -10,4 +20,113 @@ func toVolumeName(name string) string {
 
 func toDatasetID(name string, parentDatasetID string) string {
 	return parentDatasetID + "/" + toVolumeID(name)
-}
\ No newline at end of file
+}
+
+func toSnapshotID(name string) string {
+	return "snp_" + name
+}
+
+func toSnapshotName(name string) string {
+	return "snapshots/" + toSnapshotID(name)
+}
+
+// sameElements returns true if both slices hold the same elements regardless
+// of order.
+func sameElements(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	return !slices.ContainsFunc(a, func(item string) bool { return !slices.Contains(b, item) })
+}
+
+func toSnapshotGroupID(name string) string {
+	return "sgp_" + name
+}
+
+func toSnapshotGroupName(name string) string {
+	return "snapshotgroups/" + toSnapshotGroupID(name)
+}
+
+func toCSISnapshot(snap *zfsilov1.Snapshot) *csi.Snapshot {
+	return &csi.Snapshot{
+		SizeBytes:       snap.CapacityBytes,
+		SnapshotId:      snap.Id,
+		SourceVolumeId:  snap.VolumeId,
+		CreationTime:    snap.CreateTime,
+		GroupSnapshotId: snap.GetSnapshotGroup(),
+		// NOTE: ZFS snapshots are consistent and usable as soon as they are
+		// taken.
+		ReadyToUse: true,
+	}
+}
+
+func toCSIVolumeGroupSnapshot(group *zfsilov1.SnapshotGroup, snaps []*zfsilov1.Snapshot) *csi.VolumeGroupSnapshot {
+	csiSnaps := make([]*csi.Snapshot, 0, len(snaps))
+	for _, snap := range snaps {
+		csiSnaps = append(csiSnaps, toCSISnapshot(snap))
+	}
+	return &csi.VolumeGroupSnapshot{
+		GroupSnapshotId: group.Id,
+		Snapshots:       csiSnaps,
+		CreationTime:    group.CreateTime,
+		// NOTE: The snapshots of a group are taken in a single zfs snapshot, so
+		// all of them are ready as soon as the group is.
+		ReadyToUse: true,
+	}
+}
+
+// toFSType maps the filesystem type of a mount capability. An empty type
+// leaves the choice to the volume.
+func toFSType(fsType string) (zfsilov1.Volume_FSType, error) {
+	switch strings.ToLower(fsType) {
+	case "":
+		return zfsilov1.Volume_FS_TYPE_UNSPECIFIED, nil
+	case "ext4":
+		return zfsilov1.Volume_FS_TYPE_EXT4, nil
+	case "xfs":
+		return zfsilov1.Volume_FS_TYPE_XFS, nil
+	case "btrfs":
+		return zfsilov1.Volume_FS_TYPE_BTRFS, nil
+	default:
+		return zfsilov1.Volume_FS_TYPE_UNSPECIFIED, status.Errorf(codes.InvalidArgument, "unsupported filesystem type: %s", fsType)
+	}
+}
+
+// isReaderOnly returns whether the access mode of a capability only allows
+// the volume to be read.
+func isReaderOnly(c *csi.VolumeCapability) bool {
+	//nolint:exhaustive
+	switch c.GetAccessMode().GetMode() {
+	case csi.VolumeCapability_AccessMode_SINGLE_NODE_READER_ONLY,
+		csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY:
+		return true
+	default:
+		return false
+	}
+}
+
+// isMultiWriter returns whether the access mode of a capability allows the
+// volume to be written from several nodes at once.
+func isMultiWriter(c *csi.VolumeCapability) bool {
+	return c.GetAccessMode().GetMode() == csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER
+}
+
+// findAttachment returns the attachment of the volume to the host, or nil when
+// the volume is not attached to it.
+func findAttachment(vol *zfsilov1.Volume, hostID string) *zfsilov1.Volume_Attachment {
+	for _, attachment := range vol.Attachments {
+		if attachment.ClientHost == hostID {
+			return attachment
+		}
+	}
+	return nil
+}
+
+// attachedHosts returns the client hosts the volume is attached to.
+func attachedHosts(vol *zfsilov1.Volume) []string {
+	hosts := make([]string, 0, len(vol.Attachments))
+	for _, attachment := range vol.Attachments {
+		hosts = append(hosts, attachment.ClientHost)
+	}
+	return hosts
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
@@ -25,7 +26,9 @@ func validateVolumeCapability(c *csi.VolumeCapability) error {
 
 	switch t := c.AccessType.(type) {
 	case *csi.VolumeCapability_Mount:
-		// okay
+		if _, err := toFSType(t.Mount.GetFsType()); err != nil {
+			return err
+		}
 	case *csi.VolumeCapability_Block:
 		// okay
 	default:
@@ -35,7 +38,11 @@ func validateVolumeCapability(c *csi.VolumeCapability) error {
 	accessMode := c.AccessMode.Mode
 	//nolint:exhaustive
 	switch accessMode {
-	case csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER:
+	case csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+		csi.VolumeCapability_AccessMode_SINGLE_NODE_READER_ONLY:
+		// okay
+	case csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+		csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY:
 		// okay
 	default:
 		return status.Errorf(codes.InvalidArgument, "unsupported access  mode %s", accessMode)
@@ -100,6 +107,25 @@ func validateVolumeID(id string) error {
 	return nil
 }
 
+// validateSnapshotName checks for basic name requirements.
+func validateSnapshotName(name string) error {
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "snapshot name cannot be empty")
+	}
+	if !volumeNameRegex.MatchString(name) {
+		return status.Errorf(codes.InvalidArgument, "snapshot name contains invalid characters: %s", name)
+	}
+	return nil
+}
+
+// validateSnapshotID checks that the snapshot ID is not empty.
+func validateSnapshotID(id string) error {
+	if id == "" {
+		return status.Error(codes.InvalidArgument, "snapshot id cannot be empty")
+	}
+	return nil
+}
+
 // validateNodeID checks that the node ID is not empty.
 func validateNodeID(id string) error {
 	if id == "" {
@@ -161,6 +187,21 @@ func validateCreateVolumeRequest(req *csi.CreateVolumeRequest) error {
 		return err
 	}
 
+	if source := req.GetVolumeContentSource(); source != nil {
+		switch {
+		case source.GetSnapshot() != nil:
+			if source.GetSnapshot().GetSnapshotId() == "" {
+				return status.Error(codes.InvalidArgument, "volume content source snapshot id cannot be empty")
+			}
+		case source.GetVolume() != nil:
+			if source.GetVolume().GetVolumeId() == "" {
+				return status.Error(codes.InvalidArgument, "volume content source volume id cannot be empty")
+			}
+		default:
+			return status.Error(codes.InvalidArgument, "unsupported volume content source")
+		}
+	}
+
 	for key := range req.GetMutableParameters() {
 		if !strings.HasPrefix(key, "o_") {
 			return status.Errorf(codes.InvalidArgument, "unsupported mutable parameter: %s", key)
@@ -405,3 +446,85 @@ func validateNodeExpandVolumeRequest(req *csi.NodeExpandVolumeRequest) error {
 
 	return nil
 }
+
+func validateCreateSnapshotRequest(req *csi.CreateSnapshotRequest) error {
+	if err := validateSnapshotName(req.GetName()); err != nil {
+		return err
+	}
+
+	if req.GetSourceVolumeId() == "" {
+		return status.Error(codes.InvalidArgument, "source volume id cannot be empty")
+	}
+
+	return nil
+}
+
+func validateDeleteSnapshotRequest(req *csi.DeleteSnapshotRequest) error {
+	if err := validateSnapshotID(req.GetSnapshotId()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateListSnapshotsRequest(req *csi.ListSnapshotsRequest) error {
+	// MaxEntries is OPTIONAL, but if set, must be non-negative.
+	if err := validateMaxEntries(req.GetMaxEntries()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateGetSnapshotRequest(req *csi.GetSnapshotRequest) error {
+	if err := validateSnapshotID(req.GetSnapshotId()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateGroupSnapshotID checks that the group snapshot ID is not empty.
+func validateGroupSnapshotID(id string) error {
+	if id == "" {
+		return status.Error(codes.InvalidArgument, "group snapshot id cannot be empty")
+	}
+	return nil
+}
+
+func validateCreateVolumeGroupSnapshotRequest(req *csi.CreateVolumeGroupSnapshotRequest) error {
+	if err := validateSnapshotName(req.GetName()); err != nil {
+		return err
+	}
+
+	sourceVolumeIDs := req.GetSourceVolumeIds()
+	if len(sourceVolumeIDs) == 0 {
+		return status.Error(codes.InvalidArgument, "source volume ids cannot be empty")
+	}
+	for i, id := range sourceVolumeIDs {
+		if id == "" {
+			return status.Error(codes.InvalidArgument, "source volume id cannot be empty")
+		}
+		if slices.Contains(sourceVolumeIDs[:i], id) {
+			return status.Errorf(codes.InvalidArgument, "source volume id is duplicated: %s", id)
+		}
+	}
+
+	return nil
+}
+
+func validateDeleteVolumeGroupSnapshotRequest(req *csi.DeleteVolumeGroupSnapshotRequest) error {
+	if err := validateGroupSnapshotID(req.GetGroupSnapshotId()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateGetVolumeGroupSnapshotRequest(req *csi.GetVolumeGroupSnapshotRequest) error {
+	if err := validateGroupSnapshotID(req.GetGroupSnapshotId()); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -126,6 +126,7 @@ func WireServer(
 	server := grpc.NewServer(grpcServerOptions...)
 	csi.RegisterIdentityServer(server, csiService)
 	csi.RegisterControllerServer(server, csiService)
+	csi.RegisterGroupControllerServer(server, csiService)
 	csi.RegisterNodeServer(server, csiService)
 	reflection.Register(server)
 
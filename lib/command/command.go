@@ -28,6 +28,14 @@ type Executor interface {
 	Exec(ctx context.Context, command string) (*CommandResult, error)
 }
 
+// StreamExecutor is an Executor that can also stream the standard input and
+// output of a command, such as when piping data between hosts. The returned
+// result does not contain stdout as it is written to the given writer.
+type StreamExecutor interface {
+	Executor
+	ExecStream(ctx context.Context, command string, stdin io.Reader, stdout io.Writer) (*CommandResult, error)
+}
+
 type MockRule struct {
 	// CommandContains is a string that must be present in the command for the rule to match.
 	CommandContains string
@@ -70,6 +78,27 @@ func (e *MockExecutor) Exec(ctx context.Context, command string) (*CommandResult
 	}, nil
 }
 
+func (e *MockExecutor) ExecStream(ctx context.Context, command string, stdin io.Reader, stdout io.Writer) (*CommandResult, error) {
+	result, err := e.Exec(ctx, command)
+	if err != nil {
+		return result, err
+	}
+
+	// We drain stdin so that any writer on the other end is not blocked.
+	if stdin != nil {
+		if _, err := io.Copy(io.Discard, stdin); err != nil {
+			return nil, err
+		}
+	}
+	if stdout != nil {
+		if _, err := io.WriteString(stdout, result.Stdout); err != nil {
+			return nil, err
+		}
+	}
+	result.Stdout = ""
+	return result, nil
+}
+
 type LocalExecutorConfig struct {
 	RunAsRoot bool
 }
@@ -120,6 +149,38 @@ func (e *LocalExecutor) Exec(ctx context.Context, command string) (*CommandResul
 	return result, nil
 }
 
+func (e *LocalExecutor) ExecStream(ctx context.Context, command string, stdin io.Reader, stdout io.Writer) (*CommandResult, error) {
+	cmd := func() *exec.Cmd {
+		if e.runAsRoot {
+			return exec.CommandContext(ctx, "sudo", "sh", "-c", command)
+		}
+		return exec.CommandContext(ctx, "sh", "-c", command)
+	}()
+
+	var stderr bytes.Buffer
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+
+	result := &CommandResult{
+		Stderr:   stderr.String(),
+		ExitCode: cmd.ProcessState.ExitCode(),
+	}
+
+	if err != nil {
+		// exec.ExitError is expected for non-zero exit codes, so we return the
+		// result along with the error.
+		if _, ok := err.(*exec.ExitError); ok {
+			return result, err
+		}
+		// For other errors, just return the error.
+		return nil, err
+	}
+	return result, nil
+}
+
 type RemoteExecutorConfig struct {
 	RunAsRoot bool
 	Address   string `validate:"required"`
@@ -188,6 +249,15 @@ func (e *RemoteExecutor) Shutdown(ctx context.Context) error {
 }
 
 func (e *RemoteExecutor) Exec(ctx context.Context, command string) (*CommandResult, error) {
+	var stdout bytes.Buffer
+	result, err := e.ExecStream(ctx, command, nil, &stdout)
+	if result != nil {
+		result.Stdout = stdout.String()
+	}
+	return result, err
+}
+
+func (e *RemoteExecutor) ExecStream(ctx context.Context, command string, stdin io.Reader, stdout io.Writer) (*CommandResult, error) {
 	connected := e.client != nil
 	if !connected {
 		// We perform the startup if the executor has not been initialized rather
@@ -198,10 +268,57 @@ func (e *RemoteExecutor) Exec(ctx context.Context, command string) (*CommandResu
 		}
 	}
 
+	session, err := e.newSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+
+	var stderr bytes.Buffer
+	session.Stdin = stdin
+	session.Stdout = stdout
+	session.Stderr = &stderr
+
+	// We give up on the command once the context is done, so that a command
+	// stuck on a host that has become unreachable does not hold up the
+	// caller. Closing the session stops the command should the host still be
+	// reachable.
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Run(command)
+	}()
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		session.Close()
+		return &CommandResult{}, fmt.Errorf("failed to run command: %w", ctx.Err())
+	}
+
+	result := &CommandResult{
+		Stderr:   stderr.String(),
+		ExitCode: 0, // default to 0
+	}
+
+	if err != nil {
+		// If there was an error, we try to extract the exit code.
+		var exitErr *ssh.ExitError
+		if errors.As(err, &exitErr) {
+			result.ExitCode = exitErr.ExitStatus()
+		}
+		// We always return the result on error as it can contain useful
+		// information.
+		return result, err
+	}
+	return result, nil
+}
+
+// newSession creates a new session on the client, reconnecting if the
+// underlying connection has dropped. The lock is only held while the session
+// is created so that sessions can run concurrently.
+func (e *RemoteExecutor) newSession(ctx context.Context) (*ssh.Session, error) {
 	e.clientLock.Lock()
 	defer e.clientLock.Unlock()
 
-	var session *ssh.Session
 	for cnt := 0; ; cnt++ {
 		if cnt > 1 {
 			return nil, fmt.Errorf("failed to create ssh session: retry failed")
@@ -225,33 +342,8 @@ func (e *RemoteExecutor) Exec(ctx context.Context, command string) (*CommandResu
 			return nil, fmt.Errorf("failed to create new session: %w", err)
 		}
 
-		session = sess
-		break
-	}
-
-	var stdout, stderr bytes.Buffer
-	session.Stdout = &stdout
-	session.Stderr = &stderr
-
-	err := session.Run(command)
-
-	result := &CommandResult{
-		Stdout:   stdout.String(),
-		Stderr:   stderr.String(),
-		ExitCode: 0, // default to 0
+		return sess, nil
 	}
-
-	if err != nil {
-		// If there was an error, we try to extract the exit code.
-		var exitErr *ssh.ExitError
-		if errors.As(err, &exitErr) {
-			result.ExitCode = exitErr.ExitStatus()
-		}
-		// We always return the result on error as it can contain useful
-		// information.
-		return result, err
-	}
-	return result, nil
 }
 
 func (e *RemoteExecutor) dial(ctx context.Context) (*ssh.Client, error) {
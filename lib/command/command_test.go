@@ -102,6 +102,27 @@ func TestLocalExecutor(t *testing.T) {
 		}
 	})
 
+	t.Run("it streams stdin to stdout", func(t *testing.T) {
+		executor := command.NewLocalExecutor(command.LocalExecutorConfig{})
+
+		var stdout strings.Builder
+		result, err := executor.ExecStream(ctx, `tr a-z A-Z`, strings.NewReader("hello world"), &stdout)
+		if err != nil {
+			t.Fatalf("expected no error, but got: %v", err)
+		}
+		if result.ExitCode != 0 {
+			t.Errorf("expected exit code 0, but got %d", result.ExitCode)
+		}
+
+		expectedOut := "HELLO WORLD"
+		if stdout.String() != expectedOut {
+			t.Errorf("expected stdout %q, but got %q", expectedOut, stdout.String())
+		}
+		if result.Stdout != "" {
+			t.Errorf("expected empty result stdout, but got %q", result.Stdout)
+		}
+	})
+
 	t.Run("it executes as root when configured", func(t *testing.T) {
 		// This test can only run on non-Windows OS and requires passwordless sudo for the current user.
 		if runtime.GOOS == "windows" {
@@ -0,0 +1,292 @@
+// Package zfsprop provides a catalogue of the native ZFS properties that can be
+// given as options on volumes and filesystems, and validates values for them.
+//
+// It lives in lib so that the application and the CSI driver validate options
+// the same way, with the same messages. The CSI driver is a module of its own,
+// and cannot import the internal zfs command package of the application.
+package zfsprop
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Type is the type of the value of a property.
+type Type int
+
+const (
+	// TypeString accepts any value.
+	TypeString Type = iota
+	// TypeBool accepts on or off.
+	TypeBool
+	// TypeEnum accepts one of the values of the property.
+	TypeEnum
+	// TypeSize accepts a number of bytes with an optional unit suffix, such as
+	// 128K, or one of the values of the property.
+	TypeSize
+)
+
+// DatasetType is a set of the types of dataset a property applies to.
+type DatasetType int
+
+const (
+	DatasetTypeVolume DatasetType = 1 << iota
+	DatasetTypeFilesystem
+
+	DatasetTypeAll = DatasetTypeVolume | DatasetTypeFilesystem
+)
+
+func (t DatasetType) String() string {
+	switch t {
+	case DatasetTypeVolume:
+		return "volume"
+	case DatasetTypeFilesystem:
+		return "filesystem"
+	case DatasetTypeAll:
+		return "volume or filesystem"
+	default:
+		return fmt.Sprintf("DatasetType(%d)", int(t))
+	}
+}
+
+// Property describes a native ZFS property.
+type Property struct {
+	Name string
+	Type Type
+	// Values are the accepted values of an enum, or the accepted values besides
+	// a size.
+	Values []string
+	// Datasets are the types of dataset the property applies to.
+	Datasets DatasetType
+	// CreateOnly properties can only be set when the dataset is created.
+	CreateOnly bool
+	// Managed properties are set by zfsilo, such as from the capacity of the
+	// volume, and cannot be given as options.
+	Managed bool
+	// ReadOnly properties are reported by ZFS and cannot be set at all.
+	ReadOnly bool
+	// MinSize and MaxSize bound a size when set, and PowerOfTwo requires it to
+	// be a power of two.
+	MinSize    uint64
+	MaxSize    uint64
+	PowerOfTwo bool
+}
+
+var (
+	onOff = []string{"on", "off"}
+
+	compressionValues = func() []string {
+		values := []string{"on", "off", "lzjb", "gzip", "zle", "lz4", "zstd", "zstd-fast"}
+		for i := 1; i <= 9; i++ {
+			values = append(values, fmt.Sprintf("gzip-%d", i))
+		}
+		for i := 1; i <= 19; i++ {
+			values = append(values, fmt.Sprintf("zstd-%d", i))
+		}
+		for _, i := range []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100, 500, 1000} {
+			values = append(values, fmt.Sprintf("zstd-fast-%d", i))
+		}
+		return values
+	}()
+
+	checksumValues = []string{"on", "off", "fletcher2", "fletcher4", "sha256", "noparity", "sha512", "skein", "edonr", "blake3"}
+
+	dedupValues = []string{
+		"on", "off", "verify",
+		"sha256", "sha256,verify", "sha512", "sha512,verify",
+		"skein", "skein,verify", "edonr,verify", "blake3", "blake3,verify",
+	}
+)
+
+var catalogue = func() map[string]Property {
+	properties := []Property{
+		// Properties of both volumes and filesystems.
+		{Name: "compression", Type: TypeEnum, Values: compressionValues, Datasets: DatasetTypeAll},
+		{Name: "checksum", Type: TypeEnum, Values: checksumValues, Datasets: DatasetTypeAll},
+		{Name: "dedup", Type: TypeEnum, Values: dedupValues, Datasets: DatasetTypeAll},
+		{Name: "copies", Type: TypeEnum, Values: []string{"1", "2", "3"}, Datasets: DatasetTypeAll},
+		{Name: "sync", Type: TypeEnum, Values: []string{"standard", "always", "disabled"}, Datasets: DatasetTypeAll},
+		{Name: "logbias", Type: TypeEnum, Values: []string{"latency", "throughput"}, Datasets: DatasetTypeAll},
+		{Name: "primarycache", Type: TypeEnum, Values: []string{"all", "none", "metadata"}, Datasets: DatasetTypeAll},
+		{Name: "secondarycache", Type: TypeEnum, Values: []string{"all", "none", "metadata"}, Datasets: DatasetTypeAll},
+		{Name: "redundant_metadata", Type: TypeEnum, Values: []string{"all", "most", "some", "none"}, Datasets: DatasetTypeAll},
+		{Name: "readonly", Type: TypeBool, Datasets: DatasetTypeAll},
+		{Name: "reservation", Type: TypeSize, Values: []string{"none"}, Datasets: DatasetTypeAll},
+		{Name: "snapdev", Type: TypeEnum, Values: []string{"hidden", "visible"}, Datasets: DatasetTypeAll},
+
+		// Properties of volumes.
+		{Name: "volblocksize", Type: TypeSize, Datasets: DatasetTypeVolume, CreateOnly: true, MinSize: 512, MaxSize: 16 << 20, PowerOfTwo: true},
+		{Name: "volmode", Type: TypeEnum, Values: []string{"default", "full", "geom", "dev", "none"}, Datasets: DatasetTypeVolume},
+
+		// Properties of filesystems.
+		{Name: "recordsize", Type: TypeSize, Datasets: DatasetTypeFilesystem, MinSize: 512, MaxSize: 16 << 20, PowerOfTwo: true},
+		{Name: "special_small_blocks", Type: TypeSize, Datasets: DatasetTypeFilesystem, MaxSize: 16 << 20, PowerOfTwo: true},
+		{Name: "quota", Type: TypeSize, Values: []string{"none"}, Datasets: DatasetTypeFilesystem},
+		{Name: "atime", Type: TypeBool, Datasets: DatasetTypeFilesystem},
+		{Name: "relatime", Type: TypeBool, Datasets: DatasetTypeFilesystem},
+		{Name: "exec", Type: TypeBool, Datasets: DatasetTypeFilesystem},
+		{Name: "setuid", Type: TypeBool, Datasets: DatasetTypeFilesystem},
+		{Name: "devices", Type: TypeBool, Datasets: DatasetTypeFilesystem},
+		{Name: "xattr", Type: TypeEnum, Values: []string{"on", "off", "dir", "sa"}, Datasets: DatasetTypeFilesystem},
+		{Name: "acltype", Type: TypeEnum, Values: []string{"off", "noacl", "nfsv4", "posix", "posixacl"}, Datasets: DatasetTypeFilesystem},
+		{Name: "aclinherit", Type: TypeEnum, Values: []string{"discard", "noallow", "restricted", "passthrough", "passthrough-x"}, Datasets: DatasetTypeFilesystem},
+		{Name: "aclmode", Type: TypeEnum, Values: []string{"discard", "groupmask", "passthrough", "restricted"}, Datasets: DatasetTypeFilesystem},
+		{Name: "dnodesize", Type: TypeEnum, Values: []string{"legacy", "auto", "1k", "2k", "4k", "8k", "16k"}, Datasets: DatasetTypeFilesystem},
+		{Name: "snapdir", Type: TypeEnum, Values: []string{"hidden", "visible"}, Datasets: DatasetTypeFilesystem},
+		{Name: "casesensitivity", Type: TypeEnum, Values: []string{"sensitive", "insensitive", "mixed"}, Datasets: DatasetTypeFilesystem, CreateOnly: true},
+		{Name: "normalization", Type: TypeEnum, Values: []string{"none", "formC", "formD", "formKC", "formKD"}, Datasets: DatasetTypeFilesystem, CreateOnly: true},
+		{Name: "utf8only", Type: TypeBool, Datasets: DatasetTypeFilesystem, CreateOnly: true},
+
+		// Properties managed by zfsilo.
+		{Name: "volsize", Type: TypeSize, Datasets: DatasetTypeVolume, Managed: true},
+		{Name: "refquota", Type: TypeSize, Datasets: DatasetTypeFilesystem, Managed: true},
+		{Name: "refreservation", Type: TypeSize, Datasets: DatasetTypeAll, Managed: true},
+		{Name: "encryption", Type: TypeString, Datasets: DatasetTypeAll, Managed: true},
+		{Name: "keyformat", Type: TypeString, Datasets: DatasetTypeAll, Managed: true},
+		{Name: "keylocation", Type: TypeString, Datasets: DatasetTypeAll, Managed: true},
+		{Name: "pbkdf2iters", Type: TypeString, Datasets: DatasetTypeAll, Managed: true},
+		{Name: "mountpoint", Type: TypeString, Datasets: DatasetTypeFilesystem, Managed: true},
+		{Name: "canmount", Type: TypeString, Datasets: DatasetTypeFilesystem, Managed: true},
+		{Name: "sharenfs", Type: TypeString, Datasets: DatasetTypeFilesystem, Managed: true},
+		{Name: "sharesmb", Type: TypeString, Datasets: DatasetTypeFilesystem, Managed: true},
+	}
+	for _, name := range []string{
+		"type", "creation", "used", "available", "referenced", "compressratio",
+		"refcompressratio", "mounted", "origin", "written", "logicalused",
+		"logicalreferenced", "usedbysnapshots", "usedbydataset",
+		"usedbychildren", "usedbyrefreservation", "encryptionroot",
+		"keystatus", "guid", "createtxg", "clones",
+	} {
+		properties = append(properties, Property{Name: name, Type: TypeString, Datasets: DatasetTypeAll, ReadOnly: true})
+	}
+
+	catalogue := make(map[string]Property, len(properties))
+	for _, property := range properties {
+		catalogue[property.Name] = property
+	}
+	return catalogue
+}()
+
+// Lookup returns the property of the name. User properties, which contain a
+// colon, are strings that apply to any dataset.
+func Lookup(name string) (Property, bool) {
+	if IsUserProperty(name) {
+		return Property{Name: name, Type: TypeString, Datasets: DatasetTypeAll}, true
+	}
+	property, ok := catalogue[name]
+	return property, ok
+}
+
+// IsUserProperty reports whether the name is that of a user property.
+func IsUserProperty(name string) bool {
+	return strings.Contains(name, ":")
+}
+
+// Validate checks that the property of the name can be set to the value on a
+// dataset of the type, either when it is created or on an existing one.
+func Validate(name string, value string, datasetType DatasetType, create bool) error {
+	property, ok := Lookup(name)
+	switch {
+	case !ok:
+		return fmt.Errorf("unknown property '%s'", name)
+	case property.ReadOnly:
+		return fmt.Errorf("property '%s' is read-only", name)
+	case property.Managed:
+		return fmt.Errorf("property '%s' is managed by zfsilo and cannot be set as an option", name)
+	case property.Datasets&datasetType == 0:
+		return fmt.Errorf("property '%s' does not apply to a %s", name, datasetType)
+	case property.CreateOnly && !create:
+		return fmt.Errorf("property '%s' can only be set when the volume is created", name)
+	}
+	return property.ValidateValue(value)
+}
+
+// ValidateValue checks that the value is valid for the property.
+func (p Property) ValidateValue(value string) error {
+	if value == "" {
+		return fmt.Errorf("property '%s' must have a value", p.Name)
+	}
+
+	switch p.Type {
+	case TypeBool:
+		if !slices.Contains(onOff, value) {
+			return fmt.Errorf("invalid value '%s' for property '%s': must be on or off", value, p.Name)
+		}
+	case TypeEnum:
+		if !slices.Contains(p.Values, value) {
+			return fmt.Errorf("invalid value '%s' for property '%s': must be one of %s", value, p.Name, strings.Join(p.Values, ", "))
+		}
+	case TypeSize:
+		if slices.Contains(p.Values, value) {
+			return nil
+		}
+		size, err := ParseSize(value)
+		if err != nil {
+			return fmt.Errorf("invalid value '%s' for property '%s': %w", value, p.Name, err)
+		}
+		switch {
+		case p.MinSize > 0 && size < p.MinSize:
+			return fmt.Errorf("invalid value '%s' for property '%s': must be at least %d bytes", value, p.Name, p.MinSize)
+		case p.MaxSize > 0 && size > p.MaxSize:
+			return fmt.Errorf("invalid value '%s' for property '%s': must be at most %d bytes", value, p.Name, p.MaxSize)
+		case p.PowerOfTwo && size != 0 && bits.OnesCount64(size) != 1:
+			return fmt.Errorf("invalid value '%s' for property '%s': must be a power of two", value, p.Name)
+		}
+	case TypeString:
+		if IsUserProperty(p.Name) && len(value) > 8192 {
+			return fmt.Errorf("invalid value for property '%s': must be at most 8192 bytes", p.Name)
+		}
+	}
+	return nil
+}
+
+// ParseSize parses a size as ZFS accepts it, which is a number of bytes with
+// an optional unit suffix of B, K, M, G, T, P or E, that may be followed by B
+// or iB. Units are powers of 1024.
+func ParseSize(value string) (uint64, error) {
+	upper := strings.ToUpper(value)
+	number := strings.TrimRight(upper, "BKMGTPEI")
+	suffix := strings.TrimSuffix(strings.TrimSuffix(upper[len(number):], "IB"), "B")
+	if number == "" {
+		return 0, fmt.Errorf("size must start with a number")
+	}
+
+	var shift uint
+	switch suffix {
+	case "":
+		shift = 0
+	case "K":
+		shift = 10
+	case "M":
+		shift = 20
+	case "G":
+		shift = 30
+	case "T":
+		shift = 40
+	case "P":
+		shift = 50
+	case "E":
+		shift = 60
+	default:
+		return 0, fmt.Errorf("unknown size suffix '%s'", value[len(number):])
+	}
+
+	if n, err := strconv.ParseUint(number, 10, 64); err == nil {
+		if shift > 0 && n > (^uint64(0))>>shift {
+			return 0, fmt.Errorf("size is too large")
+		}
+		return n << shift, nil
+	}
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("size must be a non-negative number")
+	}
+	size := f * float64(uint64(1)<<shift)
+	if size >= float64(^uint64(0)) {
+		return 0, fmt.Errorf("size is too large")
+	}
+	return uint64(size), nil
+}
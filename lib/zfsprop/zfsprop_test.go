@@ -0,0 +1,169 @@
+package zfsprop_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jovulic/zfsilo/lib/zfsprop"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		property    string
+		value       string
+		datasetType zfsprop.DatasetType
+		create      bool
+		wantErr     string
+	}{
+		{
+			name:        "valid enum",
+			property:    "compression",
+			value:       "zstd-3",
+			datasetType: zfsprop.DatasetTypeVolume,
+		},
+		{
+			name:        "invalid enum",
+			property:    "compression",
+			value:       "lz5",
+			datasetType: zfsprop.DatasetTypeVolume,
+			wantErr:     "invalid value 'lz5' for property 'compression': must be one of on, off, lzjb",
+		},
+		{
+			name:        "invalid bool",
+			property:    "atime",
+			value:       "yes",
+			datasetType: zfsprop.DatasetTypeFilesystem,
+			wantErr:     "invalid value 'yes' for property 'atime': must be on or off",
+		},
+		{
+			name:        "unknown property",
+			property:    "compresion",
+			value:       "lz4",
+			datasetType: zfsprop.DatasetTypeVolume,
+			wantErr:     "unknown property 'compresion'",
+		},
+		{
+			name:        "create only property on create",
+			property:    "volblocksize",
+			value:       "16K",
+			datasetType: zfsprop.DatasetTypeVolume,
+			create:      true,
+		},
+		{
+			name:        "create only property on update",
+			property:    "volblocksize",
+			value:       "16K",
+			datasetType: zfsprop.DatasetTypeVolume,
+			wantErr:     "property 'volblocksize' can only be set when the volume is created",
+		},
+		{
+			name:        "size not a power of two",
+			property:    "volblocksize",
+			value:       "12K",
+			datasetType: zfsprop.DatasetTypeVolume,
+			create:      true,
+			wantErr:     "invalid value '12K' for property 'volblocksize': must be a power of two",
+		},
+		{
+			name:        "size out of range",
+			property:    "recordsize",
+			value:       "32M",
+			datasetType: zfsprop.DatasetTypeFilesystem,
+			wantErr:     "invalid value '32M' for property 'recordsize': must be at most 16777216 bytes",
+		},
+		{
+			name:        "size with literal value",
+			property:    "quota",
+			value:       "none",
+			datasetType: zfsprop.DatasetTypeFilesystem,
+		},
+		{
+			name:        "property of another dataset type",
+			property:    "recordsize",
+			value:       "128K",
+			datasetType: zfsprop.DatasetTypeVolume,
+			wantErr:     "property 'recordsize' does not apply to a volume",
+		},
+		{
+			name:        "managed property",
+			property:    "volsize",
+			value:       "1G",
+			datasetType: zfsprop.DatasetTypeVolume,
+			create:      true,
+			wantErr:     "property 'volsize' is managed by zfsilo and cannot be set as an option",
+		},
+		{
+			name:        "read-only property",
+			property:    "used",
+			value:       "1",
+			datasetType: zfsprop.DatasetTypeVolume,
+			wantErr:     "property 'used' is read-only",
+		},
+		{
+			name:        "user property",
+			property:    "com.example:owner",
+			value:       "team a",
+			datasetType: zfsprop.DatasetTypeVolume,
+		},
+		{
+			name:        "empty value",
+			property:    "sync",
+			value:       "",
+			datasetType: zfsprop.DatasetTypeVolume,
+			wantErr:     "property 'sync' must have a value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := zfsprop.Validate(tt.property, tt.value, tt.datasetType, tt.create)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected no error, but got: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error containing %q, but got none", tt.wantErr)
+			}
+			if got := err.Error(); !strings.HasPrefix(got, tt.wantErr) {
+				t.Fatalf("expected error starting with %q, but got: %q", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    uint64
+		wantErr bool
+	}{
+		{value: "512", want: 512},
+		{value: "16K", want: 16 << 10},
+		{value: "16k", want: 16 << 10},
+		{value: "1.5G", want: 3 << 29},
+		{value: "128KiB", want: 128 << 10},
+		{value: "2MB", want: 2 << 20},
+		{value: "1X", wantErr: true},
+		{value: "K", wantErr: true},
+		{value: "-1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := zfsprop.ParseSize(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, but got %d", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, but got: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("expected %d, but got %d", tt.want, got)
+			}
+		})
+	}
+}
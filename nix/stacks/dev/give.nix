@@ -52,6 +52,7 @@
         5355 # allow LLMNR
         3260 # allow iSCSI
         4420 # allow NVMe/TCP
+        2049 # allow NFS
       ];
     };
 
@@ -86,6 +87,10 @@
       enable = true;
     };
 
+    services.nfs.server = {
+      enable = true;
+    };
+
     systemd.services.setup-tank = {
       description = "Configure ZFS.";
       wantedBy = [ "multi-user.target" ];
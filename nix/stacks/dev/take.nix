@@ -28,6 +28,7 @@
     };
 
     boot = {
+      supportedFilesystems = [ "nfs" ];
       initrd.availableKernelModules = [
         "nvmet"
         "nvmet-tcp"